@@ -0,0 +1,396 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"tini-paas/api/applicationapi/proto/applicationApi"
+	"tini-paas/internal/application/proto/application"
+	"tini-paas/pkg/common"
+	"tini-paas/plugin/form"
+)
+
+// ApplicationApi handler 调用application的客户端API接口
+type ApplicationApi struct {
+	ApplicationServer application.ApplicationService
+}
+
+// AddApplication 添加应用
+// ApplicationApi.AddApplication 通过API向外暴露为/applicationApi/AddApplication, 接收http请求
+func (a *ApplicationApi) AddApplication(ctx context.Context, req *applicationApi.Request, rsp *applicationApi.Response) error {
+	info := &application.ApplicationInfo{}
+
+	// 将req.Post信息转换为ApplicationInfo
+	form.FormToApplicationStruct(req.Post, info)
+	if info.AppName == "" || info.AppNamespace == "" {
+		rsp.StatusCode = 500
+		return errors.New("参数异常")
+	}
+
+	response, err := a.ApplicationServer.AddApplication(ctx, info)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+
+	// 数据回写
+	rsp.StatusCode = 200
+	bytes, _ := json.Marshal(response)
+	rsp.Body = string(bytes)
+	return nil
+}
+
+// DeleteApplicationByID 删除应用和应用中的全部资源
+// ApplicationApi.DeleteApplicationByID 通过API向外暴露为/applicationApi/DeleteApplicationByID, 接收http请求
+func (a *ApplicationApi) DeleteApplicationByID(ctx context.Context, req *applicationApi.Request, rsp *applicationApi.Response) error {
+	appID, err := getInt64(req.Get, "app_id")
+	if err != nil {
+		rsp.StatusCode = 500
+		return err
+	}
+
+	response, err := a.ApplicationServer.DeleteApplication(ctx, &application.ApplicationID{
+		Id: appID,
+	})
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+
+	// 数据回写
+	rsp.StatusCode = 200
+	bytes, _ := json.Marshal(response)
+	rsp.Body = string(bytes)
+	return nil
+}
+
+// UpdateApplication 更新应用的描述和所属团队
+// ApplicationApi.UpdateApplication 通过API向外暴露为/applicationApi/UpdateApplication, 接收http请求
+func (a *ApplicationApi) UpdateApplication(ctx context.Context, req *applicationApi.Request, rsp *applicationApi.Response) error {
+	info := &application.ApplicationInfo{}
+
+	// 将req.Post信息转换为ApplicationInfo
+	form.FormToApplicationStruct(req.Post, info)
+	if info.Id == 0 {
+		rsp.StatusCode = 500
+		return errors.New("参数异常")
+	}
+
+	response, err := a.ApplicationServer.UpdateApplication(ctx, info)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+
+	// 数据回写
+	rsp.StatusCode = 200
+	bytes, _ := json.Marshal(response)
+	rsp.Body = string(bytes)
+	return nil
+}
+
+// FindApplicationByID 查找应用和应用中的资源
+// ApplicationApi.FindApplicationByID 通过API向外暴露为/applicationApi/FindApplicationByID, 接收http请求
+func (a *ApplicationApi) FindApplicationByID(ctx context.Context, req *applicationApi.Request, rsp *applicationApi.Response) error {
+	appID, err := getInt64(req.Get, "app_id")
+	if err != nil {
+		rsp.StatusCode = 500
+		return err
+	}
+
+	response, err := a.ApplicationServer.FindApplicationByID(ctx, &application.ApplicationID{
+		Id: appID,
+	})
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+
+	// 数据回写
+	rsp.StatusCode = 200
+	bytes, _ := json.Marshal(response)
+	rsp.Body = string(bytes)
+	return nil
+}
+
+// AddAppChild 将已有的资源加入应用
+// ApplicationApi.AddAppChild 通过API向外暴露为/applicationApi/AddAppChild, 接收http请求
+func (a *ApplicationApi) AddAppChild(ctx context.Context, req *applicationApi.Request, rsp *applicationApi.Response) error {
+	info := &application.AppChild{}
+
+	// 将req.Post信息转换为AppChild
+	form.FormToApplicationStruct(req.Post, info)
+	if info.AppId == 0 || info.ChildKind == "" || info.ChildId == 0 {
+		rsp.StatusCode = 500
+		return errors.New("参数异常")
+	}
+
+	response, err := a.ApplicationServer.AddAppChild(ctx, info)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+
+	// 数据回写
+	rsp.StatusCode = 200
+	bytes, _ := json.Marshal(response)
+	rsp.Body = string(bytes)
+	return nil
+}
+
+// RemoveAppChild 将资源移出应用，资源本身不删除
+// ApplicationApi.RemoveAppChild 通过API向外暴露为/applicationApi/RemoveAppChild, 接收http请求
+func (a *ApplicationApi) RemoveAppChild(ctx context.Context, req *applicationApi.Request, rsp *applicationApi.Response) error {
+	info := &application.AppChild{}
+
+	// 将req.Post信息转换为AppChild
+	form.FormToApplicationStruct(req.Post, info)
+	if info.AppId == 0 || info.ChildKind == "" || info.ChildId == 0 {
+		rsp.StatusCode = 500
+		return errors.New("参数异常")
+	}
+
+	response, err := a.ApplicationServer.RemoveAppChild(ctx, info)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+
+	// 数据回写
+	rsp.StatusCode = 200
+	bytes, _ := json.Marshal(response)
+	rsp.Body = string(bytes)
+	return nil
+}
+
+// SyncAppLabels 重新为应用中的资源打上公共标签
+// ApplicationApi.SyncAppLabels 通过API向外暴露为/applicationApi/SyncAppLabels, 接收http请求
+func (a *ApplicationApi) SyncAppLabels(ctx context.Context, req *applicationApi.Request, rsp *applicationApi.Response) error {
+	appID, err := getInt64(req.Get, "app_id")
+	if err != nil {
+		rsp.StatusCode = 500
+		return err
+	}
+
+	response, err := a.ApplicationServer.SyncAppLabels(ctx, &application.ApplicationID{
+		Id: appID,
+	})
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+
+	// 数据回写
+	rsp.StatusCode = 200
+	bytes, _ := json.Marshal(response)
+	rsp.Body = string(bytes)
+	return nil
+}
+
+// GetAppStatus 查看应用的汇总状态
+// ApplicationApi.GetAppStatus 通过API向外暴露为/applicationApi/GetAppStatus, 接收http请求
+func (a *ApplicationApi) GetAppStatus(ctx context.Context, req *applicationApi.Request, rsp *applicationApi.Response) error {
+	appID, err := getInt64(req.Get, "app_id")
+	if err != nil {
+		rsp.StatusCode = 500
+		return err
+	}
+
+	response, err := a.ApplicationServer.GetAppStatus(ctx, &application.ApplicationID{
+		Id: appID,
+	})
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+
+	// 数据回写
+	rsp.StatusCode = 200
+	bytes, _ := json.Marshal(response)
+	rsp.Body = string(bytes)
+	return nil
+}
+
+// GetAppTopology 查看应用中资源之间的引用关系
+// ApplicationApi.GetAppTopology 通过API向外暴露为/applicationApi/GetAppTopology, 接收http请求
+func (a *ApplicationApi) GetAppTopology(ctx context.Context, req *applicationApi.Request, rsp *applicationApi.Response) error {
+	appID, err := getInt64(req.Get, "app_id")
+	if err != nil {
+		rsp.StatusCode = 500
+		return err
+	}
+
+	response, err := a.ApplicationServer.GetAppTopology(ctx, &application.ApplicationID{
+		Id: appID,
+	})
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+
+	// 数据回写
+	rsp.StatusCode = 200
+	bytes, _ := json.Marshal(response)
+	rsp.Body = string(bytes)
+	return nil
+}
+
+// StopApplication 停止应用中全部的pod和中间件
+// ApplicationApi.StopApplication 通过API向外暴露为/applicationApi/StopApplication, 接收http请求
+func (a *ApplicationApi) StopApplication(ctx context.Context, req *applicationApi.Request, rsp *applicationApi.Response) error {
+	appID, err := getInt64(req.Get, "app_id")
+	if err != nil {
+		rsp.StatusCode = 500
+		return err
+	}
+
+	response, err := a.ApplicationServer.StopApplication(ctx, &application.ApplicationID{
+		Id: appID,
+	})
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+
+	// 数据回写
+	rsp.StatusCode = 200
+	bytes, _ := json.Marshal(response)
+	rsp.Body = string(bytes)
+	return nil
+}
+
+// StartApplication 启动应用
+// ApplicationApi.StartApplication 通过API向外暴露为/applicationApi/StartApplication, 接收http请求
+func (a *ApplicationApi) StartApplication(ctx context.Context, req *applicationApi.Request, rsp *applicationApi.Response) error {
+	appID, err := getInt64(req.Get, "app_id")
+	if err != nil {
+		rsp.StatusCode = 500
+		return err
+	}
+
+	response, err := a.ApplicationServer.StartApplication(ctx, &application.ApplicationID{
+		Id: appID,
+	})
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+
+	// 数据回写
+	rsp.StatusCode = 200
+	bytes, _ := json.Marshal(response)
+	rsp.Body = string(bytes)
+	return nil
+}
+
+// Apply 按照请求体中的清单(YAML或者JSON)创建或更新应用，参数prune=true时删除不在清单中的资源，dry_run=true时只返回执行计划
+// ApplicationApi.Apply 通过API向外暴露为/applicationApi/Apply, 接收http请求
+func (a *ApplicationApi) Apply(ctx context.Context, req *applicationApi.Request, rsp *applicationApi.Response) error {
+	if req.Body == "" {
+		rsp.StatusCode = 500
+		return errors.New("请求体中没有清单")
+	}
+
+	response, err := a.ApplicationServer.Apply(ctx, &application.ApplyRequest{
+		Manifest: req.Body,
+		Prune:    getBool(req.Get, "prune"),
+		DryRun:   getBool(req.Get, "dry_run"),
+	})
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+
+	// 数据回写
+	rsp.StatusCode = 200
+	bytes, _ := json.Marshal(response)
+	rsp.Body = string(bytes)
+	return nil
+}
+
+// Export 导出单个资源应用到k8s的对象，参数child_kind为资源类型，child_id为资源ID
+// ApplicationApi.Export 通过API向外暴露为/applicationApi/Export, 接收http请求
+func (a *ApplicationApi) Export(ctx context.Context, req *applicationApi.Request, rsp *applicationApi.Response) error {
+	kind, ok := req.Get["child_kind"]
+	if !ok || len(kind.Values) == 0 {
+		rsp.StatusCode = 500
+		return errors.New("参数异常")
+	}
+	childID, err := getInt64(req.Get, "child_id")
+	if err != nil {
+		rsp.StatusCode = 500
+		return err
+	}
+
+	response, err := a.ApplicationServer.Export(ctx, &application.ExportRequest{
+		ChildKind: kind.Values[0],
+		ChildId:   childID,
+	})
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+
+	// 数据回写
+	rsp.StatusCode = 200
+	bytes, _ := json.Marshal(response)
+	rsp.Body = string(bytes)
+	return nil
+}
+
+// ExportApplication 导出应用中的全部资源，参数helm=true时打包为Helm chart
+// ApplicationApi.ExportApplication 通过API向外暴露为/applicationApi/ExportApplication, 接收http请求
+func (a *ApplicationApi) ExportApplication(ctx context.Context, req *applicationApi.Request, rsp *applicationApi.Response) error {
+	appID, err := getInt64(req.Get, "app_id")
+	if err != nil {
+		rsp.StatusCode = 500
+		return err
+	}
+
+	response, err := a.ApplicationServer.ExportApplication(ctx, &application.ExportAppRequest{
+		AppId: appID,
+		Helm:  getBool(req.Get, "helm"),
+	})
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+
+	// 数据回写
+	rsp.StatusCode = 200
+	bytes, _ := json.Marshal(response)
+	rsp.Body = string(bytes)
+	return nil
+}
+
+// Call 查找全部应用
+// ApplicationApi.Call 通过API向外暴露为/applicationApi/Call, 接收http请求
+func (a *ApplicationApi) Call(ctx context.Context, req *applicationApi.Request, rsp *applicationApi.Response) error {
+	allApplication, err := a.ApplicationServer.FindAllApplication(ctx, &application.FindAll{})
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+
+	// 数据回写
+	rsp.StatusCode = 200
+	bytes, _ := json.Marshal(allApplication)
+	rsp.Body = string(bytes)
+	return nil
+}
+
+// getInt64 获取请求中的整数参数
+func getInt64(data map[string]*applicationApi.Pair, key string) (int64, error) {
+	pair, ok := data[key]
+	if !ok || len(pair.Values) == 0 {
+		return 0, errors.New("参数异常")
+	}
+	return strconv.ParseInt(pair.Values[0], 10, 64)
+}
+
+// getBool 获取请求中的布尔参数，值为true时返回true
+func getBool(data map[string]*applicationApi.Pair, key string) bool {
+	pair, ok := data[key]
+	return ok && len(pair.Values) > 0 && pair.Values[0] == "true"
+}
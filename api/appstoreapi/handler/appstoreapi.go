@@ -230,6 +230,76 @@ func (a *AppStoreApi) GetViewNum(ctx context.Context, request *appstoreApi.Reque
 	return nil
 }
 
+// InstallApp 安装应用，参数app_id为应用ID，namespace为命名空间，prefix为资源名称前缀(可选)
+// 表单中的字段作为安装参数，覆盖pod和中间件中同名环境变量的值
+// AppStoreApi.InstallApp 通过API向外暴露为/appstoreApi/InstallApp, 接收http请求
+func (a *AppStoreApi) InstallApp(ctx context.Context, request *appstoreApi.Request, response *appstoreApi.Response) error {
+	id, err := a.getID(request)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	namespace, ok := request.Get["namespace"]
+	if !ok || len(namespace.Values) == 0 {
+		return errors.New("参数异常")
+	}
+	req := &appstore.InstallAppRequest{
+		AppId:     id,
+		Namespace: namespace.Values[0],
+		Params:    map[string]string{},
+	}
+	if prefix, ok := request.Get["prefix"]; ok && len(prefix.Values) > 0 {
+		req.Prefix = prefix.Values[0]
+	}
+	for key, pair := range request.Post {
+		if len(pair.Values) > 0 {
+			req.Params[key] = pair.Values[0]
+		}
+	}
+
+	// 调用后端服务执行
+	rsp, err := a.AppStoreService.InstallApp(ctx, req)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+
+	// 数据回写
+	response.StatusCode = 200
+	bytes, _ := json.Marshal(rsp)
+	response.Body = string(bytes)
+	return nil
+}
+
+// FindAppInstanceByID 查询安装实例，参数instance_id为实例ID
+// AppStoreApi.FindAppInstanceByID 通过API向外暴露为/appstoreApi/FindAppInstanceByID, 接收http请求
+func (a *AppStoreApi) FindAppInstanceByID(ctx context.Context, request *appstoreApi.Request, response *appstoreApi.Response) error {
+	pair, ok := request.Get["instance_id"]
+	if !ok || len(pair.Values) == 0 {
+		return errors.New("参数异常")
+	}
+	id, err := strconv.ParseInt(pair.Values[0], 10, 64)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+
+	// 调用后端服务执行
+	rsp, err := a.AppStoreService.FindAppInstanceByID(ctx, &appstore.AppInstanceID{
+		Id: id,
+	})
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+
+	// 数据回写
+	response.StatusCode = 200
+	bytes, _ := json.Marshal(rsp)
+	response.Body = string(bytes)
+	return nil
+}
+
 // setImage 设置图片
 func (a *AppStoreApi) setImage(request *appstoreApi.Request, info *appstore.AppStoreInfo) {
 	data, ok := request.Post["app_image"]
@@ -285,7 +285,7 @@ var file_proto_appstoreApi_appstoreApi_proto_rawDesc = []byte{
 	0x6b, 0x65, 0x79, 0x12, 0x27, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x41, 0x70, 0x69,
 	0x2e, 0x50, 0x61, 0x69, 0x72, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
-	0x32, 0xc1, 0x04, 0x0a, 0x0b, 0x41, 0x70, 0x70, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x41, 0x70, 0x69,
+	0x32, 0xc4, 0x05, 0x0a, 0x0b, 0x41, 0x70, 0x70, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x41, 0x70, 0x69,
 	0x12, 0x3c, 0x0a, 0x0b, 0x41, 0x64, 0x64, 0x41, 0x70, 0x70, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x12,
 	0x14, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x41, 0x70, 0x69, 0x2e, 0x52, 0x65,
 	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x74, 0x6f, 0x72, 0x65,
@@ -321,9 +321,18 @@ var file_proto_appstoreApi_appstoreApi_proto_rawDesc = []byte{
 	0x65, 0x77, 0x4e, 0x75, 0x6d, 0x12, 0x14, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x74, 0x6f, 0x72, 0x65,
 	0x41, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x61, 0x70,
 	0x70, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x41, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x42, 0x21, 0x5a, 0x1f, 0x2e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
-	0x61, 0x70, 0x70, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x41, 0x70, 0x69, 0x3b, 0x61, 0x70, 0x70, 0x73,
-	0x74, 0x6f, 0x72, 0x65, 0x41, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x0a, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x41,
+	0x70, 0x70, 0x12, 0x14, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x41, 0x70, 0x69,
+	0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x41, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x44, 0x0a, 0x13, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x42, 0x79, 0x49, 0x44, 0x12, 0x14, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x41, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15,
+	0x2e, 0x61, 0x70, 0x70, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x41, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x21, 0x5a, 0x1f, 0x2e, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2f, 0x61, 0x70, 0x70, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x41, 0x70, 0x69, 0x3b, 0x61,
+	0x70, 0x70, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x41, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -366,17 +375,21 @@ var file_proto_appstoreApi_appstoreApi_proto_depIdxs = []int32{
 	1,  // 14: appstoreApi.AppStoreApi.GetInstallNum:input_type -> appstoreApi.Request
 	1,  // 15: appstoreApi.AppStoreApi.AddViewNum:input_type -> appstoreApi.Request
 	1,  // 16: appstoreApi.AppStoreApi.GetViewNum:input_type -> appstoreApi.Request
-	2,  // 17: appstoreApi.AppStoreApi.AddAppStore:output_type -> appstoreApi.Response
-	2,  // 18: appstoreApi.AppStoreApi.DeleteAppStore:output_type -> appstoreApi.Response
-	2,  // 19: appstoreApi.AppStoreApi.UpdateAppStore:output_type -> appstoreApi.Response
-	2,  // 20: appstoreApi.AppStoreApi.FindAppStoreByID:output_type -> appstoreApi.Response
-	2,  // 21: appstoreApi.AppStoreApi.Call:output_type -> appstoreApi.Response
-	2,  // 22: appstoreApi.AppStoreApi.AddInstallNum:output_type -> appstoreApi.Response
-	2,  // 23: appstoreApi.AppStoreApi.GetInstallNum:output_type -> appstoreApi.Response
-	2,  // 24: appstoreApi.AppStoreApi.AddViewNum:output_type -> appstoreApi.Response
-	2,  // 25: appstoreApi.AppStoreApi.GetViewNum:output_type -> appstoreApi.Response
-	17, // [17:26] is the sub-list for method output_type
-	8,  // [8:17] is the sub-list for method input_type
+	1,  // 17: appstoreApi.AppStoreApi.InstallApp:input_type -> appstoreApi.Request
+	1,  // 18: appstoreApi.AppStoreApi.FindAppInstanceByID:input_type -> appstoreApi.Request
+	2,  // 19: appstoreApi.AppStoreApi.AddAppStore:output_type -> appstoreApi.Response
+	2,  // 20: appstoreApi.AppStoreApi.DeleteAppStore:output_type -> appstoreApi.Response
+	2,  // 21: appstoreApi.AppStoreApi.UpdateAppStore:output_type -> appstoreApi.Response
+	2,  // 22: appstoreApi.AppStoreApi.FindAppStoreByID:output_type -> appstoreApi.Response
+	2,  // 23: appstoreApi.AppStoreApi.Call:output_type -> appstoreApi.Response
+	2,  // 24: appstoreApi.AppStoreApi.AddInstallNum:output_type -> appstoreApi.Response
+	2,  // 25: appstoreApi.AppStoreApi.GetInstallNum:output_type -> appstoreApi.Response
+	2,  // 26: appstoreApi.AppStoreApi.AddViewNum:output_type -> appstoreApi.Response
+	2,  // 27: appstoreApi.AppStoreApi.GetViewNum:output_type -> appstoreApi.Response
+	2,  // 28: appstoreApi.AppStoreApi.InstallApp:output_type -> appstoreApi.Response
+	2,  // 29: appstoreApi.AppStoreApi.FindAppInstanceByID:output_type -> appstoreApi.Response
+	19, // [19:30] is the sub-list for method output_type
+	8,  // [8:19] is the sub-list for method input_type
 	8,  // [8:8] is the sub-list for extension type_name
 	8,  // [8:8] is the sub-list for extension extendee
 	0,  // [0:8] is the sub-list for field type_name
@@ -45,6 +45,8 @@ type AppStoreApiService interface {
 	GetInstallNum(ctx context.Context, in *Request, opts ...client.CallOption) (*Response, error)
 	AddViewNum(ctx context.Context, in *Request, opts ...client.CallOption) (*Response, error)
 	GetViewNum(ctx context.Context, in *Request, opts ...client.CallOption) (*Response, error)
+	InstallApp(ctx context.Context, in *Request, opts ...client.CallOption) (*Response, error)
+	FindAppInstanceByID(ctx context.Context, in *Request, opts ...client.CallOption) (*Response, error)
 }
 
 type appStoreApiService struct {
@@ -149,6 +151,26 @@ func (c *appStoreApiService) GetViewNum(ctx context.Context, in *Request, opts .
 	return out, nil
 }
 
+func (c *appStoreApiService) InstallApp(ctx context.Context, in *Request, opts ...client.CallOption) (*Response, error) {
+	req := c.c.NewRequest(c.name, "AppStoreApi.InstallApp", in)
+	out := new(Response)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appStoreApiService) FindAppInstanceByID(ctx context.Context, in *Request, opts ...client.CallOption) (*Response, error) {
+	req := c.c.NewRequest(c.name, "AppStoreApi.FindAppInstanceByID", in)
+	out := new(Response)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for AppStoreApi service
 
 type AppStoreApiHandler interface {
@@ -161,6 +183,8 @@ type AppStoreApiHandler interface {
 	GetInstallNum(context.Context, *Request, *Response) error
 	AddViewNum(context.Context, *Request, *Response) error
 	GetViewNum(context.Context, *Request, *Response) error
+	InstallApp(context.Context, *Request, *Response) error
+	FindAppInstanceByID(context.Context, *Request, *Response) error
 }
 
 func RegisterAppStoreApiHandler(s server.Server, hdlr AppStoreApiHandler, opts ...server.HandlerOption) error {
@@ -174,6 +198,8 @@ func RegisterAppStoreApiHandler(s server.Server, hdlr AppStoreApiHandler, opts .
 		GetInstallNum(ctx context.Context, in *Request, out *Response) error
 		AddViewNum(ctx context.Context, in *Request, out *Response) error
 		GetViewNum(ctx context.Context, in *Request, out *Response) error
+		InstallApp(ctx context.Context, in *Request, out *Response) error
+		FindAppInstanceByID(ctx context.Context, in *Request, out *Response) error
 	}
 	type AppStoreApi struct {
 		appStoreApi
@@ -221,3 +247,11 @@ func (h *appStoreApiHandler) AddViewNum(ctx context.Context, in *Request, out *R
 func (h *appStoreApiHandler) GetViewNum(ctx context.Context, in *Request, out *Response) error {
 	return h.AppStoreApiHandler.GetViewNum(ctx, in, out)
 }
+
+func (h *appStoreApiHandler) InstallApp(ctx context.Context, in *Request, out *Response) error {
+	return h.AppStoreApiHandler.InstallApp(ctx, in, out)
+}
+
+func (h *appStoreApiHandler) FindAppInstanceByID(ctx context.Context, in *Request, out *Response) error {
+	return h.AppStoreApiHandler.FindAppInstanceByID(ctx, in, out)
+}
@@ -0,0 +1,142 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"tini-paas/api/buildapi/proto/buildApi"
+	"tini-paas/internal/build/proto/build"
+	"tini-paas/pkg/common"
+	"tini-paas/plugin/form"
+)
+
+// BuildApi handler 调用build的客户端API接口
+type BuildApi struct {
+	BuildServer build.BuildService
+}
+
+func (b *BuildApi) AddBuild(ctx context.Context, req *buildApi.Request, rsp *buildApi.Response) error {
+	addBuildInfo := &build.BuildInfo{}
+
+	// 将req.Post信息转换为BuildInfo
+	form.FormToBuildStruct(req.Post, addBuildInfo)
+
+	// 提交构建
+	response, err := b.BuildServer.AddBuild(ctx, addBuildInfo)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+
+	// 数据回写
+	rsp.StatusCode = 200
+	bytes, _ := json.Marshal(response)
+	rsp.Body = string(bytes)
+	return nil
+}
+
+func (b *BuildApi) DeleteBuildByID(ctx context.Context, req *buildApi.Request, rsp *buildApi.Response) error {
+	// 先查询id是否可以解析
+	if _, ok := req.Get["build_id"]; !ok {
+		rsp.StatusCode = 500
+		return errors.New("参数异常")
+	}
+
+	// 获取build_id
+	buildIDString := req.Get["build_id"].Values[0]
+	buildID, err := strconv.ParseInt(buildIDString, 10, 64)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+
+	// 执行删除服务
+	response, err := b.BuildServer.DeleteBuild(ctx, &build.BuildID{
+		Id: buildID,
+	})
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+
+	// 数据回写
+	rsp.StatusCode = 200
+	bytes, _ := json.Marshal(response)
+	rsp.Body = string(bytes)
+	return nil
+}
+
+func (b *BuildApi) FindBuildByID(ctx context.Context, req *buildApi.Request, rsp *buildApi.Response) error {
+	if _, ok := req.Get["build_id"]; !ok {
+		rsp.StatusCode = 500
+		return errors.New("参数异常")
+	}
+
+	// 获取build_id
+	buildIDString := req.Get["build_id"].Values[0]
+	buildID, err := strconv.ParseInt(buildIDString, 10, 64)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+
+	// 执行查询
+	buildInfo, err := b.BuildServer.FindBuildByID(ctx, &build.BuildID{
+		Id: buildID,
+	})
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+
+	// 数据回写
+	rsp.StatusCode = 200
+	bytes, _ := json.Marshal(buildInfo)
+	rsp.Body = string(bytes)
+	return nil
+}
+
+func (b *BuildApi) FindAllBuildByPodID(ctx context.Context, req *buildApi.Request, rsp *buildApi.Response) error {
+	if _, ok := req.Get["pod_id"]; !ok {
+		rsp.StatusCode = 500
+		return errors.New("参数异常")
+	}
+
+	// 获取pod_id
+	podIDString := req.Get["pod_id"].Values[0]
+	podID, err := strconv.ParseInt(podIDString, 10, 64)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+
+	// 查询pod的构建历史
+	allBuild, err := b.BuildServer.FindAllBuildByPodID(ctx, &build.BuildPodID{
+		PodId: podID,
+	})
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+
+	// 数据回写
+	rsp.StatusCode = 200
+	bytes, _ := json.Marshal(allBuild)
+	rsp.Body = string(bytes)
+	return nil
+}
+
+func (b *BuildApi) Call(ctx context.Context, req *buildApi.Request, rsp *buildApi.Response) error {
+	allBuild, err := b.BuildServer.FindAllBuild(ctx, &build.FindAll{})
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+
+	// 数据回写
+	rsp.StatusCode = 200
+	bytes, _ := json.Marshal(allBuild)
+	rsp.Body = string(bytes)
+	return nil
+}
@@ -0,0 +1,129 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"tini-paas/api/middlewareapi/proto/middlewareApi"
+	"tini-paas/internal/middleware/proto/middleware"
+	"tini-paas/pkg/common"
+	"tini-paas/plugin/form"
+)
+
+// SetBackupPolicy 设置中间件的定时备份策略
+// MiddlewareApi.SetBackupPolicy 通过API向外暴露为/middlewareApi/SetBackupPolicy, 接收http请求
+func (m *MiddlewareApi) SetBackupPolicy(ctx context.Context, req *middlewareApi.Request, rsp *middlewareApi.Response) error {
+	policy := &middleware.MiddleBackupPolicy{}
+	form.FormToMiddlewareStruct(req.Post, policy)
+	if policy.MiddleId == 0 {
+		rsp.StatusCode = 500
+		return errors.New("参数异常")
+	}
+
+	response, err := m.MiddlewareService.SetBackupPolicy(ctx, policy)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+
+	// 数据回写
+	rsp.StatusCode = 200
+	bytes, _ := json.Marshal(response)
+	rsp.Body = string(bytes)
+	return nil
+}
+
+// FindBackupPolicy 查找中间件的备份策略
+// MiddlewareApi.FindBackupPolicy 通过API向外暴露为/middlewareApi/FindBackupPolicy, 接收http请求
+func (m *MiddlewareApi) FindBackupPolicy(ctx context.Context, req *middlewareApi.Request, rsp *middlewareApi.Response) error {
+	middleID, err := getInt64(req.Get, "middle_id")
+	if err != nil {
+		rsp.StatusCode = 500
+		return err
+	}
+
+	policy, err := m.MiddlewareService.FindBackupPolicy(ctx, &middleware.MiddlewareID{Id: middleID})
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+
+	// 数据回写
+	rsp.StatusCode = 200
+	bytes, _ := json.Marshal(policy)
+	rsp.Body = string(bytes)
+	return nil
+}
+
+// DeleteBackupPolicy 删除中间件的备份策略
+// MiddlewareApi.DeleteBackupPolicy 通过API向外暴露为/middlewareApi/DeleteBackupPolicy, 接收http请求
+func (m *MiddlewareApi) DeleteBackupPolicy(ctx context.Context, req *middlewareApi.Request, rsp *middlewareApi.Response) error {
+	middleID, err := getInt64(req.Get, "middle_id")
+	if err != nil {
+		rsp.StatusCode = 500
+		return err
+	}
+
+	response, err := m.MiddlewareService.DeleteBackupPolicy(ctx, &middleware.MiddlewareID{Id: middleID})
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+
+	// 数据回写
+	rsp.StatusCode = 200
+	bytes, _ := json.Marshal(response)
+	rsp.Body = string(bytes)
+	return nil
+}
+
+// ListBackups 查找中间件的备份记录
+// MiddlewareApi.ListBackups 通过API向外暴露为/middlewareApi/ListBackups, 接收http请求
+func (m *MiddlewareApi) ListBackups(ctx context.Context, req *middlewareApi.Request, rsp *middlewareApi.Response) error {
+	middleID, err := getInt64(req.Get, "middle_id")
+	if err != nil {
+		rsp.StatusCode = 500
+		return err
+	}
+
+	backups, err := m.MiddlewareService.ListBackups(ctx, &middleware.MiddlewareID{Id: middleID})
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+
+	// 数据回写
+	rsp.StatusCode = 200
+	bytes, _ := json.Marshal(backups)
+	rsp.Body = string(bytes)
+	return nil
+}
+
+// RestoreMiddleware 从备份恢复中间件，into_new_instance=true 时恢复到新创建的中间件
+// MiddlewareApi.RestoreMiddleware 通过API向外暴露为/middlewareApi/RestoreMiddleware, 接收http请求
+func (m *MiddlewareApi) RestoreMiddleware(ctx context.Context, req *middlewareApi.Request, rsp *middlewareApi.Response) error {
+	backupID, err := getInt64(req.Get, "backup_id")
+	if err != nil {
+		rsp.StatusCode = 500
+		return err
+	}
+	intoNewInstance := false
+	if pair, ok := req.Get["into_new_instance"]; ok && len(pair.Values) > 0 {
+		intoNewInstance = pair.Values[0] == "true"
+	}
+
+	response, err := m.MiddlewareService.RestoreMiddleware(ctx, &middleware.RestoreRequest{
+		BackupId:        backupID,
+		IntoNewInstance: intoNewInstance,
+	})
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+
+	// 数据回写
+	rsp.StatusCode = 200
+	bytes, _ := json.Marshal(response)
+	rsp.Body = string(bytes)
+	return nil
+}
@@ -403,3 +403,150 @@ func (m *MiddlewareApi) getValue(request *middlewareApi.Request, key string) str
 	}
 	return ""
 }
+
+// FindMiddlewareByNamespaceAndName 根据命名空间和名称查找
+// MiddlewareApi.FindMiddlewareByNamespaceAndName 通过API向外暴露为/middlewareApi/FindMiddlewareByNamespaceAndName, 接收http请求
+func (m *MiddlewareApi) FindMiddlewareByNamespaceAndName(ctx context.Context, req *middlewareApi.Request, rsp *middlewareApi.Response) error {
+	namespace, ok := req.Get["middle_namespace"]
+	if !ok || len(namespace.Values) == 0 {
+		rsp.StatusCode = 500
+		return errors.New("参数异常")
+	}
+	name, ok := req.Get["middle_name"]
+	if !ok || len(name.Values) == 0 {
+		rsp.StatusCode = 500
+		return errors.New("参数异常")
+	}
+
+	// 执行查询
+	info, err := m.MiddlewareService.FindMiddlewareByNamespaceAndName(ctx, &middleware.MiddlewareNamespaceName{
+		Namespace: namespace.Values[0],
+		Name:      name.Values[0],
+	})
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+
+	// 数据回写
+	rsp.StatusCode = 200
+	bytes, _ := json.Marshal(info)
+	rsp.Body = string(bytes)
+	return nil
+}
+
+// GetMiddlewareCredentials 获取中间件的账号密码，需要在 Authorization 请求头中携带登录令牌
+// MiddlewareApi.GetMiddlewareCredentials 通过API向外暴露为/middlewareApi/GetMiddlewareCredentials, 接收http请求
+func (m *MiddlewareApi) GetMiddlewareCredentials(ctx context.Context, req *middlewareApi.Request, rsp *middlewareApi.Response) error {
+	middleID, err := getInt64(req.Get, "middle_id")
+	if err != nil {
+		rsp.StatusCode = 500
+		return err
+	}
+	token, ok := req.Header[common.AuthHeader]
+	if !ok || len(token.Values) == 0 {
+		rsp.StatusCode = 401
+		return common.ErrUnauthenticated
+	}
+
+	// 由中间件服务校验令牌并鉴权
+	config, err := m.MiddlewareService.GetMiddlewareCredentials(common.WithToken(ctx, token.Values[0]), &middleware.CredentialsRequest{
+		MiddlewareId: middleID,
+	})
+	if err != nil {
+		common.Error(err)
+		rsp.StatusCode = 403
+		return err
+	}
+
+	// 数据回写
+	rsp.StatusCode = 200
+	bytes, _ := json.Marshal(config)
+	rsp.Body = string(bytes)
+	return nil
+}
+
+// ResizeMiddlewareStorage 在线扩容中间件的存储，middle_storage_size 为扩容后的大小(Gi)
+// MiddlewareApi.ResizeMiddlewareStorage 通过API向外暴露为/middlewareApi/ResizeMiddlewareStorage, 接收http请求
+func (m *MiddlewareApi) ResizeMiddlewareStorage(ctx context.Context, req *middlewareApi.Request, rsp *middlewareApi.Response) error {
+	middleID, err := getInt64(req.Get, "middle_id")
+	if err != nil {
+		rsp.StatusCode = 500
+		return err
+	}
+	name, ok := req.Get["middle_storage_name"]
+	if !ok || len(name.Values) == 0 {
+		rsp.StatusCode = 500
+		return errors.New("参数异常")
+	}
+	size, ok := req.Get["middle_storage_size"]
+	if !ok || len(size.Values) == 0 {
+		rsp.StatusCode = 500
+		return errors.New("参数异常")
+	}
+	storageSize, err := strconv.ParseFloat(size.Values[0], 32)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+
+	response, err := m.MiddlewareService.ResizeMiddlewareStorage(ctx, &middleware.MiddleStorageResize{
+		MiddleId:          middleID,
+		MiddleStorageName: name.Values[0],
+		MiddleStorageSize: float32(storageSize),
+	})
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+
+	// 数据回写
+	rsp.StatusCode = 200
+	bytes, _ := json.Marshal(response)
+	rsp.Body = string(bytes)
+	return nil
+}
+
+// getInt64 获取请求中的整数参数
+func getInt64(data map[string]*middlewareApi.Pair, key string) (int64, error) {
+	pair, ok := data[key]
+	if !ok || len(pair.Values) == 0 {
+		return 0, errors.New("参数异常")
+	}
+	return strconv.ParseInt(pair.Values[0], 10, 64)
+}
+
+// UpgradeMiddleware 升级中间件到 target_version_id，skip_backup=true 时跳过升级前备份
+// MiddlewareApi.UpgradeMiddleware 通过API向外暴露为/middlewareApi/UpgradeMiddleware, 接收http请求
+func (m *MiddlewareApi) UpgradeMiddleware(ctx context.Context, req *middlewareApi.Request, rsp *middlewareApi.Response) error {
+	middleID, err := getInt64(req.Get, "middle_id")
+	if err != nil {
+		rsp.StatusCode = 500
+		return err
+	}
+	versionID, err := getInt64(req.Get, "target_version_id")
+	if err != nil {
+		rsp.StatusCode = 500
+		return err
+	}
+	skipBackup := false
+	if pair, ok := req.Get["skip_backup"]; ok && len(pair.Values) > 0 {
+		skipBackup = pair.Values[0] == "true"
+	}
+
+	response, err := m.MiddlewareService.UpgradeMiddleware(ctx, &middleware.UpgradeRequest{
+		MiddleId:        middleID,
+		TargetVersionId: versionID,
+		SkipBackup:      skipBackup,
+	})
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+
+	// 数据回写
+	rsp.StatusCode = 200
+	bytes, _ := json.Marshal(response)
+	rsp.Body = string(bytes)
+	return nil
+}
@@ -41,8 +41,18 @@ type MiddlewareApiService interface {
 	DeleteMiddleware(ctx context.Context, in *Request, opts ...client.CallOption) (*Response, error)
 	UpdateMiddleware(ctx context.Context, in *Request, opts ...client.CallOption) (*Response, error)
 	FindMiddlewareByID(ctx context.Context, in *Request, opts ...client.CallOption) (*Response, error)
+	FindMiddlewareByNamespaceAndName(ctx context.Context, in *Request, opts ...client.CallOption) (*Response, error)
+	GetMiddlewareCredentials(ctx context.Context, in *Request, opts ...client.CallOption) (*Response, error)
 	Call(ctx context.Context, in *Request, opts ...client.CallOption) (*Response, error)
 	FindAllMiddlewareByTypeID(ctx context.Context, in *Request, opts ...client.CallOption) (*Response, error)
+	ResizeMiddlewareStorage(ctx context.Context, in *Request, opts ...client.CallOption) (*Response, error)
+	UpgradeMiddleware(ctx context.Context, in *Request, opts ...client.CallOption) (*Response, error)
+	// 中间件备份API
+	SetBackupPolicy(ctx context.Context, in *Request, opts ...client.CallOption) (*Response, error)
+	FindBackupPolicy(ctx context.Context, in *Request, opts ...client.CallOption) (*Response, error)
+	DeleteBackupPolicy(ctx context.Context, in *Request, opts ...client.CallOption) (*Response, error)
+	ListBackups(ctx context.Context, in *Request, opts ...client.CallOption) (*Response, error)
+	RestoreMiddleware(ctx context.Context, in *Request, opts ...client.CallOption) (*Response, error)
 	// 中间件类型API
 	AddMiddleType(ctx context.Context, in *Request, opts ...client.CallOption) (*Response, error)
 	DeleteMiddleType(ctx context.Context, in *Request, opts ...client.CallOption) (*Response, error)
@@ -103,6 +113,26 @@ func (c *middlewareApiService) FindMiddlewareByID(ctx context.Context, in *Reque
 	return out, nil
 }
 
+func (c *middlewareApiService) FindMiddlewareByNamespaceAndName(ctx context.Context, in *Request, opts ...client.CallOption) (*Response, error) {
+	req := c.c.NewRequest(c.name, "MiddlewareApi.FindMiddlewareByNamespaceAndName", in)
+	out := new(Response)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *middlewareApiService) GetMiddlewareCredentials(ctx context.Context, in *Request, opts ...client.CallOption) (*Response, error) {
+	req := c.c.NewRequest(c.name, "MiddlewareApi.GetMiddlewareCredentials", in)
+	out := new(Response)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *middlewareApiService) Call(ctx context.Context, in *Request, opts ...client.CallOption) (*Response, error) {
 	req := c.c.NewRequest(c.name, "MiddlewareApi.Call", in)
 	out := new(Response)
@@ -123,6 +153,76 @@ func (c *middlewareApiService) FindAllMiddlewareByTypeID(ctx context.Context, in
 	return out, nil
 }
 
+func (c *middlewareApiService) ResizeMiddlewareStorage(ctx context.Context, in *Request, opts ...client.CallOption) (*Response, error) {
+	req := c.c.NewRequest(c.name, "MiddlewareApi.ResizeMiddlewareStorage", in)
+	out := new(Response)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *middlewareApiService) UpgradeMiddleware(ctx context.Context, in *Request, opts ...client.CallOption) (*Response, error) {
+	req := c.c.NewRequest(c.name, "MiddlewareApi.UpgradeMiddleware", in)
+	out := new(Response)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *middlewareApiService) SetBackupPolicy(ctx context.Context, in *Request, opts ...client.CallOption) (*Response, error) {
+	req := c.c.NewRequest(c.name, "MiddlewareApi.SetBackupPolicy", in)
+	out := new(Response)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *middlewareApiService) FindBackupPolicy(ctx context.Context, in *Request, opts ...client.CallOption) (*Response, error) {
+	req := c.c.NewRequest(c.name, "MiddlewareApi.FindBackupPolicy", in)
+	out := new(Response)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *middlewareApiService) DeleteBackupPolicy(ctx context.Context, in *Request, opts ...client.CallOption) (*Response, error) {
+	req := c.c.NewRequest(c.name, "MiddlewareApi.DeleteBackupPolicy", in)
+	out := new(Response)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *middlewareApiService) ListBackups(ctx context.Context, in *Request, opts ...client.CallOption) (*Response, error) {
+	req := c.c.NewRequest(c.name, "MiddlewareApi.ListBackups", in)
+	out := new(Response)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *middlewareApiService) RestoreMiddleware(ctx context.Context, in *Request, opts ...client.CallOption) (*Response, error) {
+	req := c.c.NewRequest(c.name, "MiddlewareApi.RestoreMiddleware", in)
+	out := new(Response)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *middlewareApiService) AddMiddleType(ctx context.Context, in *Request, opts ...client.CallOption) (*Response, error) {
 	req := c.c.NewRequest(c.name, "MiddlewareApi.AddMiddleType", in)
 	out := new(Response)
@@ -181,8 +281,18 @@ type MiddlewareApiHandler interface {
 	DeleteMiddleware(context.Context, *Request, *Response) error
 	UpdateMiddleware(context.Context, *Request, *Response) error
 	FindMiddlewareByID(context.Context, *Request, *Response) error
+	FindMiddlewareByNamespaceAndName(context.Context, *Request, *Response) error
+	GetMiddlewareCredentials(context.Context, *Request, *Response) error
 	Call(context.Context, *Request, *Response) error
 	FindAllMiddlewareByTypeID(context.Context, *Request, *Response) error
+	ResizeMiddlewareStorage(context.Context, *Request, *Response) error
+	UpgradeMiddleware(context.Context, *Request, *Response) error
+	// 中间件备份API
+	SetBackupPolicy(context.Context, *Request, *Response) error
+	FindBackupPolicy(context.Context, *Request, *Response) error
+	DeleteBackupPolicy(context.Context, *Request, *Response) error
+	ListBackups(context.Context, *Request, *Response) error
+	RestoreMiddleware(context.Context, *Request, *Response) error
 	// 中间件类型API
 	AddMiddleType(context.Context, *Request, *Response) error
 	DeleteMiddleType(context.Context, *Request, *Response) error
@@ -197,8 +307,17 @@ func RegisterMiddlewareApiHandler(s server.Server, hdlr MiddlewareApiHandler, op
 		DeleteMiddleware(ctx context.Context, in *Request, out *Response) error
 		UpdateMiddleware(ctx context.Context, in *Request, out *Response) error
 		FindMiddlewareByID(ctx context.Context, in *Request, out *Response) error
+		FindMiddlewareByNamespaceAndName(ctx context.Context, in *Request, out *Response) error
+		GetMiddlewareCredentials(ctx context.Context, in *Request, out *Response) error
 		Call(ctx context.Context, in *Request, out *Response) error
 		FindAllMiddlewareByTypeID(ctx context.Context, in *Request, out *Response) error
+		ResizeMiddlewareStorage(ctx context.Context, in *Request, out *Response) error
+		UpgradeMiddleware(ctx context.Context, in *Request, out *Response) error
+		SetBackupPolicy(ctx context.Context, in *Request, out *Response) error
+		FindBackupPolicy(ctx context.Context, in *Request, out *Response) error
+		DeleteBackupPolicy(ctx context.Context, in *Request, out *Response) error
+		ListBackups(ctx context.Context, in *Request, out *Response) error
+		RestoreMiddleware(ctx context.Context, in *Request, out *Response) error
 		AddMiddleType(ctx context.Context, in *Request, out *Response) error
 		DeleteMiddleType(ctx context.Context, in *Request, out *Response) error
 		UpdateMiddleType(ctx context.Context, in *Request, out *Response) error
@@ -232,6 +351,14 @@ func (h *middlewareApiHandler) FindMiddlewareByID(ctx context.Context, in *Reque
 	return h.MiddlewareApiHandler.FindMiddlewareByID(ctx, in, out)
 }
 
+func (h *middlewareApiHandler) FindMiddlewareByNamespaceAndName(ctx context.Context, in *Request, out *Response) error {
+	return h.MiddlewareApiHandler.FindMiddlewareByNamespaceAndName(ctx, in, out)
+}
+
+func (h *middlewareApiHandler) GetMiddlewareCredentials(ctx context.Context, in *Request, out *Response) error {
+	return h.MiddlewareApiHandler.GetMiddlewareCredentials(ctx, in, out)
+}
+
 func (h *middlewareApiHandler) Call(ctx context.Context, in *Request, out *Response) error {
 	return h.MiddlewareApiHandler.Call(ctx, in, out)
 }
@@ -240,6 +367,34 @@ func (h *middlewareApiHandler) FindAllMiddlewareByTypeID(ctx context.Context, in
 	return h.MiddlewareApiHandler.FindAllMiddlewareByTypeID(ctx, in, out)
 }
 
+func (h *middlewareApiHandler) ResizeMiddlewareStorage(ctx context.Context, in *Request, out *Response) error {
+	return h.MiddlewareApiHandler.ResizeMiddlewareStorage(ctx, in, out)
+}
+
+func (h *middlewareApiHandler) UpgradeMiddleware(ctx context.Context, in *Request, out *Response) error {
+	return h.MiddlewareApiHandler.UpgradeMiddleware(ctx, in, out)
+}
+
+func (h *middlewareApiHandler) SetBackupPolicy(ctx context.Context, in *Request, out *Response) error {
+	return h.MiddlewareApiHandler.SetBackupPolicy(ctx, in, out)
+}
+
+func (h *middlewareApiHandler) FindBackupPolicy(ctx context.Context, in *Request, out *Response) error {
+	return h.MiddlewareApiHandler.FindBackupPolicy(ctx, in, out)
+}
+
+func (h *middlewareApiHandler) DeleteBackupPolicy(ctx context.Context, in *Request, out *Response) error {
+	return h.MiddlewareApiHandler.DeleteBackupPolicy(ctx, in, out)
+}
+
+func (h *middlewareApiHandler) ListBackups(ctx context.Context, in *Request, out *Response) error {
+	return h.MiddlewareApiHandler.ListBackups(ctx, in, out)
+}
+
+func (h *middlewareApiHandler) RestoreMiddleware(ctx context.Context, in *Request, out *Response) error {
+	return h.MiddlewareApiHandler.RestoreMiddleware(ctx, in, out)
+}
+
 func (h *middlewareApiHandler) AddMiddleType(ctx context.Context, in *Request, out *Response) error {
 	return h.MiddlewareApiHandler.AddMiddleType(ctx, in, out)
 }
@@ -155,3 +155,34 @@ func (p *PodApi) Call(ctx context.Context, req *podApi.Request, rsp *podApi.Resp
 	rsp.Body = string(bytes)
 	return nil
 }
+
+// FindPodByNamespaceAndName 根据命名空间和名称查找
+// PodApi.FindPodByNamespaceAndName 通过API向外暴露为/podApi/FindPodByNamespaceAndName, 接收http请求
+func (p *PodApi) FindPodByNamespaceAndName(ctx context.Context, req *podApi.Request, rsp *podApi.Response) error {
+	namespace, ok := req.Get["pod_namespace"]
+	if !ok || len(namespace.Values) == 0 {
+		rsp.StatusCode = 500
+		return errors.New("参数异常")
+	}
+	name, ok := req.Get["pod_name"]
+	if !ok || len(name.Values) == 0 {
+		rsp.StatusCode = 500
+		return errors.New("参数异常")
+	}
+
+	// 执行查询
+	info, err := p.PodService.FindPodByNamespaceAndName(ctx, &pod.PodNamespaceName{
+		Namespace: namespace.Values[0],
+		Name:      name.Values[0],
+	})
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+
+	// 数据回写
+	rsp.StatusCode = 200
+	bytes, _ := json.Marshal(info)
+	rsp.Body = string(bytes)
+	return nil
+}
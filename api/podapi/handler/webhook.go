@@ -0,0 +1,197 @@
+package handler
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"os"
+	"strconv"
+	"strings"
+	"tini-paas/api/podapi/proto/podApi"
+	"tini-paas/internal/pod/proto/pod"
+	"tini-paas/pkg/common"
+)
+
+// webhookTokenEnv 校验推送通知的令牌，没有配置时拒绝全部推送通知
+const webhookTokenEnv = "PAAS_WEBHOOK_TOKEN"
+
+// registryNotification Docker Registry v2 推送通知
+// https://distribution.github.io/distribution/about/notifications/
+type registryNotification struct {
+	Events []struct {
+		Action string `json:"action"`
+		Target struct {
+			MediaType  string `json:"mediaType"`
+			Repository string `json:"repository"`
+			Tag        string `json:"tag"`
+			Digest     string `json:"digest"`
+		} `json:"target"`
+		Request struct {
+			Host string `json:"host"`
+		} `json:"request"`
+		Actor struct {
+			Name string `json:"name"`
+		} `json:"actor"`
+	} `json:"events"`
+}
+
+// harborNotification Harbor 推送通知
+type harborNotification struct {
+	Type      string `json:"type"`
+	Operator  string `json:"operator"`
+	EventData struct {
+		Resources []struct {
+			Digest      string `json:"digest"`
+			Tag         string `json:"tag"`
+			ResourceURL string `json:"resource_url"`
+		} `json:"resources"`
+		Repository struct {
+			RepoFullName string `json:"repo_full_name"`
+		} `json:"repository"`
+	} `json:"event_data"`
+}
+
+// ImageWebhook 接收镜像仓库的推送通知，支持 Docker Registry v2 和 Harbor 格式
+// PodApi.ImageWebhook 通过API向外暴露为/podApi/ImageWebhook, 接收http请求
+// 命中tag策略的pod会自动滚动更新
+func (p *PodApi) ImageWebhook(ctx context.Context, req *podApi.Request, rsp *podApi.Response) error {
+	if !checkWebhookToken(req) {
+		rsp.StatusCode = 401
+		return errors.New("令牌校验失败")
+	}
+
+	pushes, err := parseImagePushes(req.Body)
+	if err != nil {
+		common.Error(err)
+		rsp.StatusCode = 400
+		return err
+	}
+
+	// 逐个镜像通知pod服务
+	var msg []string
+	for _, push := range pushes {
+		response, err := p.PodService.ImagePushed(ctx, push)
+		if err != nil {
+			common.Error(err)
+			return err
+		}
+		msg = append(msg, response.Msg)
+	}
+
+	// 数据回写
+	rsp.StatusCode = 200
+	bytes, _ := json.Marshal(map[string]interface{}{
+		"events": len(pushes),
+		"msg":    msg,
+	})
+	rsp.Body = string(bytes)
+	return nil
+}
+
+// FindDeployAuditByPodID 查找pod的自动发布记录
+// PodApi.FindDeployAuditByPodID 通过API向外暴露为/podApi/FindDeployAuditByPodID, 接收http请求
+func (p *PodApi) FindDeployAuditByPodID(ctx context.Context, req *podApi.Request, rsp *podApi.Response) error {
+	if _, ok := req.Get["pod_id"]; !ok {
+		rsp.StatusCode = 500
+		return errors.New("参数异常")
+	}
+
+	// 获取pod_id
+	podIDString := req.Get["pod_id"].Values[0]
+	podID, err := strconv.ParseInt(podIDString, 10, 64)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+
+	allAudit, err := p.PodService.FindDeployAuditByPodID(ctx, &pod.PodID{
+		Id: podID,
+	})
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+
+	// 数据回写
+	rsp.StatusCode = 200
+	bytes, _ := json.Marshal(allAudit)
+	rsp.Body = string(bytes)
+	return nil
+}
+
+// checkWebhookToken 校验 Authorization 请求头或者 token 参数
+// 没有配置令牌时拒绝请求，避免任何人都可以触发发布；比较时间恒定，避免通过响应时间猜测令牌
+func checkWebhookToken(req *podApi.Request) bool {
+	token := os.Getenv(webhookTokenEnv)
+	if token == "" {
+		common.Error("没有配置 " + webhookTokenEnv + "，拒绝镜像推送通知")
+		return false
+	}
+	if pair, ok := req.Header["Authorization"]; ok && len(pair.Values) > 0 {
+		if tokenEqual(strings.TrimPrefix(pair.Values[0], "Bearer "), token) {
+			return true
+		}
+	}
+	if pair, ok := req.Get["token"]; ok && len(pair.Values) > 0 {
+		return tokenEqual(pair.Values[0], token)
+	}
+	return false
+}
+
+// tokenEqual 以恒定时间比较令牌
+func tokenEqual(given, token string) bool {
+	return subtle.ConstantTimeCompare([]byte(given), []byte(token)) == 1
+}
+
+// parseImagePushes 解析推送通知，只保留推送事件
+func parseImagePushes(body string) ([]*pod.ImagePush, error) {
+	var pushes []*pod.ImagePush
+
+	// Harbor 通知带有 type 字段
+	harbor := &harborNotification{}
+	if err := json.Unmarshal([]byte(body), harbor); err == nil && harbor.Type != "" {
+		if harbor.Type != "PUSH_ARTIFACT" && harbor.Type != "pushImage" {
+			return pushes, nil
+		}
+		for _, resource := range harbor.EventData.Resources {
+			pushes = append(pushes, &pod.ImagePush{
+				Host:       resourceHost(resource.ResourceURL),
+				Repository: harbor.EventData.Repository.RepoFullName,
+				Tag:        resource.Tag,
+				Digest:     resource.Digest,
+				Source:     "harbor",
+				Operator:   harbor.Operator,
+			})
+		}
+		return pushes, nil
+	}
+
+	registry := &registryNotification{}
+	if err := json.Unmarshal([]byte(body), registry); err != nil {
+		return nil, errors.New("无法解析推送通知：" + err.Error())
+	}
+	for _, event := range registry.Events {
+		// 推送镜像层和没有tag的推送不处理
+		if event.Action != "push" || event.Target.Tag == "" {
+			continue
+		}
+		pushes = append(pushes, &pod.ImagePush{
+			Host:       event.Request.Host,
+			Repository: event.Target.Repository,
+			Tag:        event.Target.Tag,
+			Digest:     event.Target.Digest,
+			Source:     "registry",
+			Operator:   event.Actor.Name,
+		})
+	}
+	return pushes, nil
+}
+
+// resourceHost 从 harbor.example.com/library/nginx:1.0 中取出仓库地址
+func resourceHost(resourceURL string) string {
+	if i := strings.Index(resourceURL, "/"); i > 0 {
+		return resourceURL[:i]
+	}
+	return ""
+}
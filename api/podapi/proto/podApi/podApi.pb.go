@@ -282,25 +282,37 @@ var file_proto_podapi_podApi_proto_rawDesc = []byte{
 	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
 	0x79, 0x12, 0x22, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x0c, 0x2e, 0x70, 0x6f, 0x64, 0x41, 0x70, 0x69, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x32, 0x80, 0x02, 0x0a, 0x06, 0x50, 0x6f,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x32, 0xb6, 0x03, 0x0a, 0x06, 0x50, 0x6f,
 	0x64, 0x41, 0x70, 0x69, 0x12, 0x32, 0x0a, 0x0b, 0x46, 0x69, 0x6e, 0x64, 0x50, 0x6f, 0x64, 0x42,
 	0x79, 0x49, 0x44, 0x12, 0x0f, 0x2e, 0x70, 0x6f, 0x64, 0x41, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70, 0x6f, 0x64, 0x41, 0x70, 0x69, 0x2e, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x2d, 0x0a, 0x06, 0x41, 0x64, 0x64, 0x50,
-	0x6f, 0x64, 0x12, 0x0f, 0x2e, 0x70, 0x6f, 0x64, 0x41, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70, 0x6f, 0x64, 0x41, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x50, 0x6f, 0x64, 0x42, 0x79, 0x49, 0x44, 0x12, 0x0f, 0x2e, 0x70, 0x6f, 0x64, 0x41, 0x70,
-	0x69, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70, 0x6f, 0x64, 0x41,
-	0x70, 0x69, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x30, 0x0a,
-	0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x64, 0x12, 0x0f, 0x2e, 0x70, 0x6f, 0x64,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x19, 0x46, 0x69, 0x6e, 0x64,
+	0x50, 0x6f, 0x64, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x41, 0x6e,
+	0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x0f, 0x2e, 0x70, 0x6f, 0x64, 0x41, 0x70, 0x69, 0x2e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70, 0x6f, 0x64, 0x41, 0x70, 0x69, 0x2e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x2d, 0x0a, 0x06, 0x41, 0x64,
+	0x64, 0x50, 0x6f, 0x64, 0x12, 0x0f, 0x2e, 0x70, 0x6f, 0x64, 0x41, 0x70, 0x69, 0x2e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70, 0x6f, 0x64, 0x41, 0x70, 0x69, 0x2e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x0d, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x50, 0x6f, 0x64, 0x42, 0x79, 0x49, 0x44, 0x12, 0x0f, 0x2e, 0x70, 0x6f, 0x64,
 	0x41, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70, 0x6f,
 	0x64, 0x41, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x2b, 0x0a, 0x04, 0x43, 0x61, 0x6c, 0x6c, 0x12, 0x0f, 0x2e, 0x70, 0x6f, 0x64, 0x41, 0x70, 0x69,
-	0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70, 0x6f, 0x64, 0x41, 0x70,
-	0x69, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x17, 0x5a, 0x15,
-	0x2e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x70, 0x6f, 0x64, 0x41, 0x70, 0x69, 0x3b, 0x70,
-	0x6f, 0x64, 0x41, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x30, 0x0a, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x64, 0x12, 0x0f, 0x2e, 0x70,
+	0x6f, 0x64, 0x41, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e,
+	0x70, 0x6f, 0x64, 0x41, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x2b, 0x0a, 0x04, 0x43, 0x61, 0x6c, 0x6c, 0x12, 0x0f, 0x2e, 0x70, 0x6f, 0x64, 0x41,
+	0x70, 0x69, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70, 0x6f, 0x64,
+	0x41, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x33,
+	0x0a, 0x0c, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x12, 0x0f,
+	0x2e, 0x70, 0x6f, 0x64, 0x41, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x10, 0x2e, 0x70, 0x6f, 0x64, 0x41, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x16, 0x46, 0x69, 0x6e, 0x64, 0x44, 0x65, 0x70, 0x6c, 0x6f,
+	0x79, 0x41, 0x75, 0x64, 0x69, 0x74, 0x42, 0x79, 0x50, 0x6f, 0x64, 0x49, 0x44, 0x12, 0x0f, 0x2e,
+	0x70, 0x6f, 0x64, 0x41, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10,
+	0x2e, 0x70, 0x6f, 0x64, 0x41, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x42, 0x17, 0x5a, 0x15, 0x2e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x70, 0x6f,
+	0x64, 0x41, 0x70, 0x69, 0x3b, 0x70, 0x6f, 0x64, 0x41, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
@@ -335,17 +347,23 @@ var file_proto_podapi_podApi_proto_depIdxs = []int32{
 	0,  // 6: podApi.Request.PostEntry.value:type_name -> podApi.Pair
 	0,  // 7: podApi.Response.HeaderEntry.value:type_name -> podApi.Pair
 	1,  // 8: podApi.PodApi.FindPodByID:input_type -> podApi.Request
-	1,  // 9: podApi.PodApi.AddPod:input_type -> podApi.Request
-	1,  // 10: podApi.PodApi.DeletePodByID:input_type -> podApi.Request
-	1,  // 11: podApi.PodApi.UpdatePod:input_type -> podApi.Request
-	1,  // 12: podApi.PodApi.Call:input_type -> podApi.Request
-	2,  // 13: podApi.PodApi.FindPodByID:output_type -> podApi.Response
-	2,  // 14: podApi.PodApi.AddPod:output_type -> podApi.Response
-	2,  // 15: podApi.PodApi.DeletePodByID:output_type -> podApi.Response
-	2,  // 16: podApi.PodApi.UpdatePod:output_type -> podApi.Response
-	2,  // 17: podApi.PodApi.Call:output_type -> podApi.Response
-	13, // [13:18] is the sub-list for method output_type
-	8,  // [8:13] is the sub-list for method input_type
+	1,  // 9: podApi.PodApi.FindPodByNamespaceAndName:input_type -> podApi.Request
+	1,  // 10: podApi.PodApi.AddPod:input_type -> podApi.Request
+	1,  // 11: podApi.PodApi.DeletePodByID:input_type -> podApi.Request
+	1,  // 12: podApi.PodApi.UpdatePod:input_type -> podApi.Request
+	1,  // 13: podApi.PodApi.Call:input_type -> podApi.Request
+	1,  // 14: podApi.PodApi.ImageWebhook:input_type -> podApi.Request
+	1,  // 15: podApi.PodApi.FindDeployAuditByPodID:input_type -> podApi.Request
+	2,  // 16: podApi.PodApi.FindPodByID:output_type -> podApi.Response
+	2,  // 17: podApi.PodApi.FindPodByNamespaceAndName:output_type -> podApi.Response
+	2,  // 18: podApi.PodApi.AddPod:output_type -> podApi.Response
+	2,  // 19: podApi.PodApi.DeletePodByID:output_type -> podApi.Response
+	2,  // 20: podApi.PodApi.UpdatePod:output_type -> podApi.Response
+	2,  // 21: podApi.PodApi.Call:output_type -> podApi.Response
+	2,  // 22: podApi.PodApi.ImageWebhook:output_type -> podApi.Response
+	2,  // 23: podApi.PodApi.FindDeployAuditByPodID:output_type -> podApi.Response
+	16, // [16:24] is the sub-list for method output_type
+	8,  // [8:16] is the sub-list for method input_type
 	8,  // [8:8] is the sub-list for extension type_name
 	8,  // [8:8] is the sub-list for extension extendee
 	0,  // [0:8] is the sub-list for field type_name
@@ -37,10 +37,13 @@ func NewPodApiEndpoints() []*api.Endpoint {
 
 type PodApiService interface {
 	FindPodByID(ctx context.Context, in *Request, opts ...client.CallOption) (*Response, error)
+	FindPodByNamespaceAndName(ctx context.Context, in *Request, opts ...client.CallOption) (*Response, error)
 	AddPod(ctx context.Context, in *Request, opts ...client.CallOption) (*Response, error)
 	DeletePodByID(ctx context.Context, in *Request, opts ...client.CallOption) (*Response, error)
 	UpdatePod(ctx context.Context, in *Request, opts ...client.CallOption) (*Response, error)
 	Call(ctx context.Context, in *Request, opts ...client.CallOption) (*Response, error)
+	ImageWebhook(ctx context.Context, in *Request, opts ...client.CallOption) (*Response, error)
+	FindDeployAuditByPodID(ctx context.Context, in *Request, opts ...client.CallOption) (*Response, error)
 }
 
 type podApiService struct {
@@ -65,6 +68,16 @@ func (c *podApiService) FindPodByID(ctx context.Context, in *Request, opts ...cl
 	return out, nil
 }
 
+func (c *podApiService) FindPodByNamespaceAndName(ctx context.Context, in *Request, opts ...client.CallOption) (*Response, error) {
+	req := c.c.NewRequest(c.name, "PodApi.FindPodByNamespaceAndName", in)
+	out := new(Response)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *podApiService) AddPod(ctx context.Context, in *Request, opts ...client.CallOption) (*Response, error) {
 	req := c.c.NewRequest(c.name, "PodApi.AddPod", in)
 	out := new(Response)
@@ -105,23 +118,49 @@ func (c *podApiService) Call(ctx context.Context, in *Request, opts ...client.Ca
 	return out, nil
 }
 
+func (c *podApiService) ImageWebhook(ctx context.Context, in *Request, opts ...client.CallOption) (*Response, error) {
+	req := c.c.NewRequest(c.name, "PodApi.ImageWebhook", in)
+	out := new(Response)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *podApiService) FindDeployAuditByPodID(ctx context.Context, in *Request, opts ...client.CallOption) (*Response, error) {
+	req := c.c.NewRequest(c.name, "PodApi.FindDeployAuditByPodID", in)
+	out := new(Response)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for PodApi service
 
 type PodApiHandler interface {
 	FindPodByID(context.Context, *Request, *Response) error
+	FindPodByNamespaceAndName(context.Context, *Request, *Response) error
 	AddPod(context.Context, *Request, *Response) error
 	DeletePodByID(context.Context, *Request, *Response) error
 	UpdatePod(context.Context, *Request, *Response) error
 	Call(context.Context, *Request, *Response) error
+	ImageWebhook(context.Context, *Request, *Response) error
+	FindDeployAuditByPodID(context.Context, *Request, *Response) error
 }
 
 func RegisterPodApiHandler(s server.Server, hdlr PodApiHandler, opts ...server.HandlerOption) error {
 	type podApi interface {
 		FindPodByID(ctx context.Context, in *Request, out *Response) error
+		FindPodByNamespaceAndName(ctx context.Context, in *Request, out *Response) error
 		AddPod(ctx context.Context, in *Request, out *Response) error
 		DeletePodByID(ctx context.Context, in *Request, out *Response) error
 		UpdatePod(ctx context.Context, in *Request, out *Response) error
 		Call(ctx context.Context, in *Request, out *Response) error
+		ImageWebhook(ctx context.Context, in *Request, out *Response) error
+		FindDeployAuditByPodID(ctx context.Context, in *Request, out *Response) error
 	}
 	type PodApi struct {
 		podApi
@@ -138,6 +177,10 @@ func (h *podApiHandler) FindPodByID(ctx context.Context, in *Request, out *Respo
 	return h.PodApiHandler.FindPodByID(ctx, in, out)
 }
 
+func (h *podApiHandler) FindPodByNamespaceAndName(ctx context.Context, in *Request, out *Response) error {
+	return h.PodApiHandler.FindPodByNamespaceAndName(ctx, in, out)
+}
+
 func (h *podApiHandler) AddPod(ctx context.Context, in *Request, out *Response) error {
 	return h.PodApiHandler.AddPod(ctx, in, out)
 }
@@ -153,3 +196,11 @@ func (h *podApiHandler) UpdatePod(ctx context.Context, in *Request, out *Respons
 func (h *podApiHandler) Call(ctx context.Context, in *Request, out *Response) error {
 	return h.PodApiHandler.Call(ctx, in, out)
 }
+
+func (h *podApiHandler) ImageWebhook(ctx context.Context, in *Request, out *Response) error {
+	return h.PodApiHandler.ImageWebhook(ctx, in, out)
+}
+
+func (h *podApiHandler) FindDeployAuditByPodID(ctx context.Context, in *Request, out *Response) error {
+	return h.PodApiHandler.FindDeployAuditByPodID(ctx, in, out)
+}
@@ -20,29 +20,20 @@ type RouteApi struct {
 // 即：/routeApi/AddRoute 请求会调用go.micro.api.AddRoute 服务的routeApi.AddRoute 方法
 func (r *RouteApi) AddRoute(ctx context.Context, req *routeApi.Request, rsp *routeApi.Response) error {
 	addRouteInfo := &route.RouteInfo{}
-	routePathName, ok := req.Post["route_path_name"]
-
-	if ok && len(routePathName.Values) > 0 {
-		// port
-		port, err := strconv.ParseInt(req.Post["route_backend_service_port"].Values[0], 10, 64)
-		if err != nil {
-			common.Error(err)
-			return err
-		}
-
-		// 处理Path路径
-		routePath := &route.RoutePath{
-			RoutePathName:           req.Post["route_path_name"].Values[0],
-			RouteBackendService:     req.Post["route_backend_service"].Values[0],
-			RouteBackendServicePort: int32(port),
-		}
-
-		// 组装信息
-		addRouteInfo.RoutePath = append(addRouteInfo.RoutePath, routePath)
+	routePaths, err := getRoutePaths(req.Post)
+	if err != nil {
+		common.Error(err)
+		return err
 	}
+	addRouteInfo.RoutePath = routePaths
 
 	// 执行添加
 	form.FormToRouteStruct(req.Post, addRouteInfo)
+
+	// 设置原始注解时由路由服务根据登录令牌鉴权
+	if token, ok := req.Header[common.AuthHeader]; ok && len(token.Values) > 0 {
+		ctx = common.WithToken(ctx, token.Values[0])
+	}
 	response, err := r.RouteService.AddRoute(ctx, addRouteInfo)
 	if err != nil {
 		common.Error(err)
@@ -144,3 +135,107 @@ func (r *RouteApi) Call(ctx context.Context, req *routeApi.Request, rsp *routeAp
 	rsp.Body = string(bytes)
 	return nil
 }
+
+// FindRouteByNamespaceAndName 根据命名空间和名称查找
+// RouteApi.FindRouteByNamespaceAndName 通过API向外暴露为/routeApi/FindRouteByNamespaceAndName, 接收http请求
+func (r *RouteApi) FindRouteByNamespaceAndName(ctx context.Context, req *routeApi.Request, rsp *routeApi.Response) error {
+	namespace, ok := req.Get["route_namespace"]
+	if !ok || len(namespace.Values) == 0 {
+		rsp.StatusCode = 500
+		return errors.New("参数异常")
+	}
+	name, ok := req.Get["route_name"]
+	if !ok || len(name.Values) == 0 {
+		rsp.StatusCode = 500
+		return errors.New("参数异常")
+	}
+
+	// 执行查询
+	info, err := r.RouteService.FindRouteByNamespaceAndName(ctx, &route.RouteNamespaceName{
+		Namespace: namespace.Values[0],
+		Name:      name.Values[0],
+	})
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+
+	// 数据回写
+	rsp.StatusCode = 200
+	bytes, _ := json.Marshal(info)
+	rsp.Body = string(bytes)
+	return nil
+}
+
+// FindExpiringRoutes 查找证书即将到期、已经过期或者还没有签发的路由
+// RouteApi.FindExpiringRoutes 通过API向外暴露为/routeApi/FindExpiringRoutes, 接收http请求
+func (r *RouteApi) FindExpiringRoutes(ctx context.Context, req *routeApi.Request, rsp *routeApi.Response) error {
+	allRoute, err := r.RouteService.FindExpiringRoutes(ctx, &route.FindAll{})
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+
+	// 数据回写
+	rsp.StatusCode = 200
+	bytes, _ := json.Marshal(allRoute)
+	rsp.Body = string(bytes)
+	return nil
+}
+
+// getRoutePaths 解析表单中的路径，route_path_name、route_backend_service、route_backend_service_port、
+// route_path_type、route_path_host 按顺序一一对应，匹配方式和域名可以为空
+// gateway 模式的 route_header_matches、route_backend_weight、route_extra_backends、
+// route_mirror_service、route_mirror_service_port 同样按顺序对应，可以为空
+func getRoutePaths(data map[string]*routeApi.Pair) ([]*route.RoutePath, error) {
+	var routePaths []*route.RoutePath
+	names, ok := data["route_path_name"]
+	if !ok {
+		return routePaths, nil
+	}
+	value := func(key string, i int) string {
+		pair, ok := data[key]
+		if !ok || len(pair.Values) <= i {
+			return ""
+		}
+		return pair.Values[i]
+	}
+
+	// 可以为空的数字
+	optionalInt := func(key string, i int) (int32, error) {
+		v := value(key, i)
+		if v == "" {
+			return 0, nil
+		}
+		n, err := strconv.ParseInt(v, 10, 32)
+		return int32(n), err
+	}
+
+	for i, name := range names.Values {
+		port, err := strconv.ParseInt(value("route_backend_service_port", i), 10, 32)
+		if err != nil {
+			return nil, errors.New("路径 " + name + " 的服务端口格式错误")
+		}
+		weight, err := optionalInt("route_backend_weight", i)
+		if err != nil {
+			return nil, errors.New("路径 " + name + " 的权重格式错误")
+		}
+		mirrorPort, err := optionalInt("route_mirror_service_port", i)
+		if err != nil {
+			return nil, errors.New("路径 " + name + " 的镜像服务端口格式错误")
+		}
+		routePaths = append(routePaths, &route.RoutePath{
+			RoutePathName:           name,
+			RouteBackendService:     value("route_backend_service", i),
+			RouteBackendServicePort: int32(port),
+			RoutePathType:           value("route_path_type", i),
+			RoutePathHost:           value("route_path_host", i),
+			RouteHeaderMatches:      value("route_header_matches", i),
+			RouteBackendWeight:      weight,
+			RouteExtraBackends:      value("route_extra_backends", i),
+			RouteMirrorService:      value("route_mirror_service", i),
+			RouteMirrorServicePort:  mirrorPort,
+		})
+	}
+	return routePaths, nil
+}
@@ -283,7 +283,7 @@ var file_proto_routeApi_routeApi_proto_rawDesc = []byte{
 	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x24, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x41, 0x70, 0x69, 0x2e,
 	0x50, 0x61, 0x69, 0x72, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x32,
-	0x9a, 0x02, 0x0a, 0x08, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x41, 0x70, 0x69, 0x12, 0x33, 0x0a, 0x08,
+	0xa1, 0x03, 0x0a, 0x08, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x41, 0x70, 0x69, 0x12, 0x33, 0x0a, 0x08,
 	0x41, 0x64, 0x64, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x11, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65,
 	0x41, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x72, 0x6f,
 	0x75, 0x74, 0x65, 0x41, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
@@ -297,13 +297,21 @@ var file_proto_routeApi_routeApi_proto_rawDesc = []byte{
 	0x00, 0x12, 0x38, 0x0a, 0x0d, 0x46, 0x69, 0x6e, 0x64, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x42, 0x79,
 	0x49, 0x44, 0x12, 0x11, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x41, 0x70, 0x69, 0x2e, 0x52, 0x65,
 	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x41, 0x70, 0x69,
-	0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x2f, 0x0a, 0x04, 0x43,
-	0x61, 0x6c, 0x6c, 0x12, 0x11, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x41, 0x70, 0x69, 0x2e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x41, 0x70,
-	0x69, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x1b, 0x5a, 0x19,
-	0x2e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x41, 0x70, 0x69,
-	0x3b, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x41, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x1b, 0x46,
+	0x69, 0x6e, 0x64, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x41, 0x6e, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x11, 0x2e, 0x72, 0x6f, 0x75,
+	0x74, 0x65, 0x41, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e,
+	0x72, 0x6f, 0x75, 0x74, 0x65, 0x41, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x2f, 0x0a, 0x04, 0x43, 0x61, 0x6c, 0x6c, 0x12, 0x11, 0x2e, 0x72, 0x6f,
+	0x75, 0x74, 0x65, 0x41, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12,
+	0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x41, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x12, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x78, 0x70, 0x69,
+	0x72, 0x69, 0x6e, 0x67, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x12, 0x11, 0x2e, 0x72, 0x6f, 0x75,
+	0x74, 0x65, 0x41, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e,
+	0x72, 0x6f, 0x75, 0x74, 0x65, 0x41, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x42, 0x1b, 0x5a, 0x19, 0x2e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x72,
+	0x6f, 0x75, 0x74, 0x65, 0x41, 0x70, 0x69, 0x3b, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x41, 0x70, 0x69,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -341,14 +349,18 @@ var file_proto_routeApi_routeApi_proto_depIdxs = []int32{
 	1,  // 9: routeApi.RouteApi.DeleteRoute:input_type -> routeApi.Request
 	1,  // 10: routeApi.RouteApi.UpdateRoute:input_type -> routeApi.Request
 	1,  // 11: routeApi.RouteApi.FindRouteByID:input_type -> routeApi.Request
-	1,  // 12: routeApi.RouteApi.Call:input_type -> routeApi.Request
-	2,  // 13: routeApi.RouteApi.AddRoute:output_type -> routeApi.Response
-	2,  // 14: routeApi.RouteApi.DeleteRoute:output_type -> routeApi.Response
-	2,  // 15: routeApi.RouteApi.UpdateRoute:output_type -> routeApi.Response
-	2,  // 16: routeApi.RouteApi.FindRouteByID:output_type -> routeApi.Response
-	2,  // 17: routeApi.RouteApi.Call:output_type -> routeApi.Response
-	13, // [13:18] is the sub-list for method output_type
-	8,  // [8:13] is the sub-list for method input_type
+	1,  // 12: routeApi.RouteApi.FindRouteByNamespaceAndName:input_type -> routeApi.Request
+	1,  // 13: routeApi.RouteApi.Call:input_type -> routeApi.Request
+	1,  // 14: routeApi.RouteApi.FindExpiringRoutes:input_type -> routeApi.Request
+	2,  // 15: routeApi.RouteApi.AddRoute:output_type -> routeApi.Response
+	2,  // 16: routeApi.RouteApi.DeleteRoute:output_type -> routeApi.Response
+	2,  // 17: routeApi.RouteApi.UpdateRoute:output_type -> routeApi.Response
+	2,  // 18: routeApi.RouteApi.FindRouteByID:output_type -> routeApi.Response
+	2,  // 19: routeApi.RouteApi.FindRouteByNamespaceAndName:output_type -> routeApi.Response
+	2,  // 20: routeApi.RouteApi.Call:output_type -> routeApi.Response
+	2,  // 21: routeApi.RouteApi.FindExpiringRoutes:output_type -> routeApi.Response
+	15, // [15:22] is the sub-list for method output_type
+	8,  // [8:15] is the sub-list for method input_type
 	8,  // [8:8] is the sub-list for extension type_name
 	8,  // [8:8] is the sub-list for extension extendee
 	0,  // [0:8] is the sub-list for field type_name
@@ -40,7 +40,9 @@ type RouteApiService interface {
 	DeleteRoute(ctx context.Context, in *Request, opts ...client.CallOption) (*Response, error)
 	UpdateRoute(ctx context.Context, in *Request, opts ...client.CallOption) (*Response, error)
 	FindRouteByID(ctx context.Context, in *Request, opts ...client.CallOption) (*Response, error)
+	FindRouteByNamespaceAndName(ctx context.Context, in *Request, opts ...client.CallOption) (*Response, error)
 	Call(ctx context.Context, in *Request, opts ...client.CallOption) (*Response, error)
+	FindExpiringRoutes(ctx context.Context, in *Request, opts ...client.CallOption) (*Response, error)
 }
 
 type routeApiService struct {
@@ -95,6 +97,16 @@ func (c *routeApiService) FindRouteByID(ctx context.Context, in *Request, opts .
 	return out, nil
 }
 
+func (c *routeApiService) FindRouteByNamespaceAndName(ctx context.Context, in *Request, opts ...client.CallOption) (*Response, error) {
+	req := c.c.NewRequest(c.name, "RouteApi.FindRouteByNamespaceAndName", in)
+	out := new(Response)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *routeApiService) Call(ctx context.Context, in *Request, opts ...client.CallOption) (*Response, error) {
 	req := c.c.NewRequest(c.name, "RouteApi.Call", in)
 	out := new(Response)
@@ -105,6 +117,16 @@ func (c *routeApiService) Call(ctx context.Context, in *Request, opts ...client.
 	return out, nil
 }
 
+func (c *routeApiService) FindExpiringRoutes(ctx context.Context, in *Request, opts ...client.CallOption) (*Response, error) {
+	req := c.c.NewRequest(c.name, "RouteApi.FindExpiringRoutes", in)
+	out := new(Response)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for RouteApi service
 
 type RouteApiHandler interface {
@@ -112,7 +134,9 @@ type RouteApiHandler interface {
 	DeleteRoute(context.Context, *Request, *Response) error
 	UpdateRoute(context.Context, *Request, *Response) error
 	FindRouteByID(context.Context, *Request, *Response) error
+	FindRouteByNamespaceAndName(context.Context, *Request, *Response) error
 	Call(context.Context, *Request, *Response) error
+	FindExpiringRoutes(context.Context, *Request, *Response) error
 }
 
 func RegisterRouteApiHandler(s server.Server, hdlr RouteApiHandler, opts ...server.HandlerOption) error {
@@ -121,7 +145,9 @@ func RegisterRouteApiHandler(s server.Server, hdlr RouteApiHandler, opts ...serv
 		DeleteRoute(ctx context.Context, in *Request, out *Response) error
 		UpdateRoute(ctx context.Context, in *Request, out *Response) error
 		FindRouteByID(ctx context.Context, in *Request, out *Response) error
+		FindRouteByNamespaceAndName(ctx context.Context, in *Request, out *Response) error
 		Call(ctx context.Context, in *Request, out *Response) error
+		FindExpiringRoutes(ctx context.Context, in *Request, out *Response) error
 	}
 	type RouteApi struct {
 		routeApi
@@ -150,6 +176,14 @@ func (h *routeApiHandler) FindRouteByID(ctx context.Context, in *Request, out *R
 	return h.RouteApiHandler.FindRouteByID(ctx, in, out)
 }
 
+func (h *routeApiHandler) FindRouteByNamespaceAndName(ctx context.Context, in *Request, out *Response) error {
+	return h.RouteApiHandler.FindRouteByNamespaceAndName(ctx, in, out)
+}
+
 func (h *routeApiHandler) Call(ctx context.Context, in *Request, out *Response) error {
 	return h.RouteApiHandler.Call(ctx, in, out)
 }
+
+func (h *routeApiHandler) FindExpiringRoutes(ctx context.Context, in *Request, out *Response) error {
+	return h.RouteApiHandler.FindExpiringRoutes(ctx, in, out)
+}
@@ -22,43 +22,14 @@ type SvcApi struct {
 func (s *SvcApi) AddSvc(ctx context.Context, req *svcApi.Request, rsp *svcApi.Response) error {
 	fmt.Println("接收到 svcApi.AddSvc 的请求")
 
-	// 处理port
+	// 处理port，ExternalName 类型可以没有端口
 	addSvcInfo := &svc.SvcInfo{}
-	svcType, ok := req.Post["svc_type"]
-	if ok && len(svcType.Values) > 0 {
-		// 组装svcPort信息
-		svcPort := &svc.SvcPort{}
-
-		// 选择四种Service类型
-		switch svcType.Values[0] {
-		case "ClusterIP":
-			// 解析服务端口
-			port, err := strconv.ParseInt(req.Post["svc_port"].Values[0], 10, 32)
-			if err != nil {
-				common.Error(err)
-				return err
-			}
-			svcPort.SvcPort = int32(port)
-
-			// 解析目标端口
-			targetPort, err := strconv.ParseInt(req.Post["svc_target_port"].Values[0], 10, 64)
-			if err != nil {
-				common.Error(err)
-				return err
-			}
-			svcPort.SvcTargetPort = int32(targetPort)
-
-			// 解析端口协议
-			svcPort.SvcPortProtocol = req.Post["svc_port_protocol"].Values[0]
-
-			// 将本组消息写入serviceInfo
-			addSvcInfo.SvcPort = append(addSvcInfo.SvcPort, svcPort)
-
-			// TODO 其它service类型
-		default:
-			return errors.New("类型不支持")
-		}
+	svcPorts, err := getSvcPorts(req.Post)
+	if err != nil {
+		common.Error(err)
+		return err
 	}
+	addSvcInfo.SvcPort = svcPorts
 
 	// 将form表单映射到结构体中
 	form.FormToSvcStruct(req.Post, addSvcInfo)
@@ -170,3 +141,147 @@ func (s *SvcApi) Call(ctx context.Context, req *svcApi.Request, rsp *svcApi.Resp
 	rsp.Body = string(bytes)
 	return nil
 }
+
+// FindSvcByNamespaceAndName 根据命名空间和名称查找
+// SvcApi.FindSvcByNamespaceAndName 通过API向外暴露为/svcApi/FindSvcByNamespaceAndName, 接收http请求
+func (s *SvcApi) FindSvcByNamespaceAndName(ctx context.Context, req *svcApi.Request, rsp *svcApi.Response) error {
+	namespace, ok := req.Get["svc_namespace"]
+	if !ok || len(namespace.Values) == 0 {
+		rsp.StatusCode = 500
+		return errors.New("参数异常")
+	}
+	name, ok := req.Get["svc_name"]
+	if !ok || len(name.Values) == 0 {
+		rsp.StatusCode = 500
+		return errors.New("参数异常")
+	}
+
+	// 执行查询
+	info, err := s.SvcService.FindSvcByNamespaceAndName(ctx, &svc.SvcNamespaceName{
+		Namespace: namespace.Values[0],
+		Name:      name.Values[0],
+	})
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+
+	// 数据回写
+	rsp.StatusCode = 200
+	bytes, _ := json.Marshal(info)
+	rsp.Body = string(bytes)
+	return nil
+}
+
+// GetSvcEndpoints 查看服务的就绪和未就绪端点
+// SvcApi.GetSvcEndpoints 通过API向外暴露为/svcApi/GetSvcEndpoints, 接收http请求
+func (s *SvcApi) GetSvcEndpoints(ctx context.Context, req *svcApi.Request, rsp *svcApi.Response) error {
+	if _, ok := req.Get["svc_id"]; !ok {
+		rsp.StatusCode = 500
+		return errors.New("参数异常")
+	}
+	ID, err := strconv.ParseInt(req.Get["svc_id"].Values[0], 10, 64)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+
+	endpoints, err := s.SvcService.GetSvcEndpoints(ctx, &svc.SvcID{
+		Id: ID,
+	})
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+
+	// 数据回写
+	rsp.StatusCode = 200
+	bytes, _ := json.Marshal(endpoints)
+	rsp.Body = string(bytes)
+	return nil
+}
+
+// ExposePod 根据pod声明的端口生成服务，svc_type 和 svc_name 可以为空
+// SvcApi.ExposePod 通过API向外暴露为/svcApi/ExposePod, 接收http请求
+func (s *SvcApi) ExposePod(ctx context.Context, req *svcApi.Request, rsp *svcApi.Response) error {
+	if _, ok := req.Get["pod_id"]; !ok {
+		rsp.StatusCode = 500
+		return errors.New("参数异常")
+	}
+	podID, err := strconv.ParseInt(req.Get["pod_id"].Values[0], 10, 64)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	exposeReq := &svc.ExposePodRequest{
+		PodId: podID,
+	}
+	if svcType, ok := req.Get["svc_type"]; ok && len(svcType.Values) > 0 {
+		exposeReq.SvcType = svcType.Values[0]
+	}
+	if svcName, ok := req.Get["svc_name"]; ok && len(svcName.Values) > 0 {
+		exposeReq.SvcName = svcName.Values[0]
+	}
+
+	response, err := s.SvcService.ExposePod(ctx, exposeReq)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+
+	// 数据回写
+	rsp.StatusCode = 200
+	bytes, _ := json.Marshal(response)
+	rsp.Body = string(bytes)
+	return nil
+}
+
+// getSvcPorts 解析表单中的端口，svc_port、svc_target_port、svc_port_protocol、svc_node_port 按顺序一一对应
+// svc_node_port 只用于 NodePort 和 LoadBalancer 类型，不填或为0时由k8s分配
+func getSvcPorts(data map[string]*svcApi.Pair) ([]*svc.SvcPort, error) {
+	var svcPorts []*svc.SvcPort
+	ports, ok := data["svc_port"]
+	if !ok {
+		return svcPorts, nil
+	}
+	value := func(key string, i int) string {
+		pair, ok := data[key]
+		if !ok || len(pair.Values) <= i {
+			return ""
+		}
+		return pair.Values[i]
+	}
+
+	for i, portValue := range ports.Values {
+		// 解析服务端口
+		port, err := strconv.ParseInt(portValue, 10, 32)
+		if err != nil {
+			return nil, err
+		}
+		svcPort := &svc.SvcPort{
+			SvcPort:         int32(port),
+			SvcPortProtocol: value("svc_port_protocol", i),
+		}
+
+		// 解析目标端口，不填时与服务端口相同
+		svcPort.SvcTargetPort = svcPort.SvcPort
+		if targetPort := value("svc_target_port", i); targetPort != "" {
+			target, err := strconv.ParseInt(targetPort, 10, 32)
+			if err != nil {
+				return nil, err
+			}
+			svcPort.SvcTargetPort = int32(target)
+		}
+
+		// 解析节点端口
+		if nodePort := value("svc_node_port", i); nodePort != "" {
+			node, err := strconv.ParseInt(nodePort, 10, 32)
+			if err != nil {
+				return nil, err
+			}
+			svcPort.SvcNodePort = int32(node)
+		}
+		svcPorts = append(svcPorts, svcPort)
+	}
+	return svcPorts, nil
+}
@@ -284,7 +284,7 @@ var file_proto_svcApi_svcApi_proto_rawDesc = []byte{
 	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
 	0x79, 0x12, 0x22, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x0c, 0x2e, 0x73, 0x76, 0x63, 0x41, 0x70, 0x69, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x32, 0x80, 0x02, 0x0a, 0x06, 0x53, 0x76,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x32, 0xac, 0x03, 0x0a, 0x06, 0x53, 0x76,
 	0x63, 0x41, 0x70, 0x69, 0x12, 0x2d, 0x0a, 0x06, 0x41, 0x64, 0x64, 0x53, 0x76, 0x63, 0x12, 0x0f,
 	0x2e, 0x73, 0x76, 0x63, 0x41, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
 	0x10, 0x2e, 0x73, 0x76, 0x63, 0x41, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
@@ -298,11 +298,22 @@ var file_proto_svcApi_svcApi_proto_rawDesc = []byte{
 	0x69, 0x6e, 0x64, 0x53, 0x76, 0x63, 0x42, 0x79, 0x49, 0x44, 0x12, 0x0f, 0x2e, 0x73, 0x76, 0x63,
 	0x41, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x73, 0x76,
 	0x63, 0x41, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x2b, 0x0a, 0x04, 0x43, 0x61, 0x6c, 0x6c, 0x12, 0x0f, 0x2e, 0x73, 0x76, 0x63, 0x41, 0x70, 0x69,
-	0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x73, 0x76, 0x63, 0x41, 0x70,
-	0x69, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x17, 0x5a, 0x15,
-	0x2e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x73, 0x76, 0x63, 0x41, 0x70, 0x69, 0x3b, 0x73,
-	0x76, 0x63, 0x41, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x40, 0x0a, 0x19, 0x46, 0x69, 0x6e, 0x64, 0x53, 0x76, 0x63, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x41, 0x6e, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x0f, 0x2e, 0x73,
+	0x76, 0x63, 0x41, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e,
+	0x73, 0x76, 0x63, 0x41, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x2b, 0x0a, 0x04, 0x43, 0x61, 0x6c, 0x6c, 0x12, 0x0f, 0x2e, 0x73, 0x76, 0x63, 0x41,
+	0x70, 0x69, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x73, 0x76, 0x63,
+	0x41, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x36,
+	0x0a, 0x0f, 0x47, 0x65, 0x74, 0x53, 0x76, 0x63, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x73, 0x12, 0x0f, 0x2e, 0x73, 0x76, 0x63, 0x41, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x10, 0x2e, 0x73, 0x76, 0x63, 0x41, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x30, 0x0a, 0x09, 0x45, 0x78, 0x70, 0x6f, 0x73, 0x65,
+	0x50, 0x6f, 0x64, 0x12, 0x0f, 0x2e, 0x73, 0x76, 0x63, 0x41, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x73, 0x76, 0x63, 0x41, 0x70, 0x69, 0x2e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x17, 0x5a, 0x15, 0x2e, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2f, 0x73, 0x76, 0x63, 0x41, 0x70, 0x69, 0x3b, 0x73, 0x76, 0x63, 0x41, 0x70,
+	0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -340,14 +351,20 @@ var file_proto_svcApi_svcApi_proto_depIdxs = []int32{
 	1,  // 9: svcApi.SvcApi.DeleteSvcByID:input_type -> svcApi.Request
 	1,  // 10: svcApi.SvcApi.UpdateSvc:input_type -> svcApi.Request
 	1,  // 11: svcApi.SvcApi.FindSvcByID:input_type -> svcApi.Request
-	1,  // 12: svcApi.SvcApi.Call:input_type -> svcApi.Request
-	2,  // 13: svcApi.SvcApi.AddSvc:output_type -> svcApi.Response
-	2,  // 14: svcApi.SvcApi.DeleteSvcByID:output_type -> svcApi.Response
-	2,  // 15: svcApi.SvcApi.UpdateSvc:output_type -> svcApi.Response
-	2,  // 16: svcApi.SvcApi.FindSvcByID:output_type -> svcApi.Response
-	2,  // 17: svcApi.SvcApi.Call:output_type -> svcApi.Response
-	13, // [13:18] is the sub-list for method output_type
-	8,  // [8:13] is the sub-list for method input_type
+	1,  // 12: svcApi.SvcApi.FindSvcByNamespaceAndName:input_type -> svcApi.Request
+	1,  // 13: svcApi.SvcApi.Call:input_type -> svcApi.Request
+	1,  // 14: svcApi.SvcApi.GetSvcEndpoints:input_type -> svcApi.Request
+	1,  // 15: svcApi.SvcApi.ExposePod:input_type -> svcApi.Request
+	2,  // 16: svcApi.SvcApi.AddSvc:output_type -> svcApi.Response
+	2,  // 17: svcApi.SvcApi.DeleteSvcByID:output_type -> svcApi.Response
+	2,  // 18: svcApi.SvcApi.UpdateSvc:output_type -> svcApi.Response
+	2,  // 19: svcApi.SvcApi.FindSvcByID:output_type -> svcApi.Response
+	2,  // 20: svcApi.SvcApi.FindSvcByNamespaceAndName:output_type -> svcApi.Response
+	2,  // 21: svcApi.SvcApi.Call:output_type -> svcApi.Response
+	2,  // 22: svcApi.SvcApi.GetSvcEndpoints:output_type -> svcApi.Response
+	2,  // 23: svcApi.SvcApi.ExposePod:output_type -> svcApi.Response
+	16, // [16:24] is the sub-list for method output_type
+	8,  // [8:16] is the sub-list for method input_type
 	8,  // [8:8] is the sub-list for extension type_name
 	8,  // [8:8] is the sub-list for extension extendee
 	0,  // [0:8] is the sub-list for field type_name
@@ -40,7 +40,10 @@ type SvcApiService interface {
 	DeleteSvcByID(ctx context.Context, in *Request, opts ...client.CallOption) (*Response, error)
 	UpdateSvc(ctx context.Context, in *Request, opts ...client.CallOption) (*Response, error)
 	FindSvcByID(ctx context.Context, in *Request, opts ...client.CallOption) (*Response, error)
+	FindSvcByNamespaceAndName(ctx context.Context, in *Request, opts ...client.CallOption) (*Response, error)
 	Call(ctx context.Context, in *Request, opts ...client.CallOption) (*Response, error)
+	GetSvcEndpoints(ctx context.Context, in *Request, opts ...client.CallOption) (*Response, error)
+	ExposePod(ctx context.Context, in *Request, opts ...client.CallOption) (*Response, error)
 }
 
 type svcApiService struct {
@@ -95,6 +98,16 @@ func (c *svcApiService) FindSvcByID(ctx context.Context, in *Request, opts ...cl
 	return out, nil
 }
 
+func (c *svcApiService) FindSvcByNamespaceAndName(ctx context.Context, in *Request, opts ...client.CallOption) (*Response, error) {
+	req := c.c.NewRequest(c.name, "SvcApi.FindSvcByNamespaceAndName", in)
+	out := new(Response)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *svcApiService) Call(ctx context.Context, in *Request, opts ...client.CallOption) (*Response, error) {
 	req := c.c.NewRequest(c.name, "SvcApi.Call", in)
 	out := new(Response)
@@ -105,6 +118,26 @@ func (c *svcApiService) Call(ctx context.Context, in *Request, opts ...client.Ca
 	return out, nil
 }
 
+func (c *svcApiService) GetSvcEndpoints(ctx context.Context, in *Request, opts ...client.CallOption) (*Response, error) {
+	req := c.c.NewRequest(c.name, "SvcApi.GetSvcEndpoints", in)
+	out := new(Response)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *svcApiService) ExposePod(ctx context.Context, in *Request, opts ...client.CallOption) (*Response, error) {
+	req := c.c.NewRequest(c.name, "SvcApi.ExposePod", in)
+	out := new(Response)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for SvcApi service
 
 type SvcApiHandler interface {
@@ -112,7 +145,10 @@ type SvcApiHandler interface {
 	DeleteSvcByID(context.Context, *Request, *Response) error
 	UpdateSvc(context.Context, *Request, *Response) error
 	FindSvcByID(context.Context, *Request, *Response) error
+	FindSvcByNamespaceAndName(context.Context, *Request, *Response) error
 	Call(context.Context, *Request, *Response) error
+	GetSvcEndpoints(context.Context, *Request, *Response) error
+	ExposePod(context.Context, *Request, *Response) error
 }
 
 func RegisterSvcApiHandler(s server.Server, hdlr SvcApiHandler, opts ...server.HandlerOption) error {
@@ -121,7 +157,10 @@ func RegisterSvcApiHandler(s server.Server, hdlr SvcApiHandler, opts ...server.H
 		DeleteSvcByID(ctx context.Context, in *Request, out *Response) error
 		UpdateSvc(ctx context.Context, in *Request, out *Response) error
 		FindSvcByID(ctx context.Context, in *Request, out *Response) error
+		FindSvcByNamespaceAndName(ctx context.Context, in *Request, out *Response) error
 		Call(ctx context.Context, in *Request, out *Response) error
+		GetSvcEndpoints(ctx context.Context, in *Request, out *Response) error
+		ExposePod(ctx context.Context, in *Request, out *Response) error
 	}
 	type SvcApi struct {
 		svcApi
@@ -150,6 +189,18 @@ func (h *svcApiHandler) FindSvcByID(ctx context.Context, in *Request, out *Respo
 	return h.SvcApiHandler.FindSvcByID(ctx, in, out)
 }
 
+func (h *svcApiHandler) FindSvcByNamespaceAndName(ctx context.Context, in *Request, out *Response) error {
+	return h.SvcApiHandler.FindSvcByNamespaceAndName(ctx, in, out)
+}
+
 func (h *svcApiHandler) Call(ctx context.Context, in *Request, out *Response) error {
 	return h.SvcApiHandler.Call(ctx, in, out)
 }
+
+func (h *svcApiHandler) GetSvcEndpoints(ctx context.Context, in *Request, out *Response) error {
+	return h.SvcApiHandler.GetSvcEndpoints(ctx, in, out)
+}
+
+func (h *svcApiHandler) ExposePod(ctx context.Context, in *Request, out *Response) error {
+	return h.SvcApiHandler.ExposePod(ctx, in, out)
+}
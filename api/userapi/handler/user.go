@@ -412,6 +412,33 @@ func (u *UserApi) UpdatePermission(ctx context.Context, request *userApi.Request
 	return nil
 }
 
+// Login 登录，post参数user_name、user_pwd，返回的令牌放在 Authorization 请求头中调用需要鉴权的接口
+// UserApi.Login 通过API向外暴露为/userApi/Login, 接收http请求
+func (u *UserApi) Login(ctx context.Context, request *userApi.Request, response *userApi.Response) error {
+	login := &user.UserLogin{}
+	for key, value := range map[string]*string{"user_name": &login.UserName, "user_pwd": &login.UserPwd} {
+		pair, ok := request.Post[key]
+		if !ok || len(pair.Values) == 0 {
+			return errors.New("参数异常")
+		}
+		*value = pair.Values[0]
+	}
+
+	// 调用后端服务登录
+	rsp, err := u.UserService.Login(ctx, login)
+	if err != nil {
+		common.Error(err)
+		response.StatusCode = 401
+		return err
+	}
+
+	// 数据回写
+	response.StatusCode = 200
+	bytes, _ := json.Marshal(rsp)
+	response.Body = string(bytes)
+	return nil
+}
+
 // getPost 获取参数
 func (u *UserApi) getPost(request *userApi.Request, key string) (string, error) {
 	if _, ok := request.Post[key]; ok {
@@ -282,7 +282,7 @@ var file_proto_userApi_userApi_proto_rawDesc = []byte{
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x23, 0x0a, 0x05, 0x76,
 	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x75, 0x73, 0x65,
 	0x72, 0x41, 0x70, 0x69, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x3a, 0x02, 0x38, 0x01, 0x32, 0x87, 0x05, 0x0a, 0x07, 0x55, 0x73, 0x65, 0x72, 0x41, 0x70, 0x69,
+	0x3a, 0x02, 0x38, 0x01, 0x32, 0xb7, 0x05, 0x0a, 0x07, 0x55, 0x73, 0x65, 0x72, 0x41, 0x70, 0x69,
 	0x12, 0x30, 0x0a, 0x07, 0x41, 0x64, 0x64, 0x55, 0x73, 0x65, 0x72, 0x12, 0x10, 0x2e, 0x75, 0x73,
 	0x65, 0x72, 0x41, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e,
 	0x75, 0x73, 0x65, 0x72, 0x41, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
@@ -322,6 +322,9 @@ var file_proto_userApi_userApi_proto_rawDesc = []byte{
 	0x65, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72,
 	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x41, 0x70,
 	0x69, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x75, 0x73, 0x65, 0x72,
+	0x41, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x2e,
+	0x0a, 0x05, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x10, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x41, 0x70,
+	0x69, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x75, 0x73, 0x65, 0x72,
 	0x41, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x19,
 	0x5a, 0x17, 0x2e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x41, 0x70,
 	0x69, 0x3b, 0x75, 0x73, 0x65, 0x72, 0x41, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
@@ -371,20 +374,22 @@ var file_proto_userApi_userApi_proto_depIdxs = []int32{
 	1,  // 17: userApi.UserApi.AddPermission:input_type -> userApi.Request
 	1,  // 18: userApi.UserApi.DeletePermission:input_type -> userApi.Request
 	1,  // 19: userApi.UserApi.UpdatePermission:input_type -> userApi.Request
-	2,  // 20: userApi.UserApi.AddUser:output_type -> userApi.Response
-	2,  // 21: userApi.UserApi.DeleteUser:output_type -> userApi.Response
-	2,  // 22: userApi.UserApi.UpdateUser:output_type -> userApi.Response
-	2,  // 23: userApi.UserApi.FindUserByID:output_type -> userApi.Response
-	2,  // 24: userApi.UserApi.Call:output_type -> userApi.Response
-	2,  // 25: userApi.UserApi.AddRole:output_type -> userApi.Response
-	2,  // 26: userApi.UserApi.DeleteRole:output_type -> userApi.Response
-	2,  // 27: userApi.UserApi.UpdateRole:output_type -> userApi.Response
-	2,  // 28: userApi.UserApi.IsRight:output_type -> userApi.Response
-	2,  // 29: userApi.UserApi.AddPermission:output_type -> userApi.Response
-	2,  // 30: userApi.UserApi.DeletePermission:output_type -> userApi.Response
-	2,  // 31: userApi.UserApi.UpdatePermission:output_type -> userApi.Response
-	20, // [20:32] is the sub-list for method output_type
-	8,  // [8:20] is the sub-list for method input_type
+	1,  // 20: userApi.UserApi.Login:input_type -> userApi.Request
+	2,  // 21: userApi.UserApi.AddUser:output_type -> userApi.Response
+	2,  // 22: userApi.UserApi.DeleteUser:output_type -> userApi.Response
+	2,  // 23: userApi.UserApi.UpdateUser:output_type -> userApi.Response
+	2,  // 24: userApi.UserApi.FindUserByID:output_type -> userApi.Response
+	2,  // 25: userApi.UserApi.Call:output_type -> userApi.Response
+	2,  // 26: userApi.UserApi.AddRole:output_type -> userApi.Response
+	2,  // 27: userApi.UserApi.DeleteRole:output_type -> userApi.Response
+	2,  // 28: userApi.UserApi.UpdateRole:output_type -> userApi.Response
+	2,  // 29: userApi.UserApi.IsRight:output_type -> userApi.Response
+	2,  // 30: userApi.UserApi.AddPermission:output_type -> userApi.Response
+	2,  // 31: userApi.UserApi.DeletePermission:output_type -> userApi.Response
+	2,  // 32: userApi.UserApi.UpdatePermission:output_type -> userApi.Response
+	2,  // 33: userApi.UserApi.Login:output_type -> userApi.Response
+	21, // [21:34] is the sub-list for method output_type
+	8,  // [8:21] is the sub-list for method input_type
 	8,  // [8:8] is the sub-list for extension type_name
 	8,  // [8:8] is the sub-list for extension extendee
 	0,  // [0:8] is the sub-list for field type_name
@@ -48,6 +48,7 @@ type UserApiService interface {
 	AddPermission(ctx context.Context, in *Request, opts ...client.CallOption) (*Response, error)
 	DeletePermission(ctx context.Context, in *Request, opts ...client.CallOption) (*Response, error)
 	UpdatePermission(ctx context.Context, in *Request, opts ...client.CallOption) (*Response, error)
+	Login(ctx context.Context, in *Request, opts ...client.CallOption) (*Response, error)
 }
 
 type userApiService struct {
@@ -182,6 +183,16 @@ func (c *userApiService) UpdatePermission(ctx context.Context, in *Request, opts
 	return out, nil
 }
 
+func (c *userApiService) Login(ctx context.Context, in *Request, opts ...client.CallOption) (*Response, error) {
+	req := c.c.NewRequest(c.name, "UserApi.Login", in)
+	out := new(Response)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for UserApi service
 
 type UserApiHandler interface {
@@ -197,6 +208,7 @@ type UserApiHandler interface {
 	AddPermission(context.Context, *Request, *Response) error
 	DeletePermission(context.Context, *Request, *Response) error
 	UpdatePermission(context.Context, *Request, *Response) error
+	Login(context.Context, *Request, *Response) error
 }
 
 func RegisterUserApiHandler(s server.Server, hdlr UserApiHandler, opts ...server.HandlerOption) error {
@@ -213,6 +225,7 @@ func RegisterUserApiHandler(s server.Server, hdlr UserApiHandler, opts ...server
 		AddPermission(ctx context.Context, in *Request, out *Response) error
 		DeletePermission(ctx context.Context, in *Request, out *Response) error
 		UpdatePermission(ctx context.Context, in *Request, out *Response) error
+		Login(ctx context.Context, in *Request, out *Response) error
 	}
 	type UserApi struct {
 		userApi
@@ -272,3 +285,7 @@ func (h *userApiHandler) DeletePermission(ctx context.Context, in *Request, out
 func (h *userApiHandler) UpdatePermission(ctx context.Context, in *Request, out *Response) error {
 	return h.UserApiHandler.UpdatePermission(ctx, in, out)
 }
+
+func (h *userApiHandler) Login(ctx context.Context, in *Request, out *Response) error {
+	return h.UserApiHandler.Login(ctx, in, out)
+}
@@ -0,0 +1,134 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"tini-paas/api/volumeapi/proto/volumeApi"
+	"tini-paas/internal/volume/proto/volume"
+	"tini-paas/pkg/common"
+	"tini-paas/plugin/form"
+)
+
+// CreateSnapshot 为存储创建快照
+// VolumeApi.CreateSnapshot 通过API向外暴露为/volumeApi/CreateSnapshot, 接收http请求
+func (v *VolumeApi) CreateSnapshot(ctx context.Context, req *volumeApi.Request, rsp *volumeApi.Response) error {
+	snapshotReq := &volume.SnapshotRequest{}
+	form.FormToVolumeStruct(req.Post, snapshotReq)
+	if snapshotReq.VolumeId == 0 {
+		rsp.StatusCode = 500
+		return errors.New("参数异常")
+	}
+
+	response, err := v.VolumeServer.CreateSnapshot(ctx, snapshotReq)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+
+	// 数据回写
+	rsp.StatusCode = 200
+	bytes, _ := json.Marshal(response)
+	rsp.Body = string(bytes)
+	return nil
+}
+
+// ListSnapshots 查找存储的快照
+// VolumeApi.ListSnapshots 通过API向外暴露为/volumeApi/ListSnapshots, 接收http请求
+func (v *VolumeApi) ListSnapshots(ctx context.Context, req *volumeApi.Request, rsp *volumeApi.Response) error {
+	volumeID, err := getInt64(req.Get, "volume_id")
+	if err != nil {
+		rsp.StatusCode = 500
+		return err
+	}
+
+	snapshots, err := v.VolumeServer.ListSnapshots(ctx, &volume.VolumeID{Id: volumeID})
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+
+	// 数据回写
+	rsp.StatusCode = 200
+	bytes, _ := json.Marshal(snapshots)
+	rsp.Body = string(bytes)
+	return nil
+}
+
+// DeleteSnapshot 删除快照
+// VolumeApi.DeleteSnapshot 通过API向外暴露为/volumeApi/DeleteSnapshot, 接收http请求
+func (v *VolumeApi) DeleteSnapshot(ctx context.Context, req *volumeApi.Request, rsp *volumeApi.Response) error {
+	snapshotID, err := getInt64(req.Get, "snapshot_id")
+	if err != nil {
+		rsp.StatusCode = 500
+		return err
+	}
+
+	response, err := v.VolumeServer.DeleteSnapshot(ctx, &volume.SnapshotID{Id: snapshotID})
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+
+	// 数据回写
+	rsp.StatusCode = 200
+	bytes, _ := json.Marshal(response)
+	rsp.Body = string(bytes)
+	return nil
+}
+
+// RestoreSnapshot 从快照恢复到新的存储
+// VolumeApi.RestoreSnapshot 通过API向外暴露为/volumeApi/RestoreSnapshot, 接收http请求
+func (v *VolumeApi) RestoreSnapshot(ctx context.Context, req *volumeApi.Request, rsp *volumeApi.Response) error {
+	restoreReq := &volume.RestoreSnapshotRequest{}
+	form.FormToVolumeStruct(req.Post, restoreReq)
+	if restoreReq.SnapshotId == 0 {
+		rsp.StatusCode = 500
+		return errors.New("参数异常")
+	}
+
+	response, err := v.VolumeServer.RestoreSnapshot(ctx, restoreReq)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+
+	// 数据回写
+	rsp.StatusCode = 200
+	bytes, _ := json.Marshal(response)
+	rsp.Body = string(bytes)
+	return nil
+}
+
+// CloneVolume 克隆存储到同一个命名空间的新存储
+// VolumeApi.CloneVolume 通过API向外暴露为/volumeApi/CloneVolume, 接收http请求
+func (v *VolumeApi) CloneVolume(ctx context.Context, req *volumeApi.Request, rsp *volumeApi.Response) error {
+	cloneReq := &volume.CloneVolumeRequest{}
+	form.FormToVolumeStruct(req.Post, cloneReq)
+	if cloneReq.VolumeId == 0 {
+		rsp.StatusCode = 500
+		return errors.New("参数异常")
+	}
+
+	response, err := v.VolumeServer.CloneVolume(ctx, cloneReq)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+
+	// 数据回写
+	rsp.StatusCode = 200
+	bytes, _ := json.Marshal(response)
+	rsp.Body = string(bytes)
+	return nil
+}
+
+// getInt64 获取请求中的整数参数
+func getInt64(data map[string]*volumeApi.Pair, key string) (int64, error) {
+	pair, ok := data[key]
+	if !ok || len(pair.Values) == 0 {
+		return 0, errors.New("参数异常")
+	}
+	return strconv.ParseInt(pair.Values[0], 10, 64)
+}
@@ -51,9 +51,16 @@ func (v *VolumeApi) DeleteVolume(ctx context.Context, req *volumeApi.Request, rs
 		return err
 	}
 
+	// force=true 时存储仍在使用也强制删除
+	force := false
+	if pair, ok := req.Get["force"]; ok && len(pair.Values) > 0 {
+		force = pair.Values[0] == "true"
+	}
+
 	// 执行删除服务
 	response, err := v.VolumeServer.DeleteVolume(ctx, &volume.VolumeID{
-		Id: volumeID,
+		Id:    volumeID,
+		Force: force,
 	})
 	if err != nil {
 		common.Error(err)
@@ -115,3 +122,91 @@ func (v *VolumeApi) Call(ctx context.Context, req *volumeApi.Request, rsp *volum
 	rsp.Body = string(bytes)
 	return nil
 }
+
+// FindVolumeByNamespaceAndName 根据命名空间和名称查找
+// VolumeApi.FindVolumeByNamespaceAndName 通过API向外暴露为/volumeApi/FindVolumeByNamespaceAndName, 接收http请求
+func (v *VolumeApi) FindVolumeByNamespaceAndName(ctx context.Context, req *volumeApi.Request, rsp *volumeApi.Response) error {
+	namespace, ok := req.Get["volume_namespace"]
+	if !ok || len(namespace.Values) == 0 {
+		rsp.StatusCode = 500
+		return errors.New("参数异常")
+	}
+	name, ok := req.Get["volume_name"]
+	if !ok || len(name.Values) == 0 {
+		rsp.StatusCode = 500
+		return errors.New("参数异常")
+	}
+
+	// 执行查询
+	info, err := v.VolumeServer.FindVolumeByNamespaceAndName(ctx, &volume.VolumeNamespaceName{
+		Namespace: namespace.Values[0],
+		Name:      name.Values[0],
+	})
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+
+	// 数据回写
+	rsp.StatusCode = 200
+	bytes, _ := json.Marshal(info)
+	rsp.Body = string(bytes)
+	return nil
+}
+
+// ResizeVolume 在线扩容存储，volume_request 为扩容后的大小(Gi)
+// VolumeApi.ResizeVolume 通过API向外暴露为/volumeApi/ResizeVolume, 接收http请求
+func (v *VolumeApi) ResizeVolume(ctx context.Context, req *volumeApi.Request, rsp *volumeApi.Response) error {
+	id, ok := req.Get["volume_id"]
+	if !ok || len(id.Values) == 0 {
+		rsp.StatusCode = 500
+		return errors.New("参数异常")
+	}
+	request, ok := req.Get["volume_request"]
+	if !ok || len(request.Values) == 0 {
+		rsp.StatusCode = 500
+		return errors.New("参数异常")
+	}
+
+	volumeID, err := strconv.ParseInt(id.Values[0], 10, 64)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	size, err := strconv.ParseFloat(request.Values[0], 32)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+
+	response, err := v.VolumeServer.ResizeVolume(ctx, &volume.VolumeResize{
+		Id:            volumeID,
+		VolumeRequest: float32(size),
+	})
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+
+	// 数据回写
+	rsp.StatusCode = 200
+	bytes, _ := json.Marshal(response)
+	rsp.Body = string(bytes)
+	return nil
+}
+
+// ListStorageClasses 查询集群中的存储类，创建存储时可以从中选择
+// VolumeApi.ListStorageClasses 通过API向外暴露为/volumeApi/ListStorageClasses, 接收http请求
+func (v *VolumeApi) ListStorageClasses(ctx context.Context, req *volumeApi.Request, rsp *volumeApi.Response) error {
+	storageClasses, err := v.VolumeServer.ListStorageClasses(ctx, &volume.FindAll{})
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+
+	// 数据回写
+	rsp.StatusCode = 200
+	bytes, _ := json.Marshal(storageClasses)
+	rsp.Body = string(bytes)
+	return nil
+}
@@ -283,7 +283,7 @@ var file_proto_volumeApi_volumeApi_proto_rawDesc = []byte{
 	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
 	0x25, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
 	0x2e, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x41, 0x70, 0x69, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x32, 0xa9, 0x02, 0x0a, 0x09, 0x56,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x32, 0x9e, 0x06, 0x0a, 0x09, 0x56,
 	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x41, 0x70, 0x69, 0x12, 0x36, 0x0a, 0x09, 0x41, 0x64, 0x64, 0x56,
 	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x12, 0x2e, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x41, 0x70,
 	0x69, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x76, 0x6f, 0x6c, 0x75,
@@ -299,12 +299,44 @@ var file_proto_volumeApi_volumeApi_proto_rawDesc = []byte{
 	0x6c, 0x75, 0x6d, 0x65, 0x42, 0x79, 0x49, 0x44, 0x12, 0x12, 0x2e, 0x76, 0x6f, 0x6c, 0x75, 0x6d,
 	0x65, 0x41, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x76,
 	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x41, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x04, 0x43, 0x61, 0x6c, 0x6c, 0x12, 0x12, 0x2e, 0x76, 0x6f,
-	0x6c, 0x75, 0x6d, 0x65, 0x41, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x13, 0x2e, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x41, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x1d, 0x5a, 0x1b, 0x2e, 0x2f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2f, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x41, 0x70, 0x69, 0x3b, 0x76, 0x6f, 0x6c, 0x75,
-	0x6d, 0x65, 0x41, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x1c, 0x46, 0x69, 0x6e, 0x64, 0x56, 0x6f, 0x6c, 0x75, 0x6d,
+	0x65, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x41, 0x6e, 0x64, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x12, 0x2e, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x41, 0x70, 0x69, 0x2e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
+	0x41, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x39,
+	0x0a, 0x0c, 0x52, 0x65, 0x73, 0x69, 0x7a, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x12,
+	0x2e, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x41, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x13, 0x2e, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x41, 0x70, 0x69, 0x2e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x12, 0x4c, 0x69, 0x73,
+	0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x12,
+	0x12, 0x2e, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x41, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x41, 0x70, 0x69, 0x2e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x0e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x12, 0x2e, 0x76,
+	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x41, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x13, 0x2e, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x41, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x53,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x12, 0x12, 0x2e, 0x76, 0x6f, 0x6c, 0x75, 0x6d,
+	0x65, 0x41, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x76,
+	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x41, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x12, 0x2e, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x41, 0x70,
+	0x69, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x76, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x41, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x3c, 0x0a, 0x0f, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x12, 0x12, 0x2e, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x41, 0x70, 0x69, 0x2e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
+	0x41, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x38,
+	0x0a, 0x0b, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x12, 0x2e,
+	0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x41, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x13, 0x2e, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x41, 0x70, 0x69, 0x2e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x04, 0x43, 0x61, 0x6c, 0x6c,
+	0x12, 0x12, 0x2e, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x41, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x41, 0x70, 0x69,
+	0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x1d, 0x5a, 0x1b, 0x2e,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x41, 0x70, 0x69,
+	0x3b, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x41, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -342,14 +374,30 @@ var file_proto_volumeApi_volumeApi_proto_depIdxs = []int32{
 	1,  // 9: volumeApi.VolumeApi.DeleteVolume:input_type -> volumeApi.Request
 	1,  // 10: volumeApi.VolumeApi.UpdateVolume:input_type -> volumeApi.Request
 	1,  // 11: volumeApi.VolumeApi.FindVolumeByID:input_type -> volumeApi.Request
-	1,  // 12: volumeApi.VolumeApi.Call:input_type -> volumeApi.Request
-	2,  // 13: volumeApi.VolumeApi.AddVolume:output_type -> volumeApi.Response
-	2,  // 14: volumeApi.VolumeApi.DeleteVolume:output_type -> volumeApi.Response
-	2,  // 15: volumeApi.VolumeApi.UpdateVolume:output_type -> volumeApi.Response
-	2,  // 16: volumeApi.VolumeApi.FindVolumeByID:output_type -> volumeApi.Response
-	2,  // 17: volumeApi.VolumeApi.Call:output_type -> volumeApi.Response
-	13, // [13:18] is the sub-list for method output_type
-	8,  // [8:13] is the sub-list for method input_type
+	1,  // 12: volumeApi.VolumeApi.FindVolumeByNamespaceAndName:input_type -> volumeApi.Request
+	1,  // 13: volumeApi.VolumeApi.ResizeVolume:input_type -> volumeApi.Request
+	1,  // 14: volumeApi.VolumeApi.ListStorageClasses:input_type -> volumeApi.Request
+	1,  // 15: volumeApi.VolumeApi.CreateSnapshot:input_type -> volumeApi.Request
+	1,  // 16: volumeApi.VolumeApi.ListSnapshots:input_type -> volumeApi.Request
+	1,  // 17: volumeApi.VolumeApi.DeleteSnapshot:input_type -> volumeApi.Request
+	1,  // 18: volumeApi.VolumeApi.RestoreSnapshot:input_type -> volumeApi.Request
+	1,  // 19: volumeApi.VolumeApi.CloneVolume:input_type -> volumeApi.Request
+	1,  // 20: volumeApi.VolumeApi.Call:input_type -> volumeApi.Request
+	2,  // 21: volumeApi.VolumeApi.AddVolume:output_type -> volumeApi.Response
+	2,  // 22: volumeApi.VolumeApi.DeleteVolume:output_type -> volumeApi.Response
+	2,  // 23: volumeApi.VolumeApi.UpdateVolume:output_type -> volumeApi.Response
+	2,  // 24: volumeApi.VolumeApi.FindVolumeByID:output_type -> volumeApi.Response
+	2,  // 25: volumeApi.VolumeApi.FindVolumeByNamespaceAndName:output_type -> volumeApi.Response
+	2,  // 26: volumeApi.VolumeApi.ResizeVolume:output_type -> volumeApi.Response
+	2,  // 27: volumeApi.VolumeApi.ListStorageClasses:output_type -> volumeApi.Response
+	2,  // 28: volumeApi.VolumeApi.CreateSnapshot:output_type -> volumeApi.Response
+	2,  // 29: volumeApi.VolumeApi.ListSnapshots:output_type -> volumeApi.Response
+	2,  // 30: volumeApi.VolumeApi.DeleteSnapshot:output_type -> volumeApi.Response
+	2,  // 31: volumeApi.VolumeApi.RestoreSnapshot:output_type -> volumeApi.Response
+	2,  // 32: volumeApi.VolumeApi.CloneVolume:output_type -> volumeApi.Response
+	2,  // 33: volumeApi.VolumeApi.Call:output_type -> volumeApi.Response
+	21, // [21:34] is the sub-list for method output_type
+	8,  // [8:21] is the sub-list for method input_type
 	8,  // [8:8] is the sub-list for extension type_name
 	8,  // [8:8] is the sub-list for extension extendee
 	0,  // [0:8] is the sub-list for field type_name
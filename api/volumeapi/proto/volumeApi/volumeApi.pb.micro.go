@@ -40,6 +40,15 @@ type VolumeApiService interface {
 	DeleteVolume(ctx context.Context, in *Request, opts ...client.CallOption) (*Response, error)
 	UpdateVolume(ctx context.Context, in *Request, opts ...client.CallOption) (*Response, error)
 	FindVolumeByID(ctx context.Context, in *Request, opts ...client.CallOption) (*Response, error)
+	FindVolumeByNamespaceAndName(ctx context.Context, in *Request, opts ...client.CallOption) (*Response, error)
+	ResizeVolume(ctx context.Context, in *Request, opts ...client.CallOption) (*Response, error)
+	ListStorageClasses(ctx context.Context, in *Request, opts ...client.CallOption) (*Response, error)
+	// 快照、从快照恢复和克隆
+	CreateSnapshot(ctx context.Context, in *Request, opts ...client.CallOption) (*Response, error)
+	ListSnapshots(ctx context.Context, in *Request, opts ...client.CallOption) (*Response, error)
+	DeleteSnapshot(ctx context.Context, in *Request, opts ...client.CallOption) (*Response, error)
+	RestoreSnapshot(ctx context.Context, in *Request, opts ...client.CallOption) (*Response, error)
+	CloneVolume(ctx context.Context, in *Request, opts ...client.CallOption) (*Response, error)
 	Call(ctx context.Context, in *Request, opts ...client.CallOption) (*Response, error)
 }
 
@@ -95,6 +104,86 @@ func (c *volumeApiService) FindVolumeByID(ctx context.Context, in *Request, opts
 	return out, nil
 }
 
+func (c *volumeApiService) FindVolumeByNamespaceAndName(ctx context.Context, in *Request, opts ...client.CallOption) (*Response, error) {
+	req := c.c.NewRequest(c.name, "VolumeApi.FindVolumeByNamespaceAndName", in)
+	out := new(Response)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumeApiService) ResizeVolume(ctx context.Context, in *Request, opts ...client.CallOption) (*Response, error) {
+	req := c.c.NewRequest(c.name, "VolumeApi.ResizeVolume", in)
+	out := new(Response)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumeApiService) ListStorageClasses(ctx context.Context, in *Request, opts ...client.CallOption) (*Response, error) {
+	req := c.c.NewRequest(c.name, "VolumeApi.ListStorageClasses", in)
+	out := new(Response)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumeApiService) CreateSnapshot(ctx context.Context, in *Request, opts ...client.CallOption) (*Response, error) {
+	req := c.c.NewRequest(c.name, "VolumeApi.CreateSnapshot", in)
+	out := new(Response)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumeApiService) ListSnapshots(ctx context.Context, in *Request, opts ...client.CallOption) (*Response, error) {
+	req := c.c.NewRequest(c.name, "VolumeApi.ListSnapshots", in)
+	out := new(Response)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumeApiService) DeleteSnapshot(ctx context.Context, in *Request, opts ...client.CallOption) (*Response, error) {
+	req := c.c.NewRequest(c.name, "VolumeApi.DeleteSnapshot", in)
+	out := new(Response)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumeApiService) RestoreSnapshot(ctx context.Context, in *Request, opts ...client.CallOption) (*Response, error) {
+	req := c.c.NewRequest(c.name, "VolumeApi.RestoreSnapshot", in)
+	out := new(Response)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumeApiService) CloneVolume(ctx context.Context, in *Request, opts ...client.CallOption) (*Response, error) {
+	req := c.c.NewRequest(c.name, "VolumeApi.CloneVolume", in)
+	out := new(Response)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *volumeApiService) Call(ctx context.Context, in *Request, opts ...client.CallOption) (*Response, error) {
 	req := c.c.NewRequest(c.name, "VolumeApi.Call", in)
 	out := new(Response)
@@ -112,6 +201,15 @@ type VolumeApiHandler interface {
 	DeleteVolume(context.Context, *Request, *Response) error
 	UpdateVolume(context.Context, *Request, *Response) error
 	FindVolumeByID(context.Context, *Request, *Response) error
+	FindVolumeByNamespaceAndName(context.Context, *Request, *Response) error
+	ResizeVolume(context.Context, *Request, *Response) error
+	ListStorageClasses(context.Context, *Request, *Response) error
+	// 快照、从快照恢复和克隆
+	CreateSnapshot(context.Context, *Request, *Response) error
+	ListSnapshots(context.Context, *Request, *Response) error
+	DeleteSnapshot(context.Context, *Request, *Response) error
+	RestoreSnapshot(context.Context, *Request, *Response) error
+	CloneVolume(context.Context, *Request, *Response) error
 	Call(context.Context, *Request, *Response) error
 }
 
@@ -121,6 +219,14 @@ func RegisterVolumeApiHandler(s server.Server, hdlr VolumeApiHandler, opts ...se
 		DeleteVolume(ctx context.Context, in *Request, out *Response) error
 		UpdateVolume(ctx context.Context, in *Request, out *Response) error
 		FindVolumeByID(ctx context.Context, in *Request, out *Response) error
+		FindVolumeByNamespaceAndName(ctx context.Context, in *Request, out *Response) error
+		ResizeVolume(ctx context.Context, in *Request, out *Response) error
+		ListStorageClasses(ctx context.Context, in *Request, out *Response) error
+		CreateSnapshot(ctx context.Context, in *Request, out *Response) error
+		ListSnapshots(ctx context.Context, in *Request, out *Response) error
+		DeleteSnapshot(ctx context.Context, in *Request, out *Response) error
+		RestoreSnapshot(ctx context.Context, in *Request, out *Response) error
+		CloneVolume(ctx context.Context, in *Request, out *Response) error
 		Call(ctx context.Context, in *Request, out *Response) error
 	}
 	type VolumeApi struct {
@@ -150,6 +256,38 @@ func (h *volumeApiHandler) FindVolumeByID(ctx context.Context, in *Request, out
 	return h.VolumeApiHandler.FindVolumeByID(ctx, in, out)
 }
 
+func (h *volumeApiHandler) FindVolumeByNamespaceAndName(ctx context.Context, in *Request, out *Response) error {
+	return h.VolumeApiHandler.FindVolumeByNamespaceAndName(ctx, in, out)
+}
+
+func (h *volumeApiHandler) ResizeVolume(ctx context.Context, in *Request, out *Response) error {
+	return h.VolumeApiHandler.ResizeVolume(ctx, in, out)
+}
+
+func (h *volumeApiHandler) ListStorageClasses(ctx context.Context, in *Request, out *Response) error {
+	return h.VolumeApiHandler.ListStorageClasses(ctx, in, out)
+}
+
+func (h *volumeApiHandler) CreateSnapshot(ctx context.Context, in *Request, out *Response) error {
+	return h.VolumeApiHandler.CreateSnapshot(ctx, in, out)
+}
+
+func (h *volumeApiHandler) ListSnapshots(ctx context.Context, in *Request, out *Response) error {
+	return h.VolumeApiHandler.ListSnapshots(ctx, in, out)
+}
+
+func (h *volumeApiHandler) DeleteSnapshot(ctx context.Context, in *Request, out *Response) error {
+	return h.VolumeApiHandler.DeleteSnapshot(ctx, in, out)
+}
+
+func (h *volumeApiHandler) RestoreSnapshot(ctx context.Context, in *Request, out *Response) error {
+	return h.VolumeApiHandler.RestoreSnapshot(ctx, in, out)
+}
+
+func (h *volumeApiHandler) CloneVolume(ctx context.Context, in *Request, out *Response) error {
+	return h.VolumeApiHandler.CloneVolume(ctx, in, out)
+}
+
 func (h *volumeApiHandler) Call(ctx context.Context, in *Request, out *Response) error {
 	return h.VolumeApiHandler.Call(ctx, in, out)
 }
@@ -25,6 +25,9 @@ import (
 	"tini-paas/internal/appstore/proto/appstore"
 	"tini-paas/internal/appstore/repository"
 	service2 "tini-paas/internal/appstore/service"
+	"tini-paas/internal/middleware/proto/middleware"
+	"tini-paas/internal/pod/proto/pod"
+	"tini-paas/internal/volume/proto/volume"
 	"tini-paas/pkg/common"
 	hystrix2 "tini-paas/plugin/hystrix"
 )
@@ -159,14 +162,27 @@ func main() {
 	// 初始化服务
 	service.Init()
 
+	// 创建缺少的安装实例表，可以重复执行
+	err = repository.NewAppStoreRepository(db).MigrateTable()
+	if err != nil {
+		common.Error(err)
+	}
+
 	// 初始化数据表
 	//err = repository.NewAppStoreRepository(db).InitTable()
 	//if err != nil {
 	//	common.Fatal(err)
 	//}
 
+	// 安装应用时通过各自的服务创建资源
+	installServices := service2.InstallServices{
+		Pod:        pod.NewPodService("go.micro.service.pod", service.Client()),
+		Middleware: middleware.NewMiddlewareService("go.micro.service.middleware", service.Client()),
+		Volume:     volume.NewVolumeService("go.micro.service.volume", service.Client()),
+	}
+
 	// 注册句柄
-	storeService := service2.NewAppStoreService(repository.NewAppStoreRepository(db), clientSet)
+	storeService := service2.NewAppStoreService(repository.NewAppStoreRepository(db), clientSet, installServices)
 	err = appstore.RegisterAppStoreHandler(service.Server(), &handler.AppStoreHandler{
 		AppStoreDataService: storeService,
 	})
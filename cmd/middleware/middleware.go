@@ -25,6 +25,7 @@ import (
 	"tini-paas/internal/middleware/proto/middleware"
 	"tini-paas/internal/middleware/repository"
 	service2 "tini-paas/internal/middleware/service"
+	"tini-paas/internal/user/proto/user"
 	"tini-paas/pkg/common"
 	hystrix2 "tini-paas/plugin/hystrix"
 )
@@ -159,6 +160,18 @@ func main() {
 	// 初始化服务
 	service.Init()
 
+	// 已有数据库的名称唯一索引迁移为命名空间内唯一，并补充新增的字段和数据表，可以重复执行
+	err = repository.NewMiddlewareRepository(db).MigrateTable()
+	if err != nil {
+		common.Error(err)
+	}
+
+	// 备份任务名称改为同一中间件内唯一，并创建缺少的备份和恢复记录表
+	err = repository.NewMiddleBackupRepository(db).MigrateTable()
+	if err != nil {
+		common.Error(err)
+	}
+
 	// 初始化数据表
 	//err = repository.NewSvcRepository(db).InitTable()
 	//if err != nil {
@@ -168,15 +181,41 @@ func main() {
 	// 注册句柄
 	middlewareService := service2.NewMiddlewareService(repository.NewMiddlewareRepository(db), clientSet)
 	middleTypeService := service2.NewMiddleTypeService(repository.NewMiddleTypeRepository(db))
-	err = middleware.RegisterMiddlewareHandler(service.Server(), &handler.MiddlewareHandler{
+	middleBackupService := service2.NewMiddleBackupService(repository.NewMiddleBackupRepository(db), middlewareService, clientSet)
+
+	// 旧版本以明文保存在数据库中的密码迁移到Secret，可以重复执行
+	err = middlewareService.MigrateCredentials()
+	if err != nil {
+		common.Error(err)
+	}
+
+	// 服务重启前未完成的存储扩容继续跟踪
+	err = middlewareService.ResumeStorageResize()
+	if err != nil {
+		common.Error(err)
+	}
+
+	// 查看账号密码时通过用户服务鉴权
+	userService := user.NewUserService("go.micro.service.user", service.Client())
+	middlewareHandler := &handler.MiddlewareHandler{
 		// 注册两个服务接口
 		MiddlewareService: middlewareService,
 		MiddleTypeService: middleTypeService,
-	})
+		UserService:       userService,
+		// 定时备份和恢复
+		MiddleBackupService: middleBackupService,
+	}
+	err = middleware.RegisterMiddlewareHandler(service.Server(), middlewareHandler)
 	if err != nil {
 		return
 	}
 
+	// 继续执行服务重启前未完成的恢复
+	err = middlewareHandler.ResumeRestores()
+	if err != nil {
+		common.Error(err)
+	}
+
 	// 启动服务
 	err = service.Run()
 	if err != nil {
@@ -0,0 +1,176 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"tini-paas/internal/application/proto/application"
+)
+
+// usage 命令用法
+const usage = `用法：
+  paasctl apply -f <清单文件> [--prune] [--dry-run] [--server <API网关地址>]
+  paasctl export --kind <pod|svc|route|volume|middleware> --id <资源ID> [--server <API网关地址>]
+  paasctl export --app <应用ID> [--helm <chart目录>] [--server <API网关地址>]`
+
+// paasctl 命令行工具，通过API网关调用平台接口
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprintln(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	var err error
+	switch os.Args[1] {
+	case "apply":
+		flags := flag.NewFlagSet("apply", flag.ExitOnError)
+		file := flags.String("f", "", "清单文件，YAML或者JSON格式，- 表示标准输入")
+		prune := flags.Bool("prune", false, "删除应用中不在清单里的资源")
+		dryRun := flags.Bool("dry-run", false, "只输出执行计划，不做修改")
+		server := flags.String("server", defaultServer, "API网关地址")
+		_ = flags.Parse(os.Args[2:])
+		if *file == "" {
+			fmt.Fprintln(os.Stderr, "需要通过 -f 指定清单文件")
+			os.Exit(2)
+		}
+		err = apply(*server, *file, *prune, *dryRun)
+	case "export":
+		flags := flag.NewFlagSet("export", flag.ExitOnError)
+		kind := flags.String("kind", "", "资源类型：pod, svc, route, volume, middleware")
+		id := flags.Int64("id", 0, "资源ID")
+		app := flags.Int64("app", 0, "应用ID，导出应用中的全部资源")
+		helm := flags.String("helm", "", "打包为Helm chart并写入该目录")
+		server := flags.String("server", defaultServer, "API网关地址")
+		_ = flags.Parse(os.Args[2:])
+		if *app == 0 && (*kind == "" || *id == 0) {
+			fmt.Fprintln(os.Stderr, "需要指定 --app 或者 --kind 和 --id")
+			os.Exit(2)
+		}
+		err = export(*server, *kind, *id, *app, *helm)
+	default:
+		fmt.Fprintln(os.Stderr, usage)
+		os.Exit(2)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+// defaultServer 默认的API网关地址
+const defaultServer = "http://127.0.0.1:8080"
+
+// call 调用API网关的接口，返回响应体
+func call(server, path string, query url.Values, body []byte) ([]byte, error) {
+	address := strings.TrimSuffix(server, "/") + path + "?" + query.Encode()
+	var rsp *http.Response
+	var err error
+	if body == nil {
+		rsp, err = http.Get(address)
+	} else {
+		rsp, err = http.Post(address, "text/plain", bytes.NewReader(body))
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer rsp.Body.Close()
+	data, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if rsp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("执行失败(%d)：%s", rsp.StatusCode, data)
+	}
+	return data, nil
+}
+
+// apply 将清单发送到 /applicationApi/Apply 并输出执行计划
+func apply(server, file string, prune, dryRun bool) error {
+	var manifest []byte
+	var err error
+	if file == "-" {
+		manifest, err = io.ReadAll(os.Stdin)
+	} else {
+		manifest, err = os.ReadFile(file)
+	}
+	if err != nil {
+		return err
+	}
+
+	query := url.Values{}
+	query.Set("prune", strconv.FormatBool(prune))
+	query.Set("dry_run", strconv.FormatBool(dryRun))
+	body, err := call(server, "/applicationApi/Apply", query, manifest)
+	if err != nil {
+		return err
+	}
+
+	result := &application.ApplyResult{}
+	err = json.Unmarshal(body, result)
+	if err != nil {
+		return err
+	}
+	for _, step := range result.Plan {
+		line := fmt.Sprintf("%-10s %s/%s", step.Action, step.ChildKind, step.ChildName)
+		if len(step.ChangedFields) > 0 {
+			line += " (" + strings.Join(step.ChangedFields, ", ") + ")"
+		}
+		fmt.Println(line)
+	}
+	if dryRun {
+		fmt.Println("dry-run：" + result.Msg)
+	} else {
+		fmt.Println("应用ID " + strconv.FormatInt(result.AppId, 10) + "：" + result.Msg)
+	}
+	return nil
+}
+
+// export 导出资源或者应用，YAML输出到标准输出，Helm chart写入目录
+func export(server, kind string, id, app int64, helm string) error {
+	query := url.Values{}
+	path := "/applicationApi/Export"
+	if app != 0 {
+		path = "/applicationApi/ExportApplication"
+		query.Set("app_id", strconv.FormatInt(app, 10))
+		query.Set("helm", strconv.FormatBool(helm != ""))
+	} else {
+		query.Set("child_kind", kind)
+		query.Set("child_id", strconv.FormatInt(id, 10))
+	}
+	body, err := call(server, path, query, nil)
+	if err != nil {
+		return err
+	}
+
+	result := &application.ExportResult{}
+	err = json.Unmarshal(body, result)
+	if err != nil {
+		return err
+	}
+	if app == 0 || helm == "" {
+		fmt.Print(result.Yaml)
+		return nil
+	}
+
+	for name, content := range result.ChartFiles {
+		file := filepath.Join(helm, filepath.FromSlash(name))
+		err = os.MkdirAll(filepath.Dir(file), 0755)
+		if err != nil {
+			return err
+		}
+		err = os.WriteFile(file, []byte(content), 0644)
+		if err != nil {
+			return err
+		}
+	}
+	fmt.Println("Helm chart 已经写入 " + helm)
+	return nil
+}
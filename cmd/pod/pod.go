@@ -24,6 +24,7 @@ import (
 	"tini-paas/internal/pod/proto/pod"
 	"tini-paas/internal/pod/repository"
 	service2 "tini-paas/internal/pod/service"
+	"tini-paas/internal/svc/proto/svc"
 	"tini-paas/pkg/common"
 	hystrix2 "tini-paas/plugin/hystrix"
 )
@@ -160,6 +161,12 @@ func main() {
 	// 初始化服务
 	service.Init()
 
+	// 已有数据库的名称唯一索引迁移为命名空间内唯一，并补充新增的字段和数据表，可以重复执行
+	err = repository.NewPodRepository(db).MigrateTable()
+	if err != nil {
+		common.Error(err)
+	}
+
 	// 初始化数据表，只初始化一次
 	//err = repository.NewPodRepository(db).InitTable()
 	//if err != nil {
@@ -169,7 +176,9 @@ func main() {
 	// 注册句柄
 	// svcapi：后端微服务，service2：k8s服务
 	podDataService := service2.NewPodService(repository.NewPodRepository(db), clientSet)
-	err = pod.RegisterPodHandler(service.Server(), &handler.PodHandler{PodService: podDataService})
+	// pod端口变化后通过svc服务同步关联的服务
+	svcService := svc.NewSvcService("go.micro.service.svc", service.Client())
+	err = pod.RegisterPodHandler(service.Server(), &handler.PodHandler{PodService: podDataService, SvcService: svcService})
 	if err != nil {
 		return
 	}
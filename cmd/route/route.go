@@ -13,6 +13,7 @@ import (
 	"github.com/jinzhu/gorm"
 	_ "github.com/jinzhu/gorm/dialects/mysql"
 	"github.com/opentracing/opentracing-go"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/clientcmd"
 	"k8s.io/client-go/util/homedir"
@@ -20,11 +21,13 @@ import (
 	"net/http"
 	"path/filepath"
 	"strconv"
+	"time"
 	"tini-paas/config"
 	"tini-paas/internal/route/handler"
 	"tini-paas/internal/route/proto/route"
 	"tini-paas/internal/route/repository"
 	service2 "tini-paas/internal/route/service"
+	"tini-paas/internal/user/proto/user"
 	"tini-paas/pkg/common"
 	hystrix2 "tini-paas/plugin/hystrix"
 )
@@ -39,6 +42,8 @@ var (
 	tracerPort           = 6831        // 链路追踪端口
 	hystrixPort          = 9095        // 熔断器端口
 	prometheusPort       = 9195        // 监控
+
+	backendCheckInterval = 5 * time.Minute // 检查路由后端服务的间隔
 )
 
 func main() {
@@ -159,6 +164,12 @@ func main() {
 	// 初始化服务
 	service.Init()
 
+	// 已有数据库的名称唯一索引迁移为命名空间内唯一，并补充新增的字段和数据表，可以重复执行
+	err = repository.NewRouteRepository(db).MigrateTable()
+	if err != nil {
+		common.Error(err)
+	}
+
 	//初始化数据表
 	//err = repository.NewRouteRepository(db).InitTable()
 	//if err != nil {
@@ -166,14 +177,26 @@ func main() {
 	//}
 
 	// 注册句柄
-	routeService := service2.NewRouteService(repository.NewRouteRepository(db), clientSet)
+	// Gateway API 不在 client-go 中，使用动态客户端
+	dynamicClient, err := dynamic.NewForConfig(config)
+	if err != nil {
+		common.Fatal(err.Error())
+	}
+
+	routeService := service2.NewRouteService(repository.NewRouteRepository(db), clientSet, dynamicClient)
+	// 设置原始注解时通过用户服务鉴权
+	userService := user.NewUserService("go.micro.service.user", service.Client())
 	err = route.RegisterRouteHandler(service.Server(), &handler.RouteHandler{
 		RouteService: routeService,
+		UserService:  userService,
 	})
 	if err != nil {
 		return
 	}
 
+	// 定期检查路由引用的服务是否被删除
+	go routeService.RunBackendChecker(backendCheckInterval)
+
 	// 启动服务
 	err = service.Run()
 	if err != nil {
@@ -20,6 +20,7 @@ import (
 	"net/http"
 	"path/filepath"
 	"strconv"
+	"tini-paas/internal/pod/proto/pod"
 	"tini-paas/internal/svc/handler"
 	"tini-paas/internal/svc/proto/svc"
 	"tini-paas/internal/svc/repository"
@@ -158,14 +159,23 @@ func main() {
 	// 初始化服务
 	service.Init()
 
+	// 已有数据库的名称唯一索引迁移为命名空间内唯一，并补充新增的字段和数据表，可以重复执行
+	err = repository.NewSvcRepository(db).MigrateTable()
+	if err != nil {
+		common.Error(err)
+	}
+
 	// 初始化数据表
 	//err = repository.NewSvcRepository(db).InitTable()
 	//if err != nil {
 	//	common.Fatal(err)
 	//}
 
+	// 根据pod端口生成服务时通过pod服务查询端口
+	podService := pod.NewPodService("go.micro.service.pod", service.Client())
+
 	// 注册句柄
-	svcDataService := service2.NewService(repository.NewSvcRepository(db), clientSet)
+	svcDataService := service2.NewService(repository.NewSvcRepository(db), clientSet, podService)
 	err = svc.RegisterSvcHandler(service.Server(), &handler.SvcHandler{SvcService: svcDataService})
 	if err != nil {
 		return
@@ -13,6 +13,7 @@ import (
 	"github.com/jinzhu/gorm"
 	_ "github.com/jinzhu/gorm/dialects/mysql"
 	"github.com/opentracing/opentracing-go"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/clientcmd"
 	"k8s.io/client-go/util/homedir"
@@ -159,16 +160,36 @@ func main() {
 	// 初始化服务
 	service.Init()
 
+	// 已有数据库的名称唯一索引迁移为命名空间内唯一，并补充新增的字段和数据表，可以重复执行
+	err = repository.NewVolumeRepository(db).MigrateTable()
+	if err != nil {
+		common.Error(err)
+	}
+
+	// 创建缺少的快照表
+	err = repository.NewSnapshotRepository(db).MigrateTable()
+	if err != nil {
+		common.Error(err)
+	}
+
 	// 初始化数据表
 	//err = repository.NewSvcRepository(db).InitTable()
 	//if err != nil {
 	//	common.Fatal(err)
 	//}
 
+	// 快照不在 client-go 中，使用动态客户端
+	dynamicClient, err := dynamic.NewForConfig(config)
+	if err != nil {
+		common.Fatal(err.Error())
+	}
+
 	// 注册句柄
 	volumeService := service2.NewVolumeService(repository.NewVolumeRepository(db), clientSet)
+	snapshotService := service2.NewSnapshotService(repository.NewSnapshotRepository(db), dynamicClient)
 	err = volume.RegisterVolumeHandler(service.Server(), &handler.VolumeHandler{
-		VolumeService: volumeService,
+		VolumeService:   volumeService,
+		SnapshotService: snapshotService,
 	})
 	if err != nil {
 		return
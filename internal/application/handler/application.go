@@ -0,0 +1,318 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"github.com/jinzhu/gorm"
+	"strconv"
+	"tini-paas/internal/application/model"
+	"tini-paas/internal/application/proto/application"
+	"tini-paas/internal/application/service"
+	"tini-paas/pkg/common"
+)
+
+// ApplicationHandler 应用处理
+type ApplicationHandler struct {
+	ApplicationService service.ApplicationService
+}
+
+// AddApplication 添加应用，应用中的资源通过 AddAppChild 加入
+func (a *ApplicationHandler) AddApplication(ctx context.Context, info *application.ApplicationInfo, response *application.Response) error {
+	if info.AppName == "" || info.AppNamespace == "" {
+		err := errors.New("应用需要指定名称和命名空间")
+		common.Error(err)
+		return err
+	}
+	// 同一命名空间内名称唯一，名称同时是资源公共标签的值
+	// 查询出错时不能当作不存在，否则数据库异常时会绕过检查
+	_, err := a.ApplicationService.FindApplicationByNamespaceAndName(info.AppNamespace, info.AppName)
+	if err == nil {
+		err = errors.New("应用 " + info.AppNamespace + "/" + info.AppName + " 已经存在")
+	}
+	if !gorm.IsRecordNotFoundError(err) {
+		common.Error(err)
+		return err
+	}
+
+	appModel := &model.Application{
+		AppName:      info.AppName,
+		AppNamespace: info.AppNamespace,
+		AppDescribe:  info.AppDescribe,
+		AppTeamID:    info.AppTeamId,
+	}
+	appID, err := a.ApplicationService.AddApplication(appModel)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	common.Info("应用添加成功，ID为：" + strconv.FormatInt(appID, 10))
+	response.Msg = "应用添加成功，ID为：" + strconv.FormatInt(appID, 10)
+	return nil
+}
+
+// UpdateApplication 更新应用的描述和所属团队，名称和命名空间不能修改
+func (a *ApplicationHandler) UpdateApplication(ctx context.Context, info *application.ApplicationInfo, response *application.Response) error {
+	appModel, err := a.ApplicationService.FindApplicationByID(info.Id)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	if info.AppName != appModel.AppName || info.AppNamespace != appModel.AppNamespace {
+		err = errors.New("应用的名称和命名空间不能修改")
+		common.Error(err)
+		return err
+	}
+
+	appModel.AppDescribe = info.AppDescribe
+	appModel.AppTeamID = info.AppTeamId
+	appModel.AppChildren = nil
+	err = a.ApplicationService.UpdateApplication(appModel)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	response.Msg = "应用 " + appModel.AppName + " 更新成功"
+	return nil
+}
+
+// FindApplicationByID 根据ID查找应用，包含应用中的资源
+func (a *ApplicationHandler) FindApplicationByID(ctx context.Context, id *application.ApplicationID, info *application.ApplicationInfo) error {
+	appModel, err := a.ApplicationService.FindApplicationByID(id.Id)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	err = common.SwapTo(appModel, info)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	return nil
+}
+
+// FindAllApplication 查找全部应用
+func (a *ApplicationHandler) FindAllApplication(ctx context.Context, all *application.FindAll, rsp *application.AllApplication) error {
+	apps, err := a.ApplicationService.FindAllApplication()
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	for _, v := range apps {
+		info := &application.ApplicationInfo{}
+		err = common.SwapTo(v, info)
+		if err != nil {
+			common.Error(err)
+			return err
+		}
+		rsp.ApplicationInfo = append(rsp.ApplicationInfo, info)
+	}
+	return nil
+}
+
+// DeleteApplication 删除应用中的全部资源，全部删除成功后删除应用
+// 部分资源删除失败时应用保留，只包含删除失败的资源，可以再次删除
+func (a *ApplicationHandler) DeleteApplication(ctx context.Context, id *application.ApplicationID, response *application.Response) error {
+	appModel, err := a.ApplicationService.FindApplicationByID(id.Id)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+
+	failed, err := a.ApplicationService.DeleteChildren(appModel)
+	if err != nil {
+		common.Error(err)
+		response.Msg = "应用 " + appModel.AppName + " 中有 " + strconv.Itoa(len(failed)) + " 个资源删除失败：" + err.Error()
+		return err
+	}
+
+	err = a.ApplicationService.DeleteApplication(appModel.ID)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	response.Msg = "应用 " + appModel.AppName + " 和其中的资源已经删除"
+	common.Info(response.Msg)
+	return nil
+}
+
+// AddAppChild 将已有的资源加入应用
+func (a *ApplicationHandler) AddAppChild(ctx context.Context, req *application.AppChild, response *application.Response) error {
+	appModel, err := a.ApplicationService.FindApplicationByID(req.AppId)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+
+	child, err := a.ApplicationService.AddChild(appModel, req.ChildKind, req.ChildId)
+	if err != nil {
+		common.Error(err)
+		response.Msg = err.Error()
+		return err
+	}
+	response.Msg = req.ChildKind + " " + child.ChildName + " 已经加入应用 " + appModel.AppName
+	return nil
+}
+
+// RemoveAppChild 将资源移出应用，资源本身不删除
+func (a *ApplicationHandler) RemoveAppChild(ctx context.Context, req *application.AppChild, response *application.Response) error {
+	appModel, err := a.ApplicationService.FindApplicationByID(req.AppId)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+
+	for i := range appModel.AppChildren {
+		child := &appModel.AppChildren[i]
+		if child.ChildKind != req.ChildKind || child.ChildID != req.ChildId {
+			continue
+		}
+		err = a.ApplicationService.RemoveChild(appModel, child)
+		if err != nil {
+			common.Error(err)
+			response.Msg = err.Error()
+			return err
+		}
+		response.Msg = req.ChildKind + " " + child.ChildName + " 已经移出应用 " + appModel.AppName
+		return nil
+	}
+	err = errors.New(req.ChildKind + " " + strconv.FormatInt(req.ChildId, 10) + " 不在应用 " + appModel.AppName + " 中")
+	common.Error(err)
+	return err
+}
+
+// SyncAppLabels 重新为应用中的全部资源打上公共标签
+func (a *ApplicationHandler) SyncAppLabels(ctx context.Context, id *application.ApplicationID, response *application.Response) error {
+	appModel, err := a.ApplicationService.FindApplicationByID(id.Id)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	err = a.ApplicationService.SyncLabels(appModel)
+	if err != nil {
+		common.Error(err)
+		response.Msg = err.Error()
+		return err
+	}
+	response.Msg = "应用 " + appModel.AppName + " 的标签同步成功"
+	return nil
+}
+
+// GetAppStatus 汇总应用中全部资源的状态
+func (a *ApplicationHandler) GetAppStatus(ctx context.Context, id *application.ApplicationID, rsp *application.AppStatus) error {
+	appModel, err := a.ApplicationService.FindApplicationByID(id.Id)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	status, err := a.ApplicationService.GetStatus(appModel)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	rsp.AppId = status.AppId
+	rsp.AppStatus = status.AppStatus
+	rsp.AppChildren = status.AppChildren
+	return nil
+}
+
+// GetAppTopology 应用中资源之间的引用关系
+func (a *ApplicationHandler) GetAppTopology(ctx context.Context, id *application.ApplicationID, rsp *application.AppTopology) error {
+	appModel, err := a.ApplicationService.FindApplicationByID(id.Id)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	topology, err := a.ApplicationService.GetTopology(appModel)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	rsp.AppId = topology.AppId
+	rsp.Nodes = topology.Nodes
+	rsp.Edges = topology.Edges
+	return nil
+}
+
+// StopApplication 停止应用中全部的pod和中间件
+func (a *ApplicationHandler) StopApplication(ctx context.Context, id *application.ApplicationID, response *application.Response) error {
+	appModel, err := a.ApplicationService.FindApplicationByID(id.Id)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	err = a.ApplicationService.Stop(appModel)
+	if err != nil {
+		common.Error(err)
+		response.Msg = err.Error()
+		return err
+	}
+	response.Msg = "应用 " + appModel.AppName + " 已经停止"
+	common.Info(response.Msg)
+	return nil
+}
+
+// StartApplication 按照停止前的副本数启动应用
+func (a *ApplicationHandler) StartApplication(ctx context.Context, id *application.ApplicationID, response *application.Response) error {
+	appModel, err := a.ApplicationService.FindApplicationByID(id.Id)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	err = a.ApplicationService.Start(appModel)
+	if err != nil {
+		common.Error(err)
+		response.Msg = err.Error()
+		return err
+	}
+	response.Msg = "应用 " + appModel.AppName + " 已经启动"
+	common.Info(response.Msg)
+	return nil
+}
+
+// Apply 按照清单创建或更新应用和其中的资源，prune 时删除应用中不在清单里的资源
+// dry_run 时只返回执行计划
+func (a *ApplicationHandler) Apply(ctx context.Context, req *application.ApplyRequest, rsp *application.ApplyResult) error {
+	manifest, err := service.ParseManifest([]byte(req.Manifest))
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	result, err := a.ApplicationService.Apply(manifest, req.Prune, req.DryRun)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	rsp.AppId = result.AppId
+	rsp.Plan = result.Plan
+	rsp.Msg = result.Msg
+	common.Info("应用 " + manifest.Namespace + "/" + manifest.Name + " 清单执行完成：" + result.Msg)
+	return nil
+}
+
+// Export 导出单个资源应用到k8s的对象，资源不需要属于应用
+func (a *ApplicationHandler) Export(ctx context.Context, req *application.ExportRequest, rsp *application.ExportResult) error {
+	content, err := a.ApplicationService.Export(req.ChildKind, req.ChildId)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	rsp.Yaml = content
+	return nil
+}
+
+// ExportApplication 导出应用中的全部资源，helm 时打包为Helm chart
+func (a *ApplicationHandler) ExportApplication(ctx context.Context, req *application.ExportAppRequest, rsp *application.ExportResult) error {
+	appModel, err := a.ApplicationService.FindApplicationByID(req.AppId)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	result, err := a.ApplicationService.ExportApplication(appModel, req.Helm)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	rsp.Yaml = result.Yaml
+	rsp.ChartFiles = result.ChartFiles
+	return nil
+}
@@ -0,0 +1,32 @@
+package model
+
+// 应用中资源的类型
+const (
+	KindPod        = "pod"
+	KindSvc        = "svc"
+	KindRoute      = "route"
+	KindVolume     = "volume"
+	KindMiddleware = "middleware"
+)
+
+// AppChild 应用中的一个资源，资源本身由各自的服务管理
+type AppChild struct {
+	// ID 主键
+	ID int64 `gorm:"primary_key;not_null;auto_increment" json:"id"`
+
+	// AppID 所属应用
+	AppID int64 `gorm:"index" json:"app_id"`
+
+	// ChildKind 资源类型：pod, svc, route, volume, middleware
+	// 一个资源只能属于一个应用
+	ChildKind string `gorm:"unique_index:idx_child_kind_id;not_null" json:"child_kind"`
+
+	// ChildID 资源在所属服务中的ID
+	ChildID int64 `gorm:"unique_index:idx_child_kind_id;not_null" json:"child_id"`
+
+	// ChildName 资源名称，也是资源在k8s中的名称
+	ChildName string `json:"child_name"`
+
+	// ChildReplicas 停止应用前的副本数，启动时恢复
+	ChildReplicas int32 `json:"child_replicas"`
+}
@@ -0,0 +1,24 @@
+package model
+
+// Application 应用，将一组pod、服务、路由、存储和中间件作为一个整体管理
+type Application struct {
+	ID int64 `gorm:"primary_key;not_null;auto_increment" json:"id"`
+
+	// AppName 应用名称，同一命名空间内唯一，同时作为资源公共标签的值
+	AppName string `gorm:"unique_index:idx_app_namespace_name;not_null" json:"app_name"`
+
+	// AppNamespace 应用所属的命名空间，应用中的资源都在这个命名空间中
+	AppNamespace string `gorm:"unique_index:idx_app_namespace_name;not_null" json:"app_namespace"`
+
+	// AppDescribe 应用描述
+	AppDescribe string `json:"app_describe"`
+
+	// AppTeamID 应用所属团队
+	AppTeamID int64 `json:"app_team_id"`
+
+	// AppStopped 应用是否被停止
+	AppStopped bool `json:"app_stopped"`
+
+	// AppChildren 应用中的资源
+	AppChildren []AppChild `gorm:"ForeignKey:AppID" json:"app_children"`
+}
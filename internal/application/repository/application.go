@@ -0,0 +1,138 @@
+package repository
+
+import (
+	"github.com/jinzhu/gorm"
+	"tini-paas/internal/application/model"
+	"tini-paas/pkg/common"
+)
+
+// ApplicationRepository 应用数据库操作接口
+type ApplicationRepository interface {
+	// InitTable 初始化表
+	InitTable() error
+
+	// MigrateTable 创建缺少的应用和应用资源表和字段，可以重复执行
+	MigrateTable() error
+
+	// CreateApplication 创建一条应用数据
+	CreateApplication(*model.Application) (int64, error)
+
+	// DeleteApplicationByID 删除应用和应用中资源的记录
+	DeleteApplicationByID(int64) error
+
+	// UpdateApplication 更新应用数据
+	UpdateApplication(*model.Application) error
+
+	// UpdateStopped 更新应用的停止状态
+	UpdateStopped(int64, bool) error
+
+	// FindApplicationByID 根据ID查找应用，包含应用中的资源
+	FindApplicationByID(int64) (*model.Application, error)
+
+	// FindApplicationByNamespaceAndName 根据命名空间和名称查找应用
+	FindApplicationByNamespaceAndName(string, string) (*model.Application, error)
+
+	// FindAll 查找所有应用
+	FindAll() ([]model.Application, error)
+
+	// CreateChild 将资源加入应用
+	CreateChild(*model.AppChild) (int64, error)
+
+	// DeleteChild 将资源移出应用
+	DeleteChild(int64) error
+
+	// UpdateChildReplicas 记录资源停止前的副本数
+	UpdateChildReplicas(int64, int32) error
+
+	// FindChild 根据资源类型和ID查找资源所在的应用记录
+	FindChild(string, int64) (*model.AppChild, error)
+}
+
+// NewApplicationRepository 初始化数据操作对象
+func NewApplicationRepository(db *gorm.DB) ApplicationRepository {
+	return &Application{
+		db: db,
+	}
+}
+
+// Application 数据库对象
+type Application struct {
+	db *gorm.DB
+}
+
+// InitTable 初始化表
+func (a *Application) InitTable() error {
+	return a.db.CreateTable(&model.Application{}, &model.AppChild{}).Error
+}
+
+// MigrateTable 创建缺少的应用和应用资源表和字段，可以重复执行
+func (a *Application) MigrateTable() error {
+	return common.MigrateTables(a.db, &model.Application{}, &model.AppChild{})
+}
+
+// CreateApplication 创建一条应用数据
+func (a *Application) CreateApplication(app *model.Application) (int64, error) {
+	err := a.db.Create(app).Error
+	return app.ID, err
+}
+
+// DeleteApplicationByID 删除应用和应用中资源的记录
+func (a *Application) DeleteApplicationByID(i int64) error {
+	return a.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Where("app_id = ?", i).Delete(&model.AppChild{}).Error
+		if err != nil {
+			return err
+		}
+		return tx.Where("id = ?", i).Delete(&model.Application{}).Error
+	})
+}
+
+// UpdateApplication 更新应用数据
+func (a *Application) UpdateApplication(app *model.Application) error {
+	return a.db.Model(app).Update(app).Error
+}
+
+// UpdateStopped 更新应用的停止状态，false 不能通过结构体更新
+func (a *Application) UpdateStopped(i int64, stopped bool) error {
+	return a.db.Model(&model.Application{}).Where("id = ?", i).Update("app_stopped", stopped).Error
+}
+
+// FindApplicationByID 根据ID查找应用，包含应用中的资源
+func (a *Application) FindApplicationByID(i int64) (*model.Application, error) {
+	app := &model.Application{}
+	return app, a.db.Preload("AppChildren").First(app, i).Error
+}
+
+// FindApplicationByNamespaceAndName 根据命名空间和名称查找应用
+func (a *Application) FindApplicationByNamespaceAndName(namespace, name string) (*model.Application, error) {
+	app := &model.Application{}
+	return app, a.db.Preload("AppChildren").Where("app_namespace = ? AND app_name = ?", namespace, name).First(app).Error
+}
+
+// FindAll 查找所有应用
+func (a *Application) FindAll() ([]model.Application, error) {
+	var appAll []model.Application
+	return appAll, a.db.Preload("AppChildren").Find(&appAll).Error
+}
+
+// CreateChild 将资源加入应用
+func (a *Application) CreateChild(child *model.AppChild) (int64, error) {
+	err := a.db.Create(child).Error
+	return child.ID, err
+}
+
+// DeleteChild 将资源移出应用
+func (a *Application) DeleteChild(i int64) error {
+	return a.db.Where("id = ?", i).Delete(&model.AppChild{}).Error
+}
+
+// UpdateChildReplicas 记录资源停止前的副本数
+func (a *Application) UpdateChildReplicas(i int64, replicas int32) error {
+	return a.db.Model(&model.AppChild{}).Where("id = ?", i).Update("child_replicas", replicas).Error
+}
+
+// FindChild 根据资源类型和ID查找资源所在的应用记录
+func (a *Application) FindChild(kind string, childID int64) (*model.AppChild, error) {
+	child := &model.AppChild{}
+	return child, a.db.Where("child_kind = ? AND child_id = ?", kind, childID).First(child).Error
+}
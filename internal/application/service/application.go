@@ -0,0 +1,283 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/jinzhu/gorm"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"strconv"
+	"tini-paas/internal/application/model"
+	"tini-paas/internal/application/proto/application"
+	"tini-paas/internal/application/repository"
+	"tini-paas/internal/middleware/proto/middleware"
+	"tini-paas/internal/pod/proto/pod"
+	"tini-paas/internal/route/proto/route"
+	"tini-paas/internal/svc/proto/svc"
+	"tini-paas/internal/volume/proto/volume"
+)
+
+// ApplicationService 应用服务
+type ApplicationService interface {
+	// AddApplication 添加应用
+	AddApplication(*model.Application) (int64, error)
+
+	// DeleteApplication 删除应用和应用中资源的记录
+	DeleteApplication(int64) error
+
+	// UpdateApplication 更新应用
+	UpdateApplication(*model.Application) error
+
+	// FindApplicationByID 根据ID查找应用
+	FindApplicationByID(int64) (*model.Application, error)
+
+	// FindApplicationByNamespaceAndName 根据命名空间和名称查找应用
+	FindApplicationByNamespaceAndName(string, string) (*model.Application, error)
+
+	// FindAllApplication 查找全部应用
+	FindAllApplication() ([]model.Application, error)
+
+	// AddChild 将已有的资源加入应用并打上公共标签
+	AddChild(*model.Application, string, int64) (*model.AppChild, error)
+
+	// RemoveChild 将资源移出应用并去掉公共标签
+	RemoveChild(*model.Application, *model.AppChild) error
+
+	// SyncLabels 为应用中的全部资源重新打上公共标签
+	SyncLabels(*model.Application) error
+
+	// GetStatus 汇总应用中全部资源在k8s中的状态
+	GetStatus(*model.Application) (*application.AppStatus, error)
+
+	// GetTopology 应用中资源之间的引用关系
+	GetTopology(*model.Application) (*application.AppTopology, error)
+
+	// Stop 停止应用中的pod和中间件
+	Stop(*model.Application) error
+
+	// Start 按照停止前的副本数启动应用
+	Start(*model.Application) error
+
+	// DeleteChildren 通过各自的服务删除应用中的全部资源，返回没有删除成功的资源
+	DeleteChildren(*model.Application) ([]model.AppChild, error)
+
+	// Apply 按照清单计算执行计划并通过各自的服务创建、更新和删除资源
+	Apply(*Manifest, bool, bool) (*application.ApplyResult, error)
+
+	// Export 导出单个资源应用到k8s的对象
+	Export(string, int64) (string, error)
+
+	// ExportApplication 导出应用中的全部资源，可以打包为Helm chart
+	ExportApplication(*model.Application, bool) (*application.ExportResult, error)
+}
+
+// ChildServices 管理应用中资源的微服务客户端
+type ChildServices struct {
+	Pod        pod.PodService
+	Svc        svc.SvcService
+	Route      route.RouteService
+	Volume     volume.VolumeService
+	Middleware middleware.MiddlewareService
+}
+
+// NewApplicationService 初始化应用服务
+func NewApplicationService(applicationRepository repository.ApplicationRepository, clientSet *kubernetes.Clientset, dynamicClient dynamic.Interface, services ChildServices) ApplicationService {
+	return &ApplicationDataService{
+		ApplicationRepository: applicationRepository,
+		K8sClientSet:          clientSet,
+		DynamicClient:         dynamicClient,
+		Services:              services,
+	}
+}
+
+// ApplicationDataService 应用服务对象
+type ApplicationDataService struct {
+	// ApplicationRepository 操作数据库接口
+	ApplicationRepository repository.ApplicationRepository
+
+	// K8sClientSet k8s客户端集合，读取资源的实时状态
+	K8sClientSet *kubernetes.Clientset
+
+	// DynamicClient 动态客户端，为不同类型的资源打标签
+	DynamicClient dynamic.Interface
+
+	// Services 资源所属的微服务，资源的创建、更新和删除都通过这些服务完成
+	Services ChildServices
+}
+
+// AddApplication 添加应用
+func (a *ApplicationDataService) AddApplication(m *model.Application) (int64, error) {
+	return a.ApplicationRepository.CreateApplication(m)
+}
+
+// DeleteApplication 删除应用和应用中资源的记录
+func (a *ApplicationDataService) DeleteApplication(id int64) error {
+	return a.ApplicationRepository.DeleteApplicationByID(id)
+}
+
+// UpdateApplication 更新应用
+func (a *ApplicationDataService) UpdateApplication(m *model.Application) error {
+	return a.ApplicationRepository.UpdateApplication(m)
+}
+
+// FindApplicationByID 根据ID查找应用
+func (a *ApplicationDataService) FindApplicationByID(id int64) (*model.Application, error) {
+	return a.ApplicationRepository.FindApplicationByID(id)
+}
+
+// FindApplicationByNamespaceAndName 根据命名空间和名称查找应用
+func (a *ApplicationDataService) FindApplicationByNamespaceAndName(namespace, name string) (*model.Application, error) {
+	return a.ApplicationRepository.FindApplicationByNamespaceAndName(namespace, name)
+}
+
+// FindAllApplication 查找全部应用
+func (a *ApplicationDataService) FindAllApplication() ([]model.Application, error) {
+	return a.ApplicationRepository.FindAll()
+}
+
+// AddChild 将已有的资源加入应用并打上公共标签
+// 资源需要和应用在同一个命名空间，一个资源只能属于一个应用
+func (a *ApplicationDataService) AddChild(app *model.Application, kind string, childID int64) (*model.AppChild, error) {
+	// 查询出错时不能当作不属于任何应用
+	existing, err := a.ApplicationRepository.FindChild(kind, childID)
+	if err == nil {
+		return nil, errors.New(kind + " " + strconv.FormatInt(childID, 10) + " 已经属于应用 " + strconv.FormatInt(existing.AppID, 10))
+	}
+	if !gorm.IsRecordNotFoundError(err) {
+		return nil, err
+	}
+
+	info, err := a.findChild(kind, childID)
+	if err != nil {
+		return nil, err
+	}
+	if info.namespace != app.AppNamespace {
+		return nil, errors.New(kind + " " + info.name + " 在命名空间 " + info.namespace + " 中，应用在命名空间 " + app.AppNamespace + " 中")
+	}
+
+	child := &model.AppChild{
+		AppID:     app.ID,
+		ChildKind: kind,
+		ChildID:   childID,
+		ChildName: info.name,
+	}
+	err = a.applyLabels(app, child, appLabels(app))
+	if err != nil {
+		return nil, err
+	}
+	_, err = a.ApplicationRepository.CreateChild(child)
+	if err != nil {
+		return nil, err
+	}
+	return child, nil
+}
+
+// RemoveChild 将资源移出应用并去掉公共标签，资源本身不删除
+func (a *ApplicationDataService) RemoveChild(app *model.Application, child *model.AppChild) error {
+	err := a.applyLabels(app, child, removeLabels())
+	if err != nil {
+		return err
+	}
+	return a.ApplicationRepository.DeleteChild(child.ID)
+}
+
+// SyncLabels 为应用中的全部资源重新打上公共标签，资源在k8s中重建后使用
+func (a *ApplicationDataService) SyncLabels(app *model.Application) error {
+	for i := range app.AppChildren {
+		err := a.applyLabels(app, &app.AppChildren[i], appLabels(app))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// childInfo 从资源所属服务查询到的资源信息，只有对应类型的字段不为空
+type childInfo struct {
+	id         int64
+	name       string
+	namespace  string
+	pod        *pod.PodInfo
+	svc        *svc.SvcInfo
+	route      *route.RouteInfo
+	volume     *volume.VolumeInfo
+	middleware *middleware.MiddlewareInfo
+}
+
+// findChild 通过资源所属的服务查询资源
+func (a *ApplicationDataService) findChild(kind string, id int64) (*childInfo, error) {
+	ctx := context.TODO()
+	switch kind {
+	case model.KindPod:
+		info, err := a.Services.Pod.FindPodByID(ctx, &pod.PodID{Id: id})
+		if err != nil {
+			return nil, err
+		}
+		return &childInfo{id: info.Id, name: info.PodName, namespace: info.PodNamespace, pod: info}, nil
+	case model.KindSvc:
+		info, err := a.Services.Svc.FindSvcByID(ctx, &svc.SvcID{Id: id})
+		if err != nil {
+			return nil, err
+		}
+		return &childInfo{id: info.Id, name: info.SvcName, namespace: info.SvcNamespace, svc: info}, nil
+	case model.KindRoute:
+		info, err := a.Services.Route.FindRouteByID(ctx, &route.RouteID{Id: id})
+		if err != nil {
+			return nil, err
+		}
+		return &childInfo{id: info.Id, name: info.RouteName, namespace: info.RouteNamespace, route: info}, nil
+	case model.KindVolume:
+		info, err := a.Services.Volume.FindVolumeByID(ctx, &volume.VolumeID{Id: id})
+		if err != nil {
+			return nil, err
+		}
+		return &childInfo{id: info.Id, name: info.VolumeName, namespace: info.VolumeNamespace, volume: info}, nil
+	case model.KindMiddleware:
+		info, err := a.Services.Middleware.FindMiddlewareByID(ctx, &middleware.MiddlewareID{Id: id})
+		if err != nil {
+			return nil, err
+		}
+		return &childInfo{id: info.Id, name: info.MiddleName, namespace: info.MiddleNamespace, middleware: info}, nil
+	default:
+		return nil, errors.New("不支持的资源类型：" + kind + "，可用类型：pod, svc, route, volume, middleware")
+	}
+}
+
+// findChildByName 通过资源所属的服务按照命名空间和名称查询资源
+func (a *ApplicationDataService) findChildByName(kind, namespace, name string) (*childInfo, error) {
+	ctx := context.TODO()
+	switch kind {
+	case model.KindPod:
+		info, err := a.Services.Pod.FindPodByNamespaceAndName(ctx, &pod.PodNamespaceName{Namespace: namespace, Name: name})
+		if err != nil {
+			return nil, err
+		}
+		return &childInfo{id: info.Id, name: info.PodName, namespace: info.PodNamespace, pod: info}, nil
+	case model.KindSvc:
+		info, err := a.Services.Svc.FindSvcByNamespaceAndName(ctx, &svc.SvcNamespaceName{Namespace: namespace, Name: name})
+		if err != nil {
+			return nil, err
+		}
+		return &childInfo{id: info.Id, name: info.SvcName, namespace: info.SvcNamespace, svc: info}, nil
+	case model.KindRoute:
+		info, err := a.Services.Route.FindRouteByNamespaceAndName(ctx, &route.RouteNamespaceName{Namespace: namespace, Name: name})
+		if err != nil {
+			return nil, err
+		}
+		return &childInfo{id: info.Id, name: info.RouteName, namespace: info.RouteNamespace, route: info}, nil
+	case model.KindVolume:
+		info, err := a.Services.Volume.FindVolumeByNamespaceAndName(ctx, &volume.VolumeNamespaceName{Namespace: namespace, Name: name})
+		if err != nil {
+			return nil, err
+		}
+		return &childInfo{id: info.Id, name: info.VolumeName, namespace: info.VolumeNamespace, volume: info}, nil
+	case model.KindMiddleware:
+		info, err := a.Services.Middleware.FindMiddlewareByNamespaceAndName(ctx, &middleware.MiddlewareNamespaceName{Namespace: namespace, Name: name})
+		if err != nil {
+			return nil, err
+		}
+		return &childInfo{id: info.Id, name: info.MiddleName, namespace: info.MiddleNamespace, middleware: info}, nil
+	default:
+		return nil, errors.New("不支持的资源类型：" + kind + "，可用类型：pod, svc, route, volume, middleware")
+	}
+}
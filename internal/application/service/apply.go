@@ -0,0 +1,444 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	microErrors "github.com/asim/go-micro/v3/errors"
+	"github.com/jinzhu/gorm"
+	"net/http"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"tini-paas/internal/application/model"
+	"tini-paas/internal/application/proto/application"
+	"tini-paas/internal/middleware/proto/middleware"
+	"tini-paas/internal/pod/proto/pod"
+	"tini-paas/internal/route/proto/route"
+	"tini-paas/internal/svc/proto/svc"
+	"tini-paas/internal/volume/proto/volume"
+)
+
+// 执行计划中的操作
+const (
+	ActionCreate    = "create"
+	ActionUpdate    = "update"
+	ActionDelete    = "delete"
+	ActionUnchanged = "unchanged"
+)
+
+// applyOrder 创建和更新资源的顺序，被引用的资源先创建
+var applyOrder = []string{model.KindVolume, model.KindMiddleware, model.KindPod, model.KindSvc, model.KindRoute}
+
+// planItem 执行计划中的一步和执行时需要的信息
+type planItem struct {
+	step *application.PlanStep
+
+	// desired 清单中的资源，删除时为空
+	desired interface{}
+
+	// fields 清单中写了的字段，更新时只覆盖这些字段
+	fields map[string]interface{}
+
+	// current 已经存在的资源，创建时为空
+	current *childInfo
+
+	// child 应用中已有的记录，资源还不属于应用时为空
+	child *model.AppChild
+}
+
+// manifestResource 清单中的一个资源
+type manifestResource struct {
+	name string
+	info interface{}
+}
+
+// manifestResources 清单中指定类型的全部资源
+func manifestResources(manifest *Manifest, kind string) []manifestResource {
+	var resources []manifestResource
+	switch kind {
+	case model.KindPod:
+		for _, info := range manifest.Pods {
+			resources = append(resources, manifestResource{info.PodName, info})
+		}
+	case model.KindSvc:
+		for _, info := range manifest.Svcs {
+			resources = append(resources, manifestResource{info.SvcName, info})
+		}
+	case model.KindRoute:
+		for _, info := range manifest.Routes {
+			resources = append(resources, manifestResource{info.RouteName, info})
+		}
+	case model.KindVolume:
+		for _, info := range manifest.Volumes {
+			resources = append(resources, manifestResource{info.VolumeName, info})
+		}
+	case model.KindMiddleware:
+		for _, info := range manifest.Middlewares {
+			resources = append(resources, manifestResource{info.MiddleName, info})
+		}
+	}
+	return resources
+}
+
+// message 资源在所属服务中的信息
+func (c *childInfo) message() interface{} {
+	switch {
+	case c.pod != nil:
+		return c.pod
+	case c.svc != nil:
+		return c.svc
+	case c.route != nil:
+		return c.route
+	case c.volume != nil:
+		return c.volume
+	default:
+		return c.middleware
+	}
+}
+
+// notFound 资源所属的服务查询不到记录时返回 NotFound
+func notFound(err error) bool {
+	return err != nil && microErrors.FromError(err).Code == http.StatusNotFound
+}
+
+// Apply 按照清单计算执行计划，dryRun 为 false 时按照依赖顺序通过各自的服务执行
+// 先创建和更新被引用的资源，prune 时最后按照删除顺序删除应用中不在清单里的资源
+// 执行到某一步失败时停止，之前的步骤已经生效，修复后再次执行即可
+func (a *ApplicationDataService) Apply(manifest *Manifest, prune, dryRun bool) (*application.ApplyResult, error) {
+	err := a.checkReferences(manifest)
+	if err != nil {
+		return nil, err
+	}
+
+	app, err := a.ApplicationRepository.FindApplicationByNamespaceAndName(manifest.Namespace, manifest.Name)
+	if gorm.IsRecordNotFoundError(err) {
+		app = &model.Application{
+			AppName:      manifest.Name,
+			AppNamespace: manifest.Namespace,
+		}
+	} else if err != nil {
+		return nil, err
+	}
+
+	items, orphans, err := a.plan(app, manifest, prune)
+	if err != nil {
+		return nil, err
+	}
+	result := &application.ApplyResult{AppId: app.ID}
+	for _, item := range items {
+		result.Plan = append(result.Plan, item.step)
+	}
+	result.Msg = planSummary(items, orphans)
+	if dryRun {
+		return result, nil
+	}
+
+	app.AppDescribe = manifest.Describe
+	if app.ID == 0 {
+		_, err = a.ApplicationRepository.CreateApplication(app)
+	} else {
+		app.AppChildren = nil
+		err = a.ApplicationRepository.UpdateApplication(app)
+	}
+	if err != nil {
+		return nil, err
+	}
+	result.AppId = app.ID
+
+	for _, item := range items {
+		err = a.applyItem(app, item)
+		if err != nil {
+			return nil, errors.New(item.step.Action + " " + item.step.ChildKind + " " + item.step.ChildName + " 失败，之前的步骤已经生效：" + err.Error())
+		}
+	}
+	return result, nil
+}
+
+// plan 将清单与数据库中的资源比较，生成执行计划，返回应用中不在清单里并且没有删除的资源数量
+func (a *ApplicationDataService) plan(app *model.Application, manifest *Manifest, prune bool) ([]*planItem, int, error) {
+	linked := map[string]*model.AppChild{}
+	for i := range app.AppChildren {
+		child := &app.AppChildren[i]
+		linked[child.ChildKind+"/"+child.ChildName] = child
+	}
+
+	var items []*planItem
+	declared := map[string]bool{}
+	for _, kind := range applyOrder {
+		for _, resource := range manifestResources(manifest, kind) {
+			declared[kind+"/"+resource.name] = true
+			fields, err := manifest.fields(resource.info)
+			if err != nil {
+				return nil, 0, err
+			}
+			item := &planItem{
+				step:    &application.PlanStep{ChildKind: kind, ChildName: resource.name},
+				desired: resource.info,
+				fields:  fields,
+				child:   linked[kind+"/"+resource.name],
+			}
+			items = append(items, item)
+
+			current, err := a.findChildByName(kind, manifest.Namespace, resource.name)
+			if notFound(err) {
+				item.step.Action = ActionCreate
+				continue
+			}
+			if err != nil {
+				return nil, 0, err
+			}
+			item.current = current
+			item.step.ChildId = current.id
+
+			// 同名的资源已经属于其他应用时不能接管
+			if item.child == nil {
+				owner, err := a.ApplicationRepository.FindChild(kind, current.id)
+				if err == nil && owner.AppID != app.ID {
+					return nil, 0, errors.New(kind + " " + resource.name + " 已经属于应用 " + strconv.FormatInt(owner.AppID, 10))
+				}
+				if err != nil && !gorm.IsRecordNotFoundError(err) {
+					return nil, 0, err
+				}
+			}
+
+			item.step.ChangedFields, err = changedFields(fields, current.message())
+			if err != nil {
+				return nil, 0, err
+			}
+			item.step.Action = ActionUpdate
+			if len(item.step.ChangedFields) == 0 {
+				item.step.Action = ActionUnchanged
+			}
+			if kind == model.KindVolume && item.step.Action == ActionUpdate {
+				err = checkVolumeUpdate(resource.info.(*volume.VolumeInfo), current.volume, item.step.ChangedFields)
+				if err != nil {
+					return nil, 0, err
+				}
+			}
+		}
+	}
+
+	orphans := 0
+	for _, kind := range deleteOrder {
+		for _, child := range childrenOf(app, kind) {
+			if declared[kind+"/"+child.ChildName] {
+				continue
+			}
+			if !prune {
+				orphans++
+				continue
+			}
+			items = append(items, &planItem{
+				step: &application.PlanStep{
+					Action:    ActionDelete,
+					ChildKind: kind,
+					ChildName: child.ChildName,
+					ChildId:   child.ChildID,
+				},
+				child: child,
+			})
+		}
+	}
+	return items, orphans, nil
+}
+
+// planSummary 执行计划的摘要
+func planSummary(items []*planItem, orphans int) string {
+	count := map[string]int{}
+	for _, item := range items {
+		count[item.step.Action]++
+	}
+	summary := "创建 " + strconv.Itoa(count[ActionCreate]) +
+		"，更新 " + strconv.Itoa(count[ActionUpdate]) +
+		"，删除 " + strconv.Itoa(count[ActionDelete]) +
+		"，不变 " + strconv.Itoa(count[ActionUnchanged])
+	if orphans > 0 {
+		summary += "；应用中有 " + strconv.Itoa(orphans) + " 个资源不在清单中，使用 prune 删除"
+	}
+	return summary
+}
+
+// applyItem 执行计划中的一步，创建和更新的资源加入应用
+func (a *ApplicationDataService) applyItem(app *model.Application, item *planItem) error {
+	step := item.step
+	switch step.Action {
+	case ActionDelete:
+		// 清单中保留的工作负载仍在使用的存储卷不强制删除
+		return a.deleteChild(item.child, false)
+	case ActionCreate:
+		err := a.createChild(item.desired)
+		if err != nil {
+			return err
+		}
+		created, err := a.findChildByName(step.ChildKind, app.AppNamespace, step.ChildName)
+		if err != nil {
+			return err
+		}
+		step.ChildId = created.id
+	case ActionUpdate:
+		err := a.updateChild(item.current, item.fields)
+		if err != nil {
+			return err
+		}
+	}
+
+	if item.child == nil {
+		_, err := a.AddChild(app, step.ChildKind, step.ChildId)
+		return err
+	}
+	return nil
+}
+
+// createChild 通过资源所属的服务创建资源
+func (a *ApplicationDataService) createChild(desired interface{}) error {
+	ctx := context.TODO()
+	var err error
+	switch info := desired.(type) {
+	case *pod.PodInfo:
+		_, err = a.Services.Pod.AddPod(ctx, info)
+	case *svc.SvcInfo:
+		_, err = a.Services.Svc.AddSvc(ctx, info)
+	case *route.RouteInfo:
+		_, err = a.Services.Route.AddRoute(ctx, info)
+	case *volume.VolumeInfo:
+		_, err = a.Services.Volume.AddVolume(ctx, info)
+	case *middleware.MiddlewareInfo:
+		_, err = a.Services.Middleware.AddMiddleware(ctx, info)
+	}
+	return err
+}
+
+// updateChild 通过资源所属的服务更新资源，fields 为清单中写了的字段，没有写的字段保持现有的值
+// 存储创建后只能扩容，通过扩容接口修改大小
+func (a *ApplicationDataService) updateChild(current *childInfo, fields map[string]interface{}) error {
+	ctx := context.TODO()
+	var err error
+	switch current.message().(type) {
+	case *pod.PodInfo:
+		info := &pod.PodInfo{}
+		if err = overlay(current.pod, fields, info); err == nil {
+			info.Id = current.id
+			_, err = a.Services.Pod.UpdatePod(ctx, info)
+		}
+	case *svc.SvcInfo:
+		info := &svc.SvcInfo{}
+		if err = overlay(current.svc, fields, info); err == nil {
+			info.Id = current.id
+			_, err = a.Services.Svc.UpdateSvc(ctx, info)
+		}
+	case *route.RouteInfo:
+		info := &route.RouteInfo{}
+		if err = overlay(current.route, fields, info); err == nil {
+			info.Id = current.id
+			_, err = a.Services.Route.UpdateRoute(ctx, info)
+		}
+	case *volume.VolumeInfo:
+		info := &volume.VolumeInfo{}
+		if err = overlay(current.volume, fields, info); err == nil {
+			_, err = a.Services.Volume.ResizeVolume(ctx, &volume.VolumeResize{
+				Id:            current.id,
+				VolumeRequest: info.VolumeRequest,
+			})
+		}
+	case *middleware.MiddlewareInfo:
+		info := &middleware.MiddlewareInfo{}
+		if err = overlay(current.middleware, fields, info); err == nil {
+			info.Id = current.id
+			_, err = a.Services.Middleware.UpdateMiddleware(ctx, info)
+		}
+	}
+	return err
+}
+
+// checkVolumeUpdate 存储创建后只能扩容，volume_request 以外的字段不能修改，大小不能缩小
+func checkVolumeUpdate(desired, current *volume.VolumeInfo, changed []string) error {
+	for _, field := range changed {
+		if field != "volume_request" {
+			return errors.New("存储 " + desired.VolumeName + " 创建后只能扩容 volume_request，不能修改 " + strings.Join(changed, ", "))
+		}
+	}
+	if desired.VolumeRequest < current.VolumeRequest {
+		return errors.New("存储 " + desired.VolumeName + " 不能缩容，当前大小 " + strconv.FormatFloat(float64(current.VolumeRequest), 'f', -1, 32) + "Gi")
+	}
+	return nil
+}
+
+// toMap 将资源转换为以json字段名为键的map，零值字段会被省略
+func toMap(obj interface{}) (map[string]interface{}, error) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	result := map[string]interface{}{}
+	return result, json.Unmarshal(data, &result)
+}
+
+// overlay 用清单中写了的顶层字段覆盖现有资源，结果写入out，列表字段整体替换
+func overlay(current interface{}, fields map[string]interface{}, out interface{}) error {
+	merged, err := toMap(current)
+	if err != nil {
+		return err
+	}
+	for key, value := range fields {
+		merged[key] = value
+	}
+	data, err := json.Marshal(merged)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, out)
+}
+
+// changedFields 清单中写了并且与现有资源不同的顶层字段
+// 现有资源中多出的字段(ID、状态等)不参与比较，现有资源序列化时省略了零值，写成零值的字段与缺少的字段比较
+func changedFields(fields map[string]interface{}, current interface{}) ([]string, error) {
+	existing, err := toMap(current)
+	if err != nil {
+		return nil, err
+	}
+	var changed []string
+	for key, value := range fields {
+		if !contains(existing[key], value) {
+			changed = append(changed, key)
+		}
+	}
+	sort.Strings(changed)
+	return changed, nil
+}
+
+// contains current 是否包含 desired 中的全部值，对象只比较 desired 中的字段，列表需要长度相同
+// current 中缺少的值按零值比较
+func contains(current, desired interface{}) bool {
+	switch value := desired.(type) {
+	case map[string]interface{}:
+		object, ok := current.(map[string]interface{})
+		if !ok && current != nil {
+			return false
+		}
+		for key, item := range value {
+			if !contains(object[key], item) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		list, ok := current.([]interface{})
+		if (!ok && current != nil) || len(list) != len(value) {
+			return false
+		}
+		for i := range value {
+			if !contains(list[i], value[i]) {
+				return false
+			}
+		}
+		return true
+	default:
+		// 现有资源序列化时省略了零值
+		if current == nil {
+			return desired == nil || reflect.ValueOf(desired).IsZero()
+		}
+		return reflect.DeepEqual(current, desired)
+	}
+}
@@ -0,0 +1,161 @@
+package service
+
+import (
+	"errors"
+	microErrors "github.com/asim/go-micro/v3/errors"
+	"reflect"
+	"testing"
+	"tini-paas/internal/pod/proto/pod"
+)
+
+func TestContains(t *testing.T) {
+	tests := []struct {
+		name    string
+		current interface{}
+		desired interface{}
+		want    bool
+	}{
+		{name: "equal value", current: "web", desired: "web", want: true},
+		{name: "different value", current: "web", desired: "api", want: false},
+		{name: "missing zero value", current: nil, desired: float64(0), want: true},
+		{name: "missing empty string", current: nil, desired: "", want: true},
+		{name: "missing non-zero value", current: nil, desired: float64(2), want: false},
+		{name: "object subset", current: map[string]interface{}{"env_key": "A", "pod_id": float64(1)}, desired: map[string]interface{}{"env_key": "A"}, want: true},
+		{name: "object different field", current: map[string]interface{}{"env_key": "A"}, desired: map[string]interface{}{"env_key": "B"}, want: false},
+		{name: "object missing field as zero", current: map[string]interface{}{"env_key": "A"}, desired: map[string]interface{}{"env_value": ""}, want: true},
+		{name: "object against value", current: "A", desired: map[string]interface{}{"env_key": "A"}, want: false},
+		{name: "list same items", current: []interface{}{"a", "b"}, desired: []interface{}{"a", "b"}, want: true},
+		{name: "list different length", current: []interface{}{"a", "b"}, desired: []interface{}{"a"}, want: false},
+		{name: "missing list against empty list", current: nil, desired: []interface{}{}, want: true},
+		{name: "missing list against items", current: nil, desired: []interface{}{"a"}, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := contains(tt.current, tt.desired); got != tt.want {
+				t.Errorf("contains(%v, %v) = %v, want %v", tt.current, tt.desired, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestChangedFields(t *testing.T) {
+	current := &pod.PodInfo{
+		Id:           1,
+		PodName:      "web",
+		PodNamespace: "prod",
+		PodImage:     "web:1.0.0",
+		PodReplicas:  2,
+		PodEnv:       []*pod.PodEnv{{PodId: 1, EnvKey: "A", EnvValue: "1"}},
+	}
+	tests := []struct {
+		name   string
+		fields map[string]interface{}
+		want   []string
+	}{
+		{name: "unchanged", fields: map[string]interface{}{"pod_name": "web", "pod_image": "web:1.0.0"}},
+		{name: "changed image", fields: map[string]interface{}{"pod_name": "web", "pod_image": "web:1.1.0"}, want: []string{"pod_image"}},
+		{name: "replicas to zero", fields: map[string]interface{}{"pod_replicas": float64(0)}, want: []string{"pod_replicas"}},
+		{name: "zero value not set", fields: map[string]interface{}{"pod_cpu_max": float64(0)}},
+		{
+			name:   "env without ids",
+			fields: map[string]interface{}{"pod_env": []interface{}{map[string]interface{}{"env_key": "A", "env_value": "1"}}},
+		},
+		{
+			name: "sorted",
+			fields: map[string]interface{}{
+				"pod_replicas": float64(3),
+				"pod_image":    "web:2.0.0",
+				"pod_env":      []interface{}{},
+			},
+			want: []string{"pod_env", "pod_image", "pod_replicas"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := changedFields(tt.fields, current)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("changedFields() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOverlay(t *testing.T) {
+	current := &pod.PodInfo{
+		Id:           1,
+		PodName:      "web",
+		PodNamespace: "prod",
+		PodImage:     "web:1.0.0",
+		PodReplicas:  2,
+		PodEnv: []*pod.PodEnv{
+			{PodId: 1, EnvKey: "A", EnvValue: "1"},
+			{PodId: 1, EnvKey: "B", EnvValue: "2"},
+		},
+	}
+	tests := []struct {
+		name   string
+		fields map[string]interface{}
+		want   *pod.PodInfo
+	}{
+		{
+			name:   "keep fields not written",
+			fields: map[string]interface{}{"pod_image": "web:1.1.0"},
+			want: &pod.PodInfo{
+				Id: 1, PodName: "web", PodNamespace: "prod", PodImage: "web:1.1.0", PodReplicas: 2,
+				PodEnv: current.PodEnv,
+			},
+		},
+		{
+			name:   "replicas to zero",
+			fields: map[string]interface{}{"pod_replicas": float64(0)},
+			want: &pod.PodInfo{
+				Id: 1, PodName: "web", PodNamespace: "prod", PodImage: "web:1.0.0",
+				PodEnv: current.PodEnv,
+			},
+		},
+		{
+			name:   "replace list",
+			fields: map[string]interface{}{"pod_env": []interface{}{map[string]interface{}{"env_key": "C", "env_value": "3"}}},
+			want: &pod.PodInfo{
+				Id: 1, PodName: "web", PodNamespace: "prod", PodImage: "web:1.0.0", PodReplicas: 2,
+				PodEnv: []*pod.PodEnv{{EnvKey: "C", EnvValue: "3"}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := &pod.PodInfo{}
+			err := overlay(current, tt.fields, got)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("overlay() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNotFound(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "nil", err: nil, want: false},
+		{name: "not found", err: microErrors.NotFound("go.micro.service.pod", "pod prod/web 不存在"), want: true},
+		{name: "not found from rpc", err: errors.New(microErrors.NotFound("go.micro.service.pod", "pod prod/web 不存在").Error()), want: true},
+		{name: "record not found detail", err: microErrors.InternalServerError("go.micro.service.pod", "record not found"), want: false},
+		{name: "database error", err: errors.New("dial tcp 127.0.0.1:3306: connect: connection refused"), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := notFound(tt.err); got != tt.want {
+				t.Errorf("notFound(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
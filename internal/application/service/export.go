@@ -0,0 +1,68 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"tini-paas/internal/application/model"
+	"tini-paas/internal/application/proto/application"
+	"tini-paas/internal/middleware/proto/middleware"
+	"tini-paas/internal/pod/proto/pod"
+	"tini-paas/internal/route/proto/route"
+	"tini-paas/internal/svc/proto/svc"
+	"tini-paas/internal/volume/proto/volume"
+	"tini-paas/pkg/common"
+)
+
+// Export 通过资源所属的服务导出资源应用到k8s的对象
+func (a *ApplicationDataService) Export(kind string, id int64) (string, error) {
+	ctx := context.TODO()
+	var result interface{ GetYaml() string }
+	var err error
+	switch kind {
+	case model.KindPod:
+		result, err = a.Services.Pod.ExportPod(ctx, &pod.PodID{Id: id})
+	case model.KindSvc:
+		result, err = a.Services.Svc.ExportSvc(ctx, &svc.SvcID{Id: id})
+	case model.KindRoute:
+		result, err = a.Services.Route.ExportRoute(ctx, &route.RouteID{Id: id})
+	case model.KindVolume:
+		result, err = a.Services.Volume.ExportVolume(ctx, &volume.VolumeID{Id: id})
+	case model.KindMiddleware:
+		result, err = a.Services.Middleware.ExportMiddleware(ctx, &middleware.MiddlewareID{Id: id})
+	default:
+		return "", errors.New("不支持的资源类型：" + kind + "，可用类型：pod, svc, route, volume, middleware")
+	}
+	if err != nil {
+		return "", err
+	}
+	return result.GetYaml(), nil
+}
+
+// ExportApplication 按照创建顺序导出应用中的全部资源，可以直接 kubectl apply
+// helm 为 true 时打包为Helm chart，每个资源一个模板文件
+func (a *ApplicationDataService) ExportApplication(app *model.Application, helm bool) (*application.ExportResult, error) {
+	var docs []chartDoc
+	for _, kind := range applyOrder {
+		for _, child := range childrenOf(app, kind) {
+			content, err := a.Export(kind, child.ChildID)
+			if err != nil {
+				return nil, errors.New("导出" + kind + " " + child.ChildName + " 失败：" + err.Error())
+			}
+			docs = append(docs, chartDoc{kind: kind, name: child.ChildName, content: content})
+		}
+	}
+
+	if !helm {
+		var contents []string
+		for _, doc := range docs {
+			contents = append(contents, doc.content)
+		}
+		return &application.ExportResult{Yaml: common.JoinYAML(contents...)}, nil
+	}
+
+	files, err := buildChart(app, docs)
+	if err != nil {
+		return nil, err
+	}
+	return &application.ExportResult{ChartFiles: files}, nil
+}
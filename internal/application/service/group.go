@@ -0,0 +1,157 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"tini-paas/internal/application/model"
+	"tini-paas/internal/middleware/proto/middleware"
+	"tini-paas/internal/pod/proto/pod"
+	"tini-paas/internal/route/proto/route"
+	"tini-paas/internal/svc/proto/svc"
+	"tini-paas/internal/volume/proto/volume"
+)
+
+// deleteOrder 删除资源的顺序，先断开流量，再删除工作负载，最后删除存储
+var deleteOrder = []string{model.KindRoute, model.KindSvc, model.KindPod, model.KindMiddleware, model.KindVolume}
+
+// childrenOf 应用中指定类型的资源
+func childrenOf(app *model.Application, kind string) []*model.AppChild {
+	var children []*model.AppChild
+	for i := range app.AppChildren {
+		if app.AppChildren[i].ChildKind == kind {
+			children = append(children, &app.AppChildren[i])
+		}
+	}
+	return children
+}
+
+// joinErrors 合并多个资源的错误，一个资源失败时继续处理其他资源
+func joinErrors(errs []string) error {
+	if len(errs) == 0 {
+		return nil
+	}
+	return errors.New(strings.Join(errs, "; "))
+}
+
+// Stop 停止应用，先停止pod再停止中间件，副本数通过各自的调整副本数接口缩为0并写入数据库
+// 停止前的副本数记录在应用中，启动时恢复；停止后直接更新pod或中间件不会重新启动
+// pod配置了HPA时通过scale子资源缩为0，HPA在副本数为0时暂停
+func (a *ApplicationDataService) Stop(app *model.Application) error {
+	ctx := context.TODO()
+	var errs []string
+	for _, child := range childrenOf(app, model.KindPod) {
+		info, err := a.Services.Pod.FindPodByID(ctx, &pod.PodID{Id: child.ChildID})
+		if err == nil && info.PodReplicas > 0 {
+			err = a.ApplicationRepository.UpdateChildReplicas(child.ID, info.PodReplicas)
+		}
+		if err == nil {
+			_, err = a.Services.Pod.ScalePod(ctx, &pod.PodScale{PodId: child.ChildID})
+		}
+		if err != nil {
+			errs = append(errs, "停止pod "+child.ChildName+" 失败："+err.Error())
+		}
+	}
+	for _, child := range childrenOf(app, model.KindMiddleware) {
+		info, err := a.Services.Middleware.FindMiddlewareByID(ctx, &middleware.MiddlewareID{Id: child.ChildID})
+		if err == nil && info.MiddleReplicas > 0 {
+			err = a.ApplicationRepository.UpdateChildReplicas(child.ID, info.MiddleReplicas)
+		}
+		if err == nil {
+			_, err = a.Services.Middleware.ScaleMiddleware(ctx, &middleware.MiddleScale{MiddleId: child.ChildID})
+		}
+		if err != nil {
+			errs = append(errs, "停止中间件 "+child.ChildName+" 失败："+err.Error())
+		}
+	}
+	if len(errs) == 0 {
+		return a.ApplicationRepository.UpdateStopped(app.ID, true)
+	}
+	return joinErrors(errs)
+}
+
+// Start 启动应用，先启动中间件再启动pod，没有记录停止前的副本数时使用数据库中的副本数
+func (a *ApplicationDataService) Start(app *model.Application) error {
+	ctx := context.TODO()
+	var errs []string
+	for _, child := range childrenOf(app, model.KindMiddleware) {
+		info, err := a.Services.Middleware.FindMiddlewareByID(ctx, &middleware.MiddlewareID{Id: child.ChildID})
+		if err == nil {
+			_, err = a.Services.Middleware.ScaleMiddleware(ctx, &middleware.MiddleScale{
+				MiddleId:       child.ChildID,
+				MiddleReplicas: startReplicas(child.ChildReplicas, info.MiddleReplicas),
+			})
+		}
+		if err != nil {
+			errs = append(errs, "启动中间件 "+child.ChildName+" 失败："+err.Error())
+		}
+	}
+	for _, child := range childrenOf(app, model.KindPod) {
+		info, err := a.Services.Pod.FindPodByID(ctx, &pod.PodID{Id: child.ChildID})
+		if err == nil {
+			_, err = a.Services.Pod.ScalePod(ctx, &pod.PodScale{
+				PodId:       child.ChildID,
+				PodReplicas: startReplicas(child.ChildReplicas, info.PodReplicas),
+			})
+		}
+		if err != nil {
+			errs = append(errs, "启动pod "+child.ChildName+" 失败："+err.Error())
+		}
+	}
+	if len(errs) == 0 {
+		return a.ApplicationRepository.UpdateStopped(app.ID, false)
+	}
+	return joinErrors(errs)
+}
+
+// startReplicas 启动时的副本数，优先使用停止前记录的副本数，都没有时为1
+func startReplicas(recorded, current int32) int32 {
+	if recorded > 0 {
+		return recorded
+	}
+	if current > 0 {
+		return current
+	}
+	return 1
+}
+
+// DeleteChildren 通过各自的服务按顺序删除应用中的全部资源
+// 删除成功的资源同时移出应用，返回没有删除成功的资源，可以修复后再次删除
+func (a *ApplicationDataService) DeleteChildren(app *model.Application) ([]model.AppChild, error) {
+	var failed []model.AppChild
+	var errs []string
+	for _, kind := range deleteOrder {
+		for _, child := range childrenOf(app, kind) {
+			// 应用中的工作负载已经删除，pod退出前存储仍被使用，强制删除
+			err := a.deleteChild(child, true)
+			if err != nil {
+				failed = append(failed, *child)
+				errs = append(errs, "删除"+child.ChildKind+" "+child.ChildName+" 失败："+err.Error())
+			}
+		}
+	}
+	return failed, joinErrors(errs)
+}
+
+// deleteChild 通过资源所属的服务删除资源，删除成功后移出应用
+// force 为 true 时存储卷仍被使用也删除
+func (a *ApplicationDataService) deleteChild(child *model.AppChild, force bool) error {
+	ctx := context.TODO()
+	var err error
+	switch child.ChildKind {
+	case model.KindRoute:
+		_, err = a.Services.Route.DeleteRoute(ctx, &route.RouteID{Id: child.ChildID})
+	case model.KindSvc:
+		_, err = a.Services.Svc.DeleteSvc(ctx, &svc.SvcID{Id: child.ChildID})
+	case model.KindPod:
+		_, err = a.Services.Pod.DeletePod(ctx, &pod.PodID{Id: child.ChildID})
+	case model.KindMiddleware:
+		_, err = a.Services.Middleware.DeleteMiddleware(ctx, &middleware.MiddlewareID{Id: child.ChildID})
+	case model.KindVolume:
+		_, err = a.Services.Volume.DeleteVolume(ctx, &volume.VolumeID{Id: child.ChildID, Force: force})
+	}
+	if err != nil {
+		return err
+	}
+	return a.ApplicationRepository.DeleteChild(child.ID)
+}
@@ -0,0 +1,135 @@
+package service
+
+import (
+	"fmt"
+	"regexp"
+	"sigs.k8s.io/yaml"
+	"strconv"
+	"strings"
+	"tini-paas/internal/application/model"
+	"tini-paas/pkg/common"
+)
+
+// chartVersion 导出的chart版本，每次导出都是完整的chart，不做版本递增
+const chartVersion = "0.1.0"
+
+// chartValueGroups 工作负载在values中的分组，Deployment 和 StatefulSet 可能同名
+var chartValueGroups = map[string]string{
+	"Deployment":  "deployments",
+	"StatefulSet": "statefulsets",
+}
+
+// documentSeparator 多文档YAML的分隔行
+var documentSeparator = regexp.MustCompile(`(?m)^---\s*$`)
+
+// chartDoc 应用中一个资源导出的YAML
+type chartDoc struct {
+	kind    string
+	name    string
+	content string
+}
+
+// buildChart 将导出的资源打包为Helm chart，返回文件路径和内容
+// 工作负载的镜像、副本数和资源限制提取到values.yaml，模板中通过 .Values.<分组>.<名称> 引用
+func buildChart(app *model.Application, docs []chartDoc) (map[string]string, error) {
+	values := map[string]interface{}{}
+	files := map[string]string{}
+	for _, doc := range docs {
+		var templates []string
+		for _, text := range documentSeparator.Split(doc.content, -1) {
+			if strings.TrimSpace(text) == "" {
+				continue
+			}
+			object := map[string]interface{}{}
+			err := yaml.Unmarshal([]byte(text), &object)
+			if err != nil {
+				return nil, err
+			}
+
+			placeholders := map[string]string{}
+			kind, _ := object["kind"].(string)
+			if group, ok := chartValueGroups[kind]; ok {
+				metadata, _ := object["metadata"].(map[string]interface{})
+				name, _ := metadata["name"].(string)
+				groupValues, _ := values[group].(map[string]interface{})
+				if groupValues == nil {
+					groupValues = map[string]interface{}{}
+					values[group] = groupValues
+				}
+				groupValues[name] = extractValues(object, group, name, placeholders)
+			}
+
+			data, err := yaml.Marshal(object)
+			if err != nil {
+				return nil, err
+			}
+			// 配置文件等内容中的 {{ 需要转义，避免被Helm当作模板
+			rendered := strings.ReplaceAll(string(data), "{{", `{{ "{{" }}`)
+			for placeholder, expr := range placeholders {
+				rendered = strings.ReplaceAll(rendered, placeholder, expr)
+			}
+			templates = append(templates, rendered)
+		}
+		files["templates/"+doc.kind+"-"+doc.name+".yaml"] = common.JoinYAML(templates...)
+	}
+
+	description := app.AppDescribe
+	if description == "" {
+		description = "应用 " + app.AppName + " 导出的chart"
+	}
+	chart, err := yaml.Marshal(map[string]interface{}{
+		"apiVersion":  "v2",
+		"name":        app.AppName,
+		"description": description,
+		"type":        "application",
+		"version":     chartVersion,
+	})
+	if err != nil {
+		return nil, err
+	}
+	files["Chart.yaml"] = string(chart)
+
+	valuesData, err := yaml.Marshal(values)
+	if err != nil {
+		return nil, err
+	}
+	files["values.yaml"] = string(valuesData)
+	return files, nil
+}
+
+// extractValues 提取工作负载的副本数、第一个容器的镜像和资源限制，原位置替换为占位符
+// placeholders 记录占位符对应的模板表达式，对象转换为YAML后替换
+func extractValues(object map[string]interface{}, group, name string, placeholders map[string]string) map[string]interface{} {
+	result := map[string]interface{}{}
+	ref := fmt.Sprintf("(index .Values.%s %q)", group, name)
+	replace := func(parent map[string]interface{}, field, expr string) {
+		value, ok := parent[field]
+		if !ok {
+			return
+		}
+		result[field] = value
+		placeholder := "__HELM_VALUE_" + strconv.Itoa(len(placeholders)) + "__"
+		placeholders[placeholder] = "{{ " + ref + "." + field + expr + " }}"
+		parent[field] = placeholder
+	}
+
+	spec, _ := object["spec"].(map[string]interface{})
+	if spec == nil {
+		return result
+	}
+	replace(spec, "replicas", "")
+
+	podTemplate, _ := spec["template"].(map[string]interface{})
+	podSpec, _ := podTemplate["spec"].(map[string]interface{})
+	containers, _ := podSpec["containers"].([]interface{})
+	if len(containers) == 0 {
+		return result
+	}
+	container, _ := containers[0].(map[string]interface{})
+	if container == nil {
+		return result
+	}
+	replace(container, "image", " | quote")
+	replace(container, "resources", " | toJson")
+	return result
+}
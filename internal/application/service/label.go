@@ -0,0 +1,106 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"tini-paas/internal/application/model"
+)
+
+// 应用中资源的公共标签
+const (
+	LabelPartOf    = "app.kubernetes.io/part-of"
+	LabelManagedBy = "app.kubernetes.io/managed-by"
+)
+
+// labelFieldManager 打标签时使用的字段管理者，与各服务应用资源时的管理者区分
+const labelFieldManager = "tini-paas-application"
+
+// 资源在k8s中对应的对象类型
+var (
+	deploymentResource  = schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}
+	statefulSetResource = schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "statefulsets"}
+	serviceResource     = schema.GroupVersionResource{Version: "v1", Resource: "services"}
+	pvcResource         = schema.GroupVersionResource{Version: "v1", Resource: "persistentvolumeclaims"}
+	secretResource      = schema.GroupVersionResource{Version: "v1", Resource: "secrets"}
+	configMapResource   = schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}
+	ingressResource     = schema.GroupVersionResource{Group: "networking.k8s.io", Version: "v1", Resource: "ingresses"}
+	httpRouteResource   = schema.GroupVersionResource{Group: "gateway.networking.k8s.io", Version: "v1", Resource: "httproutes"}
+)
+
+// k8sObject 资源在k8s中的一个对象
+type k8sObject struct {
+	resource schema.GroupVersionResource
+	name     string
+}
+
+// appLabels 应用中资源的公共标签
+func appLabels(app *model.Application) map[string]interface{} {
+	return map[string]interface{}{
+		LabelPartOf:    app.AppName,
+		LabelManagedBy: "tini-paas",
+	}
+}
+
+// removeLabels 去掉公共标签，合并补丁中值为null的字段会被删除
+func removeLabels() map[string]interface{} {
+	return map[string]interface{}{
+		LabelPartOf:    nil,
+		LabelManagedBy: nil,
+	}
+}
+
+// childObjects 资源在k8s中对应的全部对象，名称规则与各服务创建对象时一致
+func childObjects(child *model.AppChild) []k8sObject {
+	name := child.ChildName
+	switch child.ChildKind {
+	case model.KindPod:
+		return []k8sObject{{deploymentResource, name}}
+	case model.KindSvc:
+		return []k8sObject{{serviceResource, name}}
+	case model.KindRoute:
+		// 路由按照渲染方式生成ingress或者HTTPRoute，不存在的对象会被跳过
+		return []k8sObject{{ingressResource, name}, {httpRouteResource, name}}
+	case model.KindVolume:
+		return []k8sObject{{pvcResource, name}}
+	case model.KindMiddleware:
+		return []k8sObject{
+			{statefulSetResource, name},
+			{serviceResource, name},
+			{serviceResource, name + "-client"},
+			{secretResource, name + "-credentials"},
+			{configMapResource, name + "-config"},
+		}
+	}
+	return nil
+}
+
+// applyLabels 使用合并补丁为资源的对象设置标签
+// 只修改对象自身的标签，不修改pod模板，避免触发工作负载的滚动更新
+// 各服务通过服务端应用更新对象时不会管理这些标签，标签不会被覆盖
+func (a *ApplicationDataService) applyLabels(app *model.Application, child *model.AppChild, labels map[string]interface{}) error {
+	data, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"labels": labels,
+		},
+	})
+	if err != nil {
+		return err
+	}
+	for _, object := range childObjects(child) {
+		_, err = a.DynamicClient.Resource(object.resource).Namespace(app.AppNamespace).Patch(context.TODO(), object.name, types.MergePatchType, data, v1.PatchOptions{
+			FieldManager: labelFieldManager,
+		})
+		// 可选的对象(没有端口时的客户端服务、没有安装 Gateway API 的HTTPRoute等)不存在时跳过
+		if k8serrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
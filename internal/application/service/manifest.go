@@ -0,0 +1,224 @@
+package service
+
+import (
+	"errors"
+	"reflect"
+	"sigs.k8s.io/yaml"
+	"strings"
+	"tini-paas/internal/application/model"
+	"tini-paas/internal/middleware/proto/middleware"
+	"tini-paas/internal/pod/proto/pod"
+	"tini-paas/internal/route/proto/route"
+	"tini-paas/internal/svc/proto/svc"
+	"tini-paas/internal/volume/proto/volume"
+)
+
+// Manifest 声明式的应用清单，资源的字段与各服务的接口一致，资源之间通过名称引用
+//
+//	name: shop
+//	namespace: prod
+//	pods:
+//	  - pod_name: web
+//	    pod_image: registry.example.com/shop/web:1.0.0
+//	svcs:
+//	  - svc_name: web
+//	    svc_pod_name: web
+//	routes:
+//	  - route_name: web
+//	    route_host: shop.example.com
+//	    route_path:
+//	      - route_path_name: /
+//	        route_backend_service: web
+//	        route_backend_service_port: 80
+type Manifest struct {
+	// Name 应用名称
+	Name string `json:"name"`
+
+	// Namespace 应用和资源所在的命名空间，资源没有设置命名空间时使用
+	Namespace string `json:"namespace"`
+
+	// Describe 应用描述
+	Describe string `json:"describe,omitempty"`
+
+	Pods        []*pod.PodInfo               `json:"pods,omitempty"`
+	Svcs        []*svc.SvcInfo               `json:"svcs,omitempty"`
+	Routes      []*route.RouteInfo           `json:"routes,omitempty"`
+	Volumes     []*volume.VolumeInfo         `json:"volumes,omitempty"`
+	Middlewares []*middleware.MiddlewareInfo `json:"middlewares,omitempty"`
+
+	// written 每个资源在清单中写了的顶层字段和值，包括零值
+	// 资源序列化时会省略零值，更新时据此区分没有写的字段和写成零值的字段，如 pod_replicas: 0
+	written map[interface{}]map[string]interface{}
+}
+
+// rawManifest 清单中资源写了的字段
+type rawManifest struct {
+	Pods        []map[string]interface{} `json:"pods"`
+	Svcs        []map[string]interface{} `json:"svcs"`
+	Routes      []map[string]interface{} `json:"routes"`
+	Volumes     []map[string]interface{} `json:"volumes"`
+	Middlewares []map[string]interface{} `json:"middlewares"`
+}
+
+// ParseManifest 解析YAML或者JSON格式的清单，未知的字段视为错误，避免拼写错误被忽略
+func ParseManifest(data []byte) (*Manifest, error) {
+	manifest := &Manifest{}
+	err := yaml.UnmarshalStrict(data, manifest)
+	if err != nil {
+		return nil, errors.New("清单格式错误：" + err.Error())
+	}
+	err = manifest.recordWritten(data)
+	if err != nil {
+		return nil, errors.New("清单格式错误：" + err.Error())
+	}
+	if manifest.Name == "" || manifest.Namespace == "" {
+		return nil, errors.New("清单需要指定应用的 name 和 namespace")
+	}
+
+	// 资源由清单统一管理，不能在清单中指定ID和其他命名空间
+	names := map[string]bool{}
+	check := func(kind, name string, namespace *string, id int64) error {
+		if name == "" {
+			return errors.New("清单中有 " + kind + " 没有设置名称")
+		}
+		if id != 0 {
+			return errors.New(kind + " " + name + " 不能指定ID，资源通过名称对应")
+		}
+		if *namespace == "" {
+			*namespace = manifest.Namespace
+		}
+		if *namespace != manifest.Namespace {
+			return errors.New(kind + " " + name + " 的命名空间需要与应用一致：" + manifest.Namespace)
+		}
+		if names[kind+"/"+name] {
+			return errors.New(kind + " " + name + " 在清单中重复")
+		}
+		names[kind+"/"+name] = true
+		return nil
+	}
+	for _, info := range manifest.Pods {
+		if err = check(model.KindPod, info.PodName, &info.PodNamespace, info.Id); err != nil {
+			return nil, err
+		}
+	}
+	for _, info := range manifest.Svcs {
+		if err = check(model.KindSvc, info.SvcName, &info.SvcNamespace, info.Id); err != nil {
+			return nil, err
+		}
+	}
+	for _, info := range manifest.Routes {
+		if err = check(model.KindRoute, info.RouteName, &info.RouteNamespace, info.Id); err != nil {
+			return nil, err
+		}
+	}
+	for _, info := range manifest.Volumes {
+		if err = check(model.KindVolume, info.VolumeName, &info.VolumeNamespace, info.Id); err != nil {
+			return nil, err
+		}
+	}
+	for _, info := range manifest.Middlewares {
+		if err = check(model.KindMiddleware, info.MiddleName, &info.MiddleNamespace, info.Id); err != nil {
+			return nil, err
+		}
+	}
+	return manifest, nil
+}
+
+// recordWritten 记录每个资源在清单中写了的字段，字段名转换为资源的json字段名
+func (m *Manifest) recordWritten(data []byte) error {
+	raw := &rawManifest{}
+	err := yaml.Unmarshal(data, raw)
+	if err != nil {
+		return err
+	}
+	m.written = map[interface{}]map[string]interface{}{}
+	add := func(info interface{}, fields []map[string]interface{}, i int) {
+		if i < len(fields) {
+			m.written[info] = jsonFields(info, fields[i])
+		}
+	}
+	for i, info := range m.Pods {
+		add(info, raw.Pods, i)
+	}
+	for i, info := range m.Svcs {
+		add(info, raw.Svcs, i)
+	}
+	for i, info := range m.Routes {
+		add(info, raw.Routes, i)
+	}
+	for i, info := range m.Volumes {
+		add(info, raw.Volumes, i)
+	}
+	for i, info := range m.Middlewares {
+		add(info, raw.Middlewares, i)
+	}
+	return nil
+}
+
+// fields 资源在清单中写了的字段，没有记录时(不是通过 ParseManifest 解析的清单)只能使用非零值的字段
+func (m *Manifest) fields(info interface{}) (map[string]interface{}, error) {
+	if fields, ok := m.written[info]; ok {
+		return fields, nil
+	}
+	return toMap(info)
+}
+
+// jsonFields 将清单中的字段名转换为资源的json字段名，解析json时字段名不区分大小写
+func jsonFields(info interface{}, fields map[string]interface{}) map[string]interface{} {
+	names := map[string]string{}
+	t := reflect.TypeOf(info).Elem()
+	for i := 0; i < t.NumField(); i++ {
+		name := strings.Split(t.Field(i).Tag.Get("json"), ",")[0]
+		if name != "" && name != "-" {
+			names[strings.ToLower(name)] = name
+		}
+	}
+	result := map[string]interface{}{}
+	for key, value := range fields {
+		if name, ok := names[strings.ToLower(key)]; ok {
+			result[name] = value
+		}
+	}
+	return result
+}
+
+// checkReferences 检查清单中通过名称的引用，引用的资源需要在清单中或者已经存在
+// 服务选择的pod，路由转发到的服务(包括中间件的服务)
+func (a *ApplicationDataService) checkReferences(manifest *Manifest) error {
+	pods := map[string]bool{}
+	for _, info := range manifest.Pods {
+		pods[info.PodName] = true
+	}
+	services := map[string]bool{}
+	for _, info := range manifest.Svcs {
+		services[info.SvcName] = true
+	}
+	for _, info := range manifest.Middlewares {
+		services[info.MiddleName] = true
+		services[info.MiddleName+"-client"] = true
+	}
+
+	for _, info := range manifest.Svcs {
+		if info.SvcPodName == "" || pods[info.SvcPodName] {
+			continue
+		}
+		if _, err := a.findChildByName(model.KindPod, manifest.Namespace, info.SvcPodName); err != nil {
+			return errors.New("服务 " + info.SvcName + " 引用的pod " + info.SvcPodName + " 不在清单中也不存在")
+		}
+	}
+	for _, info := range manifest.Routes {
+		for _, name := range routeServices(info) {
+			if services[name] {
+				continue
+			}
+			if _, err := a.findChildByName(model.KindSvc, manifest.Namespace, name); err == nil {
+				continue
+			}
+			if _, err := a.findChildByName(model.KindMiddleware, manifest.Namespace, strings.TrimSuffix(name, "-client")); err == nil {
+				continue
+			}
+			return errors.New("路由 " + info.RouteName + " 引用的服务 " + name + " 不在清单中也不存在")
+		}
+	}
+	return nil
+}
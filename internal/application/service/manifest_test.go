@@ -0,0 +1,125 @@
+package service
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"tini-paas/internal/pod/proto/pod"
+)
+
+func TestParseManifest(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		wantErr string
+	}{
+		{
+			name: "valid",
+			data: `
+name: shop
+namespace: prod
+pods:
+  - pod_name: web
+svcs:
+  - svc_name: web
+    svc_namespace: prod
+    svc_pod_name: web
+`,
+		},
+		{name: "unknown field", data: "name: shop\nnamespace: prod\npods:\n  - pod_nmae: web\n", wantErr: "清单格式错误"},
+		{name: "missing namespace", data: "name: shop\n", wantErr: "清单需要指定应用的 name 和 namespace"},
+		{name: "missing resource name", data: "name: shop\nnamespace: prod\npods:\n  - pod_image: web:1.0.0\n", wantErr: "没有设置名称"},
+		{name: "id", data: "name: shop\nnamespace: prod\npods:\n  - pod_name: web\n    id: 1\n", wantErr: "不能指定ID"},
+		{name: "other namespace", data: "name: shop\nnamespace: prod\npods:\n  - pod_name: web\n    pod_namespace: dev\n", wantErr: "命名空间需要与应用一致"},
+		{name: "duplicate", data: "name: shop\nnamespace: prod\npods:\n  - pod_name: web\n  - pod_name: web\n", wantErr: "在清单中重复"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			manifest, err := ParseManifest([]byte(tt.data))
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("ParseManifest() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if manifest.Pods[0].PodNamespace != "prod" || manifest.Svcs[0].SvcNamespace != "prod" {
+				t.Errorf("namespaces = %s, %s, want prod", manifest.Pods[0].PodNamespace, manifest.Svcs[0].SvcNamespace)
+			}
+		})
+	}
+}
+
+func TestManifestFields(t *testing.T) {
+	tests := []struct {
+		name string
+		pod  string
+		want map[string]interface{}
+	}{
+		{
+			name: "written fields",
+			pod:  "pod_name: web\n    pod_image: web:1.0.0",
+			want: map[string]interface{}{"pod_name": "web", "pod_image": "web:1.0.0"},
+		},
+		{
+			name: "replicas zero",
+			pod:  "pod_name: web\n    pod_replicas: 0",
+			want: map[string]interface{}{"pod_name": "web", "pod_replicas": float64(0)},
+		},
+		{
+			name: "case insensitive",
+			pod:  "pod_name: web\n    POD_Replicas: 3",
+			want: map[string]interface{}{"pod_name": "web", "pod_replicas": float64(3)},
+		},
+		{
+			name: "list",
+			pod:  "pod_name: web\n    pod_env: []",
+			want: map[string]interface{}{"pod_name": "web", "pod_env": []interface{}{}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			manifest, err := ParseManifest([]byte("name: shop\nnamespace: prod\npods:\n  - " + tt.pod + "\n"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := manifest.fields(manifest.Pods[0])
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("fields() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// 不是通过 ParseManifest 解析的清单只能使用非零值的字段
+func TestManifestFieldsNotParsed(t *testing.T) {
+	info := &pod.PodInfo{PodName: "web", PodReplicas: 0, PodImage: "web:1.0.0"}
+	manifest := &Manifest{Name: "shop", Namespace: "prod", Pods: []*pod.PodInfo{info}}
+
+	got, err := manifest.fields(info)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]interface{}{"pod_name": "web", "pod_image": "web:1.0.0"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("fields() = %v, want %v", got, want)
+	}
+}
+
+func TestJSONFields(t *testing.T) {
+	got := jsonFields(&pod.PodInfo{}, map[string]interface{}{
+		"pod_name":    "web",
+		"Pod_Image":   "web:1.0.0",
+		"podReplicas": float64(2),
+		"unknown":     "x",
+	})
+	want := map[string]interface{}{"pod_name": "web", "pod_image": "web:1.0.0"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("jsonFields() = %v, want %v", got, want)
+	}
+}
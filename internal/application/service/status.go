@@ -0,0 +1,203 @@
+package service
+
+import (
+	"context"
+	v1 "k8s.io/api/apps/v1"
+	v12 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	v13 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"strconv"
+	"tini-paas/internal/application/model"
+	"tini-paas/internal/application/proto/application"
+)
+
+// 资源的状态
+const (
+	StatusReady       = "Ready"
+	StatusProgressing = "Progressing"
+	StatusStopped     = "Stopped"
+	StatusMissing     = "Missing"
+	StatusFailed      = "Failed"
+)
+
+// 应用的汇总状态
+const (
+	AppRunning     = "Running"
+	AppProgressing = "Progressing"
+	AppStopped     = "Stopped"
+	AppDegraded    = "Degraded"
+	AppEmpty       = "Empty"
+)
+
+// routeBackendMissing 路由服务标记的后端服务不可用状态
+const routeBackendMissing = "Missing"
+
+// GetStatus 汇总应用中全部资源在k8s中的状态
+// 任意资源缺失或者失败时应用为 Degraded，所有工作负载都停止时为 Stopped
+func (a *ApplicationDataService) GetStatus(app *model.Application) (*application.AppStatus, error) {
+	result := &application.AppStatus{AppId: app.ID}
+	for i := range app.AppChildren {
+		status, err := a.childStatus(app, &app.AppChildren[i])
+		if err != nil {
+			return nil, err
+		}
+		result.AppChildren = append(result.AppChildren, status)
+	}
+	result.AppStatus = aggregateStatus(result.AppChildren)
+	return result, nil
+}
+
+// aggregateStatus 根据资源的状态计算应用的状态
+func aggregateStatus(children []*application.AppChildStatus) string {
+	if len(children) == 0 {
+		return AppEmpty
+	}
+	var progressing, stopped, workloads int
+	for _, child := range children {
+		if child.ChildKind == model.KindPod || child.ChildKind == model.KindMiddleware {
+			workloads++
+		}
+		switch child.Status {
+		case StatusMissing, StatusFailed:
+			return AppDegraded
+		case StatusProgressing:
+			progressing++
+		case StatusStopped:
+			stopped++
+		}
+	}
+	if workloads > 0 && stopped == workloads {
+		return AppStopped
+	}
+	if progressing > 0 || stopped > 0 {
+		return AppProgressing
+	}
+	return AppRunning
+}
+
+// childStatus 读取一个资源的状态
+func (a *ApplicationDataService) childStatus(app *model.Application, child *model.AppChild) (*application.AppChildStatus, error) {
+	result := &application.AppChildStatus{
+		ChildKind: child.ChildKind,
+		ChildId:   child.ChildID,
+		ChildName: child.ChildName,
+	}
+	var err error
+	switch child.ChildKind {
+	case model.KindPod:
+		var deployment *v1.Deployment
+		deployment, err = a.K8sClientSet.AppsV1().Deployments(app.AppNamespace).Get(context.TODO(), child.ChildName, v13.GetOptions{})
+		if err == nil {
+			result.Status, result.Msg = deploymentStatus(deployment)
+		}
+	case model.KindMiddleware:
+		var statefulSet *v1.StatefulSet
+		statefulSet, err = a.K8sClientSet.AppsV1().StatefulSets(app.AppNamespace).Get(context.TODO(), child.ChildName, v13.GetOptions{})
+		if err == nil {
+			result.Status, result.Msg = statefulSetStatus(statefulSet)
+		}
+	case model.KindSvc:
+		_, err = a.K8sClientSet.CoreV1().Services(app.AppNamespace).Get(context.TODO(), child.ChildName, v13.GetOptions{})
+		if err == nil {
+			result.Status = StatusReady
+		}
+	case model.KindVolume:
+		var pvc *v12.PersistentVolumeClaim
+		pvc, err = a.K8sClientSet.CoreV1().PersistentVolumeClaims(app.AppNamespace).Get(context.TODO(), child.ChildName, v13.GetOptions{})
+		if err == nil {
+			result.Status, result.Msg = pvcStatus(pvc)
+		}
+	case model.KindRoute:
+		err = a.routeStatus(app, child, result)
+	}
+	if k8serrors.IsNotFound(err) {
+		result.Status = StatusMissing
+		result.Msg = child.ChildKind + " " + child.ChildName + " 在k8s中不存在"
+		return result, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// deploymentStatus 副本为0时为停止，全部副本更新并就绪时为就绪，超过发布期限时为失败
+func deploymentStatus(deployment *v1.Deployment) (string, string) {
+	replicas := int32(1)
+	if deployment.Spec.Replicas != nil {
+		replicas = *deployment.Spec.Replicas
+	}
+	if replicas == 0 {
+		return StatusStopped, ""
+	}
+	msg := "就绪 " + strconv.Itoa(int(deployment.Status.ReadyReplicas)) + "/" + strconv.Itoa(int(replicas))
+	for _, condition := range deployment.Status.Conditions {
+		if condition.Type == v1.DeploymentProgressing && condition.Status == v12.ConditionFalse {
+			return StatusFailed, msg + "，" + condition.Message
+		}
+	}
+	if deployment.Status.ReadyReplicas >= replicas && deployment.Status.UpdatedReplicas >= replicas {
+		return StatusReady, msg
+	}
+	return StatusProgressing, msg
+}
+
+// statefulSetStatus 副本为0时为停止，全部副本就绪并且是最新版本时为就绪
+func statefulSetStatus(statefulSet *v1.StatefulSet) (string, string) {
+	replicas := int32(1)
+	if statefulSet.Spec.Replicas != nil {
+		replicas = *statefulSet.Spec.Replicas
+	}
+	if replicas == 0 {
+		return StatusStopped, ""
+	}
+	msg := "就绪 " + strconv.Itoa(int(statefulSet.Status.ReadyReplicas)) + "/" + strconv.Itoa(int(replicas))
+	if statefulSet.Status.ReadyReplicas >= replicas && statefulSet.Status.UpdateRevision == statefulSet.Status.CurrentRevision {
+		return StatusReady, msg
+	}
+	return StatusProgressing, msg
+}
+
+// pvcStatus 绑定后为就绪，等待绑定时为进行中，PV丢失时为失败
+func pvcStatus(pvc *v12.PersistentVolumeClaim) (string, string) {
+	switch pvc.Status.Phase {
+	case v12.ClaimBound:
+		return StatusReady, ""
+	case v12.ClaimLost:
+		return StatusFailed, "绑定的PV " + pvc.Spec.VolumeName + " 已经丢失"
+	default:
+		return StatusProgressing, "等待绑定存储"
+	}
+}
+
+// routeStatus 路由的ingress或者HTTPRoute存在时为就绪，路由服务标记后端服务不可用时为失败
+func (a *ApplicationDataService) routeStatus(app *model.Application, child *model.AppChild, result *application.AppChildStatus) error {
+	exists := false
+	for _, object := range childObjects(child) {
+		_, err := a.DynamicClient.Resource(object.resource).Namespace(app.AppNamespace).Get(context.TODO(), object.name, v13.GetOptions{})
+		if k8serrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		exists = true
+	}
+	if !exists {
+		result.Status = StatusMissing
+		result.Msg = "路由 " + child.ChildName + " 在k8s中不存在"
+		return nil
+	}
+
+	info, err := a.findChild(child.ChildKind, child.ChildID)
+	if err != nil {
+		return err
+	}
+	if info.route.RouteBackendStatus == routeBackendMissing {
+		result.Status = StatusFailed
+		result.Msg = info.route.RouteBackendMsg
+		return nil
+	}
+	result.Status = StatusReady
+	return nil
+}
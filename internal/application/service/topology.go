@@ -0,0 +1,122 @@
+package service
+
+import (
+	"strconv"
+	"strings"
+	"tini-paas/internal/application/model"
+	"tini-paas/internal/application/proto/application"
+	"tini-paas/internal/route/proto/route"
+)
+
+// 拓扑中的引用关系
+const (
+	RelationRoutesTo = "routes-to"
+	RelationSelects  = "selects"
+	RelationMounts   = "mounts"
+)
+
+// nodeKey 拓扑节点的键 <类型>/<ID>
+func nodeKey(kind string, id int64) string {
+	return kind + "/" + strconv.FormatInt(id, 10)
+}
+
+// GetTopology 应用中资源之间的引用关系
+// 路由转发到服务或中间件的服务，服务选择pod，pod和中间件挂载存储，只包含应用内部的引用
+func (a *ApplicationDataService) GetTopology(app *model.Application) (*application.AppTopology, error) {
+	status, err := a.GetStatus(app)
+	if err != nil {
+		return nil, err
+	}
+	result := &application.AppTopology{AppId: app.ID}
+	for _, child := range status.AppChildren {
+		result.Nodes = append(result.Nodes, &application.AppNode{
+			Key:       nodeKey(child.ChildKind, child.ChildId),
+			ChildKind: child.ChildKind,
+			ChildId:   child.ChildId,
+			ChildName: child.ChildName,
+			Status:    child.Status,
+		})
+	}
+
+	infos := make([]*childInfo, len(app.AppChildren))
+	for i, child := range app.AppChildren {
+		infos[i], err = a.findChild(child.ChildKind, child.ChildID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// 按照k8s中的名称查找应用内的资源
+	services := map[string]string{}
+	pods := map[string]string{}
+	workloads := map[string]string{}
+	for i, info := range infos {
+		key := nodeKey(app.AppChildren[i].ChildKind, app.AppChildren[i].ChildID)
+		switch {
+		case info.svc != nil:
+			services[info.name] = key
+		case info.pod != nil:
+			pods[info.name] = key
+			workloads["Deployment/"+info.name] = key
+		case info.middleware != nil:
+			services[info.name] = key
+			services[info.name+"-client"] = key
+			workloads["StatefulSet/"+info.name] = key
+		}
+	}
+
+	// 只保留两端都在应用中的引用
+	nodes := map[string]bool{}
+	for _, node := range result.Nodes {
+		nodes[node.Key] = true
+	}
+	edges := map[string]bool{}
+	addEdge := func(from, to, relation string) {
+		if !nodes[from] || !nodes[to] || edges[from+"|"+to] {
+			return
+		}
+		edges[from+"|"+to] = true
+		result.Edges = append(result.Edges, &application.AppEdge{From: from, To: to, Relation: relation})
+	}
+	for i, info := range infos {
+		key := nodeKey(app.AppChildren[i].ChildKind, app.AppChildren[i].ChildID)
+		switch {
+		case info.route != nil:
+			for _, name := range routeServices(info.route) {
+				addEdge(key, services[name], RelationRoutesTo)
+			}
+		case info.svc != nil:
+			if info.svc.SvcPodId > 0 {
+				addEdge(key, nodeKey(model.KindPod, info.svc.SvcPodId), RelationSelects)
+			} else {
+				addEdge(key, pods[info.svc.SvcPodName], RelationSelects)
+			}
+		case info.volume != nil:
+			for _, consumer := range info.volume.VolumeConsumers {
+				addEdge(workloads[consumer.Kind+"/"+consumer.Name], key, RelationMounts)
+			}
+		}
+	}
+	return result, nil
+}
+
+// routeServices 路由转发到的全部服务名称，包括分流服务、镜像服务和默认服务
+func routeServices(info *route.RouteInfo) []string {
+	var names []string
+	for _, path := range info.RoutePath {
+		names = append(names, path.RouteBackendService)
+		// 分流服务的格式为 服务:端口:权重
+		for _, backend := range strings.Split(path.RouteExtraBackends, ",") {
+			if name := strings.TrimSpace(strings.Split(backend, ":")[0]); name != "" {
+				names = append(names, name)
+			}
+		}
+		if path.RouteMirrorService != "" {
+			names = append(names, path.RouteMirrorService)
+		}
+	}
+	if info.RouteDefaultBackendService != "" {
+		names = append(names, info.RouteDefaultBackendService)
+	}
+	return names
+}
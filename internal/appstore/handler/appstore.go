@@ -122,3 +122,34 @@ func (a *AppStoreHandler) GetViewNum(ctx context.Context, id *appstore.AppStoreI
 	number.Num = a.AppStoreDataService.GetViewNum(id.Id)
 	return nil
 }
+
+// InstallApp 将应用的pod、中间件和存储克隆到命名空间并创建，返回安装实例
+func (a *AppStoreHandler) InstallApp(ctx context.Context, req *appstore.InstallAppRequest, info *appstore.AppInstanceInfo) error {
+	appStoreModel, err := a.AppStoreDataService.FindAppStoreByID(req.AppId)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+
+	instance, err := a.AppStoreDataService.InstallApp(appStoreModel, req.Namespace, req.Prefix, req.Params)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	common.Info("应用 " + appStoreModel.AppTitle + " 安装到命名空间 " + req.Namespace + " 成功，实例ID为：" + strconv.FormatInt(instance.ID, 10))
+
+	// 数据转换
+	return common.SwapTo(instance, info)
+}
+
+// FindAppInstanceByID 查询安装实例和创建的资源
+func (a *AppStoreHandler) FindAppInstanceByID(ctx context.Context, id *appstore.AppInstanceID, info *appstore.AppInstanceInfo) error {
+	instance, err := a.AppStoreDataService.FindAppInstanceByID(id.Id)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+
+	// 数据转换
+	return common.SwapTo(instance, info)
+}
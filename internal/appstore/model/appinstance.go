@@ -0,0 +1,51 @@
+package model
+
+// 安装实例的状态
+const (
+	InstanceInstalling = "Installing"
+	InstanceInstalled  = "Installed"
+	InstanceFailed     = "Failed"
+)
+
+// AppInstance 应用市场应用安装到命名空间的实例
+type AppInstance struct {
+	ID int64 `gorm:"primary_key;not_null;auto_increment" json:"id"`
+
+	// AppID 安装的应用市场应用
+	AppID int64 `gorm:"index" json:"app_id"`
+
+	// InstanceNamespace 安装到的命名空间
+	InstanceNamespace string `gorm:"unique_index:idx_app_instance_namespace_prefix;not_null" json:"instance_namespace"`
+
+	// InstancePrefix 资源名称前缀，克隆的资源命名为 前缀-原名称
+	InstancePrefix string `gorm:"unique_index:idx_app_instance_namespace_prefix;not_null" json:"instance_prefix"`
+
+	// InstanceStatus 安装状态：Installing, Installed, Failed
+	InstanceStatus string `json:"instance_status"`
+
+	// InstanceMsg 安装失败的原因
+	InstanceMsg string `json:"instance_msg"`
+
+	// InstanceResource 安装时创建的资源
+	InstanceResource []AppInstanceResource `gorm:"ForeignKey:InstanceID" json:"instance_resource"`
+}
+
+// AppInstanceResource 安装实例创建的资源
+type AppInstanceResource struct {
+	ID int64 `gorm:"primary_key;not_null;auto_increment"`
+
+	// InstanceID 所属的安装实例
+	InstanceID int64 `gorm:"index" json:"instance_id"`
+
+	// ResourceKind 资源类型：pod, middleware, volume
+	ResourceKind string `json:"resource_kind"`
+
+	// SourceID 应用模板中被克隆的资源ID
+	SourceID int64 `json:"source_id"`
+
+	// ResourceID 创建的资源ID
+	ResourceID int64 `json:"resource_id"`
+
+	// ResourceName 创建的资源名称
+	ResourceName string `json:"resource_name"`
+}
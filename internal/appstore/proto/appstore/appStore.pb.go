@@ -715,6 +715,306 @@ func (x *Number) GetNum() int64 {
 	return 0
 }
 
+// InstallAppRequest 安装应用
+type InstallAppRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AppId     int64  `protobuf:"varint,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// 资源名称前缀，为空时使用应用的sku
+	Prefix string `protobuf:"bytes,3,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	// 覆盖pod和中间件中同名环境变量的值
+	Params map[string]string `protobuf:"bytes,4,rep,name=params,proto3" json:"params,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *InstallAppRequest) Reset() {
+	*x = InstallAppRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_appStore_appStore_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InstallAppRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstallAppRequest) ProtoMessage() {}
+
+func (x *InstallAppRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_appStore_appStore_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstallAppRequest.ProtoReflect.Descriptor instead.
+func (*InstallAppRequest) Descriptor() ([]byte, []int) {
+	return file_proto_appStore_appStore_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *InstallAppRequest) GetAppId() int64 {
+	if x != nil {
+		return x.AppId
+	}
+	return 0
+}
+
+func (x *InstallAppRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *InstallAppRequest) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *InstallAppRequest) GetParams() map[string]string {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+// AppInstanceID 安装实例ID
+type AppInstanceID struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *AppInstanceID) Reset() {
+	*x = AppInstanceID{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_appStore_appStore_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AppInstanceID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppInstanceID) ProtoMessage() {}
+
+func (x *AppInstanceID) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_appStore_appStore_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppInstanceID.ProtoReflect.Descriptor instead.
+func (*AppInstanceID) Descriptor() ([]byte, []int) {
+	return file_proto_appStore_appStore_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *AppInstanceID) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// AppInstanceInfo 安装实例
+type AppInstanceInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	AppId             int64  `protobuf:"varint,2,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	InstanceNamespace string `protobuf:"bytes,3,opt,name=instance_namespace,json=instanceNamespace,proto3" json:"instance_namespace,omitempty"`
+	InstancePrefix    string `protobuf:"bytes,4,opt,name=instance_prefix,json=instancePrefix,proto3" json:"instance_prefix,omitempty"`
+	// Installing, Installed, Failed
+	InstanceStatus   string                 `protobuf:"bytes,5,opt,name=instance_status,json=instanceStatus,proto3" json:"instance_status,omitempty"`
+	InstanceMsg      string                 `protobuf:"bytes,6,opt,name=instance_msg,json=instanceMsg,proto3" json:"instance_msg,omitempty"`
+	InstanceResource []*AppInstanceResource `protobuf:"bytes,7,rep,name=instance_resource,json=instanceResource,proto3" json:"instance_resource,omitempty"`
+}
+
+func (x *AppInstanceInfo) Reset() {
+	*x = AppInstanceInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_appStore_appStore_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AppInstanceInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppInstanceInfo) ProtoMessage() {}
+
+func (x *AppInstanceInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_appStore_appStore_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppInstanceInfo.ProtoReflect.Descriptor instead.
+func (*AppInstanceInfo) Descriptor() ([]byte, []int) {
+	return file_proto_appStore_appStore_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *AppInstanceInfo) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AppInstanceInfo) GetAppId() int64 {
+	if x != nil {
+		return x.AppId
+	}
+	return 0
+}
+
+func (x *AppInstanceInfo) GetInstanceNamespace() string {
+	if x != nil {
+		return x.InstanceNamespace
+	}
+	return ""
+}
+
+func (x *AppInstanceInfo) GetInstancePrefix() string {
+	if x != nil {
+		return x.InstancePrefix
+	}
+	return ""
+}
+
+func (x *AppInstanceInfo) GetInstanceStatus() string {
+	if x != nil {
+		return x.InstanceStatus
+	}
+	return ""
+}
+
+func (x *AppInstanceInfo) GetInstanceMsg() string {
+	if x != nil {
+		return x.InstanceMsg
+	}
+	return ""
+}
+
+func (x *AppInstanceInfo) GetInstanceResource() []*AppInstanceResource {
+	if x != nil {
+		return x.InstanceResource
+	}
+	return nil
+}
+
+// AppInstanceResource 安装实例创建的资源
+type AppInstanceResource struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InstanceId int64 `protobuf:"varint,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	// pod, middleware, volume
+	ResourceKind string `protobuf:"bytes,2,opt,name=resource_kind,json=resourceKind,proto3" json:"resource_kind,omitempty"`
+	SourceId     int64  `protobuf:"varint,3,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	ResourceId   int64  `protobuf:"varint,4,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	ResourceName string `protobuf:"bytes,5,opt,name=resource_name,json=resourceName,proto3" json:"resource_name,omitempty"`
+}
+
+func (x *AppInstanceResource) Reset() {
+	*x = AppInstanceResource{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_appStore_appStore_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AppInstanceResource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppInstanceResource) ProtoMessage() {}
+
+func (x *AppInstanceResource) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_appStore_appStore_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppInstanceResource.ProtoReflect.Descriptor instead.
+func (*AppInstanceResource) Descriptor() ([]byte, []int) {
+	return file_proto_appStore_appStore_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *AppInstanceResource) GetInstanceId() int64 {
+	if x != nil {
+		return x.InstanceId
+	}
+	return 0
+}
+
+func (x *AppInstanceResource) GetResourceKind() string {
+	if x != nil {
+		return x.ResourceKind
+	}
+	return ""
+}
+
+func (x *AppInstanceResource) GetSourceId() int64 {
+	if x != nil {
+		return x.SourceId
+	}
+	return 0
+}
+
+func (x *AppInstanceResource) GetResourceId() int64 {
+	if x != nil {
+		return x.ResourceId
+	}
+	return 0
+}
+
+func (x *AppInstanceResource) GetResourceName() string {
+	if x != nil {
+		return x.ResourceName
+	}
+	return ""
+}
+
 var File_proto_appStore_appStore_proto protoreflect.FileDescriptor
 
 var file_proto_appStore_appStore_proto_rawDesc = []byte{
@@ -796,44 +1096,100 @@ var file_proto_appStore_appStore_proto_rawDesc = []byte{
 	0x6f, 0x72, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x61, 0x70, 0x70, 0x53, 0x74, 0x6f, 0x72,
 	0x65, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x1a, 0x0a, 0x06, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12,
 	0x10, 0x0a, 0x03, 0x6e, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6e, 0x75,
-	0x6d, 0x32, 0xb2, 0x04, 0x0a, 0x08, 0x41, 0x70, 0x70, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x3b,
-	0x0a, 0x0b, 0x41, 0x64, 0x64, 0x41, 0x70, 0x70, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x16, 0x2e,
-	0x61, 0x70, 0x70, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x41, 0x70, 0x70, 0x53, 0x74, 0x6f, 0x72,
-	0x65, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x12, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x74, 0x6f, 0x72, 0x65,
-	0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x0e, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x70, 0x70, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x14, 0x2e,
-	0x61, 0x70, 0x70, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x41, 0x70, 0x70, 0x53, 0x74, 0x6f, 0x72,
-	0x65, 0x49, 0x44, 0x1a, 0x12, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x0e, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x16, 0x2e, 0x61, 0x70,
-	0x70, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x41, 0x70, 0x70, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x49,
-	0x6e, 0x66, 0x6f, 0x1a, 0x12, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x10, 0x46, 0x69, 0x6e,
-	0x64, 0x41, 0x70, 0x70, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x42, 0x79, 0x49, 0x44, 0x12, 0x14, 0x2e,
-	0x61, 0x70, 0x70, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x41, 0x70, 0x70, 0x53, 0x74, 0x6f, 0x72,
-	0x65, 0x49, 0x44, 0x1a, 0x16, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x41,
-	0x70, 0x70, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x12, 0x3d, 0x0a,
-	0x0f, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x41, 0x70, 0x70, 0x53, 0x74, 0x6f, 0x72, 0x65,
-	0x12, 0x11, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x46, 0x69, 0x6e, 0x64,
-	0x41, 0x6c, 0x6c, 0x1a, 0x15, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x41,
-	0x6c, 0x6c, 0x41, 0x70, 0x70, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x0d,
-	0x41, 0x64, 0x64, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x4e, 0x75, 0x6d, 0x12, 0x14, 0x2e,
-	0x61, 0x70, 0x70, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x41, 0x70, 0x70, 0x53, 0x74, 0x6f, 0x72,
-	0x65, 0x49, 0x44, 0x1a, 0x12, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x0d, 0x47, 0x65, 0x74,
-	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x4e, 0x75, 0x6d, 0x12, 0x14, 0x2e, 0x61, 0x70, 0x70,
-	0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x41, 0x70, 0x70, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x44,
-	0x1a, 0x10, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x4e, 0x75, 0x6d, 0x62,
-	0x65, 0x72, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x0a, 0x41, 0x64, 0x64, 0x56, 0x69, 0x65, 0x77, 0x4e,
-	0x75, 0x6d, 0x12, 0x14, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x41, 0x70,
-	0x70, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x44, 0x1a, 0x12, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x74,
-	0x6f, 0x72, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x36,
-	0x0a, 0x0a, 0x47, 0x65, 0x74, 0x56, 0x69, 0x65, 0x77, 0x4e, 0x75, 0x6d, 0x12, 0x14, 0x2e, 0x61,
-	0x70, 0x70, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x41, 0x70, 0x70, 0x53, 0x74, 0x6f, 0x72, 0x65,
-	0x49, 0x44, 0x1a, 0x10, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x4e, 0x75,
-	0x6d, 0x62, 0x65, 0x72, 0x22, 0x00, 0x42, 0x1b, 0x5a, 0x19, 0x2e, 0x2f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2f, 0x61, 0x70, 0x70, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x3b, 0x61, 0x70, 0x70, 0x73, 0x74,
-	0x6f, 0x72, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6d, 0x22, 0xdc, 0x01, 0x0a, 0x11, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x41, 0x70, 0x70,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x61, 0x70, 0x70, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x12, 0x1c,
+	0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72,
+	0x65, 0x66, 0x69, 0x78, 0x12, 0x3f, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e,
+	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x41, 0x70, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x70,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0x1f, 0x0a, 0x0d, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49,
+	0x44, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69,
+	0x64, 0x22, 0xa8, 0x02, 0x0a, 0x0f, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x61, 0x70, 0x70, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x12, 0x2d, 0x0a, 0x12,
+	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x69,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x50, 0x72,
+	0x65, 0x66, 0x69, 0x78, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x21, 0x0a,
+	0x0c, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x73, 0x67,
+	0x12, 0x4a, 0x0a, 0x11, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x70,
+	0x70, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x10, 0x69, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0xbe, 0x01, 0x0a,
+	0x13, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x5f, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x72, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x32, 0xc7, 0x05,
+	0x0a, 0x08, 0x41, 0x70, 0x70, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x41, 0x64,
+	0x64, 0x41, 0x70, 0x70, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x16, 0x2e, 0x61, 0x70, 0x70, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x2e, 0x41, 0x70, 0x70, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x6e, 0x66,
+	0x6f, 0x1a, 0x12, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x41, 0x70, 0x70, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x14, 0x2e, 0x61, 0x70, 0x70, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x2e, 0x41, 0x70, 0x70, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x44, 0x1a,
+	0x12, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41,
+	0x70, 0x70, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x16, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x2e, 0x41, 0x70, 0x70, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x1a,
+	0x12, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x10, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x70, 0x70,
+	0x53, 0x74, 0x6f, 0x72, 0x65, 0x42, 0x79, 0x49, 0x44, 0x12, 0x14, 0x2e, 0x61, 0x70, 0x70, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x2e, 0x41, 0x70, 0x70, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x44, 0x1a,
+	0x16, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x41, 0x70, 0x70, 0x53, 0x74,
+	0x6f, 0x72, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0f, 0x46, 0x69, 0x6e,
+	0x64, 0x41, 0x6c, 0x6c, 0x41, 0x70, 0x70, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x11, 0x2e, 0x61,
+	0x70, 0x70, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x1a,
+	0x15, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x41, 0x6c, 0x6c, 0x41, 0x70,
+	0x70, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x0d, 0x41, 0x64, 0x64, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x4e, 0x75, 0x6d, 0x12, 0x14, 0x2e, 0x61, 0x70, 0x70, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x2e, 0x41, 0x70, 0x70, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x44, 0x1a,
+	0x12, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6c, 0x6c, 0x4e, 0x75, 0x6d, 0x12, 0x14, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x2e, 0x41, 0x70, 0x70, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x44, 0x1a, 0x10, 0x2e, 0x61,
+	0x70, 0x70, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x00,
+	0x12, 0x38, 0x0a, 0x0a, 0x41, 0x64, 0x64, 0x56, 0x69, 0x65, 0x77, 0x4e, 0x75, 0x6d, 0x12, 0x14,
+	0x2e, 0x61, 0x70, 0x70, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x41, 0x70, 0x70, 0x53, 0x74, 0x6f,
+	0x72, 0x65, 0x49, 0x44, 0x1a, 0x12, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x0a, 0x47, 0x65,
+	0x74, 0x56, 0x69, 0x65, 0x77, 0x4e, 0x75, 0x6d, 0x12, 0x14, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x2e, 0x41, 0x70, 0x70, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x44, 0x1a, 0x10,
+	0x2e, 0x61, 0x70, 0x70, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x22, 0x00, 0x12, 0x46, 0x0a, 0x0a, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x41, 0x70, 0x70,
+	0x12, 0x1b, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6c, 0x6c, 0x41, 0x70, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e,
+	0x61, 0x70, 0x70, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x13, 0x46, 0x69,
+	0x6e, 0x64, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x42, 0x79, 0x49,
+	0x44, 0x12, 0x17, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x41, 0x70, 0x70,
+	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x44, 0x1a, 0x19, 0x2e, 0x61, 0x70, 0x70,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x42, 0x1b, 0x5a, 0x19, 0x2e, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2f, 0x61, 0x70, 0x70, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x3b, 0x61, 0x70, 0x70, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -848,19 +1204,24 @@ func file_proto_appStore_appStore_proto_rawDescGZIP() []byte {
 	return file_proto_appStore_appStore_proto_rawDescData
 }
 
-var file_proto_appStore_appStore_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_proto_appStore_appStore_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_proto_appStore_appStore_proto_goTypes = []interface{}{
-	(*AppStoreInfo)(nil), // 0: appstore.AppStoreInfo
-	(*AppStoreID)(nil),   // 1: appstore.AppStoreID
-	(*AppImage)(nil),     // 2: appstore.AppImage
-	(*AppPod)(nil),       // 3: appstore.AppPod
-	(*AppMiddle)(nil),    // 4: appstore.AppMiddle
-	(*AppVolume)(nil),    // 5: appstore.AppVolume
-	(*AppComment)(nil),   // 6: appstore.AppComment
-	(*Response)(nil),     // 7: appstore.Response
-	(*FindAll)(nil),      // 8: appstore.FindAll
-	(*AllAppStore)(nil),  // 9: appstore.AllAppStore
-	(*Number)(nil),       // 10: appstore.Number
+	(*AppStoreInfo)(nil),        // 0: appstore.AppStoreInfo
+	(*AppStoreID)(nil),          // 1: appstore.AppStoreID
+	(*AppImage)(nil),            // 2: appstore.AppImage
+	(*AppPod)(nil),              // 3: appstore.AppPod
+	(*AppMiddle)(nil),           // 4: appstore.AppMiddle
+	(*AppVolume)(nil),           // 5: appstore.AppVolume
+	(*AppComment)(nil),          // 6: appstore.AppComment
+	(*Response)(nil),            // 7: appstore.Response
+	(*FindAll)(nil),             // 8: appstore.FindAll
+	(*AllAppStore)(nil),         // 9: appstore.AllAppStore
+	(*Number)(nil),              // 10: appstore.Number
+	(*InstallAppRequest)(nil),   // 11: appstore.InstallAppRequest
+	(*AppInstanceID)(nil),       // 12: appstore.AppInstanceID
+	(*AppInstanceInfo)(nil),     // 13: appstore.AppInstanceInfo
+	(*AppInstanceResource)(nil), // 14: appstore.AppInstanceResource
+	nil,                         // 15: appstore.InstallAppRequest.ParamsEntry
 }
 var file_proto_appStore_appStore_proto_depIdxs = []int32{
 	2,  // 0: appstore.AppStoreInfo.app_image:type_name -> appstore.AppImage
@@ -869,29 +1230,35 @@ var file_proto_appStore_appStore_proto_depIdxs = []int32{
 	5,  // 3: appstore.AppStoreInfo.app_volume:type_name -> appstore.AppVolume
 	6,  // 4: appstore.AppStoreInfo.app_comment:type_name -> appstore.AppComment
 	0,  // 5: appstore.AllAppStore.app_store_info:type_name -> appstore.AppStoreInfo
-	0,  // 6: appstore.AppStore.AddAppStore:input_type -> appstore.AppStoreInfo
-	1,  // 7: appstore.AppStore.DeleteAppStore:input_type -> appstore.AppStoreID
-	0,  // 8: appstore.AppStore.UpdateAppStore:input_type -> appstore.AppStoreInfo
-	1,  // 9: appstore.AppStore.FindAppStoreByID:input_type -> appstore.AppStoreID
-	8,  // 10: appstore.AppStore.FindAllAppStore:input_type -> appstore.FindAll
-	1,  // 11: appstore.AppStore.AddInstallNum:input_type -> appstore.AppStoreID
-	1,  // 12: appstore.AppStore.GetInstallNum:input_type -> appstore.AppStoreID
-	1,  // 13: appstore.AppStore.AddViewNum:input_type -> appstore.AppStoreID
-	1,  // 14: appstore.AppStore.GetViewNum:input_type -> appstore.AppStoreID
-	7,  // 15: appstore.AppStore.AddAppStore:output_type -> appstore.Response
-	7,  // 16: appstore.AppStore.DeleteAppStore:output_type -> appstore.Response
-	7,  // 17: appstore.AppStore.UpdateAppStore:output_type -> appstore.Response
-	0,  // 18: appstore.AppStore.FindAppStoreByID:output_type -> appstore.AppStoreInfo
-	9,  // 19: appstore.AppStore.FindAllAppStore:output_type -> appstore.AllAppStore
-	7,  // 20: appstore.AppStore.AddInstallNum:output_type -> appstore.Response
-	10, // 21: appstore.AppStore.GetInstallNum:output_type -> appstore.Number
-	7,  // 22: appstore.AppStore.AddViewNum:output_type -> appstore.Response
-	10, // 23: appstore.AppStore.GetViewNum:output_type -> appstore.Number
-	15, // [15:24] is the sub-list for method output_type
-	6,  // [6:15] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	15, // 6: appstore.InstallAppRequest.params:type_name -> appstore.InstallAppRequest.ParamsEntry
+	14, // 7: appstore.AppInstanceInfo.instance_resource:type_name -> appstore.AppInstanceResource
+	0,  // 8: appstore.AppStore.AddAppStore:input_type -> appstore.AppStoreInfo
+	1,  // 9: appstore.AppStore.DeleteAppStore:input_type -> appstore.AppStoreID
+	0,  // 10: appstore.AppStore.UpdateAppStore:input_type -> appstore.AppStoreInfo
+	1,  // 11: appstore.AppStore.FindAppStoreByID:input_type -> appstore.AppStoreID
+	8,  // 12: appstore.AppStore.FindAllAppStore:input_type -> appstore.FindAll
+	1,  // 13: appstore.AppStore.AddInstallNum:input_type -> appstore.AppStoreID
+	1,  // 14: appstore.AppStore.GetInstallNum:input_type -> appstore.AppStoreID
+	1,  // 15: appstore.AppStore.AddViewNum:input_type -> appstore.AppStoreID
+	1,  // 16: appstore.AppStore.GetViewNum:input_type -> appstore.AppStoreID
+	11, // 17: appstore.AppStore.InstallApp:input_type -> appstore.InstallAppRequest
+	12, // 18: appstore.AppStore.FindAppInstanceByID:input_type -> appstore.AppInstanceID
+	7,  // 19: appstore.AppStore.AddAppStore:output_type -> appstore.Response
+	7,  // 20: appstore.AppStore.DeleteAppStore:output_type -> appstore.Response
+	7,  // 21: appstore.AppStore.UpdateAppStore:output_type -> appstore.Response
+	0,  // 22: appstore.AppStore.FindAppStoreByID:output_type -> appstore.AppStoreInfo
+	9,  // 23: appstore.AppStore.FindAllAppStore:output_type -> appstore.AllAppStore
+	7,  // 24: appstore.AppStore.AddInstallNum:output_type -> appstore.Response
+	10, // 25: appstore.AppStore.GetInstallNum:output_type -> appstore.Number
+	7,  // 26: appstore.AppStore.AddViewNum:output_type -> appstore.Response
+	10, // 27: appstore.AppStore.GetViewNum:output_type -> appstore.Number
+	13, // 28: appstore.AppStore.InstallApp:output_type -> appstore.AppInstanceInfo
+	13, // 29: appstore.AppStore.FindAppInstanceByID:output_type -> appstore.AppInstanceInfo
+	19, // [19:30] is the sub-list for method output_type
+	8,  // [8:19] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_proto_appStore_appStore_proto_init() }
@@ -1032,6 +1399,54 @@ func file_proto_appStore_appStore_proto_init() {
 				return nil
 			}
 		}
+		file_proto_appStore_appStore_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InstallAppRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_appStore_appStore_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppInstanceID); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_appStore_appStore_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppInstanceInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_appStore_appStore_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppInstanceResource); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1039,7 +1454,7 @@ func file_proto_appStore_appStore_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_appStore_appStore_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   11,
+			NumMessages:   16,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
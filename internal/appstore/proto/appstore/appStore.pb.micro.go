@@ -45,6 +45,9 @@ type AppStoreService interface {
 	GetInstallNum(ctx context.Context, in *AppStoreID, opts ...client.CallOption) (*Number, error)
 	AddViewNum(ctx context.Context, in *AppStoreID, opts ...client.CallOption) (*Response, error)
 	GetViewNum(ctx context.Context, in *AppStoreID, opts ...client.CallOption) (*Number, error)
+	// 将应用的pod、中间件和存储模板克隆到命名空间
+	InstallApp(ctx context.Context, in *InstallAppRequest, opts ...client.CallOption) (*AppInstanceInfo, error)
+	FindAppInstanceByID(ctx context.Context, in *AppInstanceID, opts ...client.CallOption) (*AppInstanceInfo, error)
 }
 
 type appStoreService struct {
@@ -149,6 +152,26 @@ func (c *appStoreService) GetViewNum(ctx context.Context, in *AppStoreID, opts .
 	return out, nil
 }
 
+func (c *appStoreService) InstallApp(ctx context.Context, in *InstallAppRequest, opts ...client.CallOption) (*AppInstanceInfo, error) {
+	req := c.c.NewRequest(c.name, "AppStore.InstallApp", in)
+	out := new(AppInstanceInfo)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appStoreService) FindAppInstanceByID(ctx context.Context, in *AppInstanceID, opts ...client.CallOption) (*AppInstanceInfo, error) {
+	req := c.c.NewRequest(c.name, "AppStore.FindAppInstanceByID", in)
+	out := new(AppInstanceInfo)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for AppStore service
 
 type AppStoreHandler interface {
@@ -161,6 +184,9 @@ type AppStoreHandler interface {
 	GetInstallNum(context.Context, *AppStoreID, *Number) error
 	AddViewNum(context.Context, *AppStoreID, *Response) error
 	GetViewNum(context.Context, *AppStoreID, *Number) error
+	// 将应用的pod、中间件和存储模板克隆到命名空间
+	InstallApp(context.Context, *InstallAppRequest, *AppInstanceInfo) error
+	FindAppInstanceByID(context.Context, *AppInstanceID, *AppInstanceInfo) error
 }
 
 func RegisterAppStoreHandler(s server.Server, hdlr AppStoreHandler, opts ...server.HandlerOption) error {
@@ -174,6 +200,8 @@ func RegisterAppStoreHandler(s server.Server, hdlr AppStoreHandler, opts ...serv
 		GetInstallNum(ctx context.Context, in *AppStoreID, out *Number) error
 		AddViewNum(ctx context.Context, in *AppStoreID, out *Response) error
 		GetViewNum(ctx context.Context, in *AppStoreID, out *Number) error
+		InstallApp(ctx context.Context, in *InstallAppRequest, out *AppInstanceInfo) error
+		FindAppInstanceByID(ctx context.Context, in *AppInstanceID, out *AppInstanceInfo) error
 	}
 	type AppStore struct {
 		appStore
@@ -221,3 +249,11 @@ func (h *appStoreHandler) AddViewNum(ctx context.Context, in *AppStoreID, out *R
 func (h *appStoreHandler) GetViewNum(ctx context.Context, in *AppStoreID, out *Number) error {
 	return h.AppStoreHandler.GetViewNum(ctx, in, out)
 }
+
+func (h *appStoreHandler) InstallApp(ctx context.Context, in *InstallAppRequest, out *AppInstanceInfo) error {
+	return h.AppStoreHandler.InstallApp(ctx, in, out)
+}
+
+func (h *appStoreHandler) FindAppInstanceByID(ctx context.Context, in *AppInstanceID, out *AppInstanceInfo) error {
+	return h.AppStoreHandler.FindAppInstanceByID(ctx, in, out)
+}
@@ -9,6 +9,9 @@ import (
 // AppStoreRepository 云应用商店接口
 type AppStoreRepository interface {
 	InitTable() error
+
+	// MigrateTable 迁移已有数据库，创建缺少的安装实例表和命名空间内前缀的唯一索引
+	MigrateTable() error
 	CreateAppStore(store *model.AppStore) (int64, error)
 	DeleteAppStore(id int64) error
 	UpdateAppStore(store *model.AppStore) error
@@ -18,6 +21,13 @@ type AppStoreRepository interface {
 	GetInstallNumber(id int64) int64
 	AddViewNumber(id int64) error
 	GetViewNumber(id int64) int64
+
+	CreateInstance(instance *model.AppInstance) (int64, error)
+	UpdateInstance(instance *model.AppInstance) error
+	FindInstanceByID(id int64) (*model.AppInstance, error)
+	FindInstanceByNamespaceAndPrefix(namespace, prefix string) (*model.AppInstance, error)
+	CreateInstanceResource(resource *model.AppInstanceResource) error
+	DeleteInstanceResources(instanceID int64) error
 }
 
 // NewAppStoreRepository 初始化数据操作对象
@@ -34,7 +44,16 @@ type AppStore struct {
 
 // InitTable 初始化表
 func (a *AppStore) InitTable() error {
-	return a.db.CreateTable(&model.AppStore{}, &model.AppCategory{}, &model.AppComment{}, &model.AppImage{}, &model.AppIsv{}, &model.AppMiddle{}, &model.AppPod{}, &model.AppVolume{}).Error
+	return a.db.CreateTable(&model.AppStore{}, &model.AppCategory{}, &model.AppComment{}, &model.AppImage{}, &model.AppIsv{}, &model.AppMiddle{}, &model.AppPod{}, &model.AppVolume{}, &model.AppInstance{}, &model.AppInstanceResource{}).Error
+}
+
+// MigrateTable 迁移已有数据库，创建缺少的安装实例表和命名空间内前缀的唯一索引
+func (a *AppStore) MigrateTable() error {
+	err := common.MigrateUniqueIndex(a.db, &model.AppInstance{}, "", "idx_app_instance_namespace_prefix", "instance_namespace", "instance_prefix")
+	if err != nil {
+		return err
+	}
+	return common.MigrateTables(a.db, &model.AppInstance{}, &model.AppInstanceResource{})
 }
 
 // CreateAppStore 创建应用市场
@@ -146,3 +165,39 @@ func (a *AppStore) GetViewNumber(i int64) int64 {
 	}
 	return appStore.AppViews
 }
+
+// CreateInstance 创建安装实例
+func (a *AppStore) CreateInstance(instance *model.AppInstance) (int64, error) {
+	return instance.ID, a.db.Create(instance).Error
+}
+
+// UpdateInstance 更新安装实例的状态，重新安装时同时更新安装的应用
+func (a *AppStore) UpdateInstance(instance *model.AppInstance) error {
+	return a.db.Model(instance).Updates(map[string]interface{}{
+		"app_id":          instance.AppID,
+		"instance_status": instance.InstanceStatus,
+		"instance_msg":    instance.InstanceMsg,
+	}).Error
+}
+
+// FindInstanceByID 查询安装实例和创建的资源
+func (a *AppStore) FindInstanceByID(id int64) (*model.AppInstance, error) {
+	instance := &model.AppInstance{}
+	return instance, a.db.Preload("InstanceResource").First(instance, id).Error
+}
+
+// FindInstanceByNamespaceAndPrefix 根据命名空间和名称前缀查询安装实例
+func (a *AppStore) FindInstanceByNamespaceAndPrefix(namespace, prefix string) (*model.AppInstance, error) {
+	instance := &model.AppInstance{}
+	return instance, a.db.Where("instance_namespace = ? AND instance_prefix = ?", namespace, prefix).Last(instance).Error
+}
+
+// CreateInstanceResource 记录安装实例创建的资源
+func (a *AppStore) CreateInstanceResource(resource *model.AppInstanceResource) error {
+	return a.db.Create(resource).Error
+}
+
+// DeleteInstanceResources 删除安装实例的资源记录
+func (a *AppStore) DeleteInstanceResources(instanceID int64) error {
+	return a.db.Where("instance_id = ?", instanceID).Delete(&model.AppInstanceResource{}).Error
+}
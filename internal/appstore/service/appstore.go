@@ -4,6 +4,9 @@ import (
 	"k8s.io/client-go/kubernetes"
 	"tini-paas/internal/appstore/model"
 	"tini-paas/internal/appstore/repository"
+	"tini-paas/internal/middleware/proto/middleware"
+	"tini-paas/internal/pod/proto/pod"
+	"tini-paas/internal/volume/proto/volume"
 )
 
 // AppStoreService 应用市场服务接口
@@ -18,12 +21,26 @@ type AppStoreService interface {
 	GetInstallNum(id int64) int64
 	AddViewNum(id int64) error
 	GetViewNum(id int64) int64
+
+	// InstallApp 将应用的pod、中间件和存储模板克隆到命名空间并创建
+	InstallApp(store *model.AppStore, namespace, prefix string, params map[string]string) (*model.AppInstance, error)
+
+	// FindAppInstanceByID 查询安装实例和创建的资源
+	FindAppInstanceByID(id int64) (*model.AppInstance, error)
+}
+
+// InstallServices 安装应用时创建资源的微服务客户端
+type InstallServices struct {
+	Pod        pod.PodService
+	Middleware middleware.MiddlewareService
+	Volume     volume.VolumeService
 }
 
 // NewAppStoreService 初始化应用市场服务
-func NewAppStoreService(storeRepository repository.AppStoreRepository, client *kubernetes.Clientset) AppStoreService {
+func NewAppStoreService(storeRepository repository.AppStoreRepository, client *kubernetes.Clientset, services InstallServices) AppStoreService {
 	return &AppStore{
 		AppStoreRepository: storeRepository,
+		Services:           services,
 	}
 }
 
@@ -31,6 +48,9 @@ func NewAppStoreService(storeRepository repository.AppStoreRepository, client *k
 type AppStore struct {
 	// AppStoreRepository 数据库对象
 	AppStoreRepository repository.AppStoreRepository
+
+	// Services 安装应用时通过这些服务创建资源
+	Services InstallServices
 }
 
 // AddAppStore 添加应用市场
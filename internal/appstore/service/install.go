@@ -0,0 +1,418 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/jinzhu/gorm"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"tini-paas/internal/appstore/model"
+	"tini-paas/internal/middleware/proto/middleware"
+	"tini-paas/internal/pod/proto/pod"
+	"tini-paas/internal/volume/proto/volume"
+)
+
+// 安装实例中资源的类型
+const (
+	ResourcePod        = "pod"
+	ResourceMiddleware = "middleware"
+	ResourceVolume     = "volume"
+)
+
+// prefixPattern 名称前缀需要满足k8s资源名称的规则
+var prefixPattern = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`)
+
+// installTemplate 应用中被克隆的资源定义
+type installTemplate struct {
+	volumes     []*volume.VolumeInfo
+	middlewares []*middleware.MiddlewareInfo
+	pods        []*pod.PodInfo
+}
+
+// FindAppInstanceByID 查询安装实例和创建的资源
+func (a *AppStore) FindAppInstanceByID(id int64) (*model.AppInstance, error) {
+	return a.AppStoreRepository.FindInstanceByID(id)
+}
+
+// InstallApp 将应用的存储、中间件和pod模板克隆到命名空间，名称加上前缀后通过各自的服务创建
+// 按照存储、中间件、pod的顺序创建，失败时删除已经创建的资源，实例标记为 Failed
+func (a *AppStore) InstallApp(store *model.AppStore, namespace, prefix string, params map[string]string) (*model.AppInstance, error) {
+	if namespace == "" {
+		return nil, errors.New("需要指定安装的命名空间")
+	}
+	if !store.AppCheck {
+		return nil, errors.New("应用 " + store.AppTitle + " 还没有通过审核")
+	}
+	if prefix == "" {
+		prefix = strings.ToLower(store.AppSku)
+	}
+	if !prefixPattern.MatchString(prefix) {
+		return nil, errors.New("名称前缀 " + prefix + " 只能包含小写字母、数字和-，并且以字母或数字开头和结尾")
+	}
+	// 同一命名空间内前缀唯一，安装失败的实例重新安装时复用
+	existing, err := a.AppStoreRepository.FindInstanceByNamespaceAndPrefix(namespace, prefix)
+	if err != nil && !gorm.IsRecordNotFoundError(err) {
+		return nil, err
+	}
+	reinstall := err == nil
+	if reinstall && existing.InstanceStatus != model.InstanceFailed {
+		return nil, errors.New("命名空间 " + namespace + " 中已经使用前缀 " + prefix + " 安装过应用，实例ID为：" + strconv.FormatInt(existing.ID, 10))
+	}
+
+	tpl, err := a.loadTemplate(store)
+	if err != nil {
+		return nil, err
+	}
+	renameReferences(tpl, prefix)
+	err = applyParams(tpl, params)
+	if err != nil {
+		return nil, err
+	}
+
+	instance := &model.AppInstance{
+		AppID:             store.ID,
+		InstanceNamespace: namespace,
+		InstancePrefix:    prefix,
+		InstanceStatus:    model.InstanceInstalling,
+	}
+	if reinstall {
+		instance.ID = existing.ID
+		err = a.AppStoreRepository.UpdateInstance(instance)
+	} else {
+		_, err = a.AppStoreRepository.CreateInstance(instance)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := a.createResources(instance, tpl)
+	if err != nil {
+		instance.InstanceStatus = model.InstanceFailed
+		instance.InstanceMsg = err.Error()
+		if rollbackErr := a.rollback(instance.InstanceNamespace, created); rollbackErr != nil {
+			instance.InstanceMsg += "；删除已经创建的资源失败：" + rollbackErr.Error()
+		} else if deleteErr := a.AppStoreRepository.DeleteInstanceResources(instance.ID); deleteErr != nil {
+			instance.InstanceMsg += "；" + deleteErr.Error()
+		}
+		if updateErr := a.AppStoreRepository.UpdateInstance(instance); updateErr != nil {
+			return nil, updateErr
+		}
+		return nil, errors.New("安装失败，实例ID为 " + strconv.FormatInt(instance.ID, 10) + "：" + instance.InstanceMsg)
+	}
+
+	instance.InstanceStatus = model.InstanceInstalled
+	err = a.AppStoreRepository.UpdateInstance(instance)
+	if err != nil {
+		return nil, err
+	}
+	err = a.AppStoreRepository.AddInstallNumber(store.ID)
+	if err != nil {
+		return nil, err
+	}
+	return a.AppStoreRepository.FindInstanceByID(instance.ID)
+}
+
+// loadTemplate 通过各自的服务查询应用中的存储、中间件和pod定义
+func (a *AppStore) loadTemplate(store *model.AppStore) (*installTemplate, error) {
+	ctx := context.TODO()
+	tpl := &installTemplate{}
+	for _, v := range store.AppVolume {
+		info, err := a.Services.Volume.FindVolumeByID(ctx, &volume.VolumeID{Id: v.AppVolumeID})
+		if err != nil {
+			return nil, errors.New("查询应用中的存储 " + strconv.FormatInt(v.AppVolumeID, 10) + " 失败：" + err.Error())
+		}
+		tpl.volumes = append(tpl.volumes, info)
+	}
+	for _, m := range store.AppMiddle {
+		info, err := a.Services.Middleware.FindMiddlewareByID(ctx, &middleware.MiddlewareID{Id: m.AppMiddleID})
+		if err != nil {
+			return nil, errors.New("查询应用中的中间件 " + strconv.FormatInt(m.AppMiddleID, 10) + " 失败：" + err.Error())
+		}
+		tpl.middlewares = append(tpl.middlewares, info)
+	}
+	for _, p := range store.AppPod {
+		info, err := a.Services.Pod.FindPodByID(ctx, &pod.PodID{Id: p.AppPodID})
+		if err != nil {
+			return nil, errors.New("查询应用中的pod " + strconv.FormatInt(p.AppPodID, 10) + " 失败：" + err.Error())
+		}
+		tpl.pods = append(tpl.pods, info)
+	}
+	if len(tpl.volumes)+len(tpl.middlewares)+len(tpl.pods) == 0 {
+		return nil, errors.New("应用 " + store.AppTitle + " 中没有可以安装的资源")
+	}
+	return tpl, nil
+}
+
+// renameReferences 环境变量的值等于应用中资源的原名称时改为加上前缀的名称
+// 例如 pod 通过 DB_HOST=mysql-client 连接应用中的中间件
+func renameReferences(tpl *installTemplate, prefix string) {
+	names := map[string]string{}
+	for _, info := range tpl.volumes {
+		names[info.VolumeName] = prefix + "-" + info.VolumeName
+	}
+	for _, info := range tpl.middlewares {
+		names[info.MiddleName] = prefix + "-" + info.MiddleName
+		names[info.MiddleName+"-client"] = prefix + "-" + info.MiddleName + "-client"
+	}
+	for _, info := range tpl.pods {
+		names[info.PodName] = prefix + "-" + info.PodName
+	}
+
+	for _, info := range tpl.pods {
+		for _, env := range info.PodEnv {
+			if name, ok := names[env.EnvValue]; ok {
+				env.EnvValue = name
+			}
+		}
+	}
+	for _, info := range tpl.middlewares {
+		for _, env := range info.MiddleEnv {
+			if name, ok := names[env.EnvValue]; ok {
+				env.EnvValue = name
+			}
+		}
+	}
+}
+
+// applyParams 用安装参数覆盖pod和中间件中同名环境变量的值
+// 没有对应环境变量的参数视为错误，避免拼写错误被忽略
+func applyParams(tpl *installTemplate, params map[string]string) error {
+	used := map[string]bool{}
+	for _, info := range tpl.pods {
+		for _, env := range info.PodEnv {
+			if value, ok := params[env.EnvKey]; ok {
+				env.EnvValue = value
+				used[env.EnvKey] = true
+			}
+		}
+	}
+	for _, info := range tpl.middlewares {
+		for _, env := range info.MiddleEnv {
+			if value, ok := params[env.EbvKey]; ok {
+				env.EnvValue = value
+				used[env.EbvKey] = true
+			}
+		}
+	}
+
+	var unknown []string
+	for key := range params {
+		if !used[key] {
+			unknown = append(unknown, key)
+		}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return errors.New("参数 " + strings.Join(unknown, ", ") + " 没有对应的环境变量")
+	}
+	return nil
+}
+
+// createResources 按照存储、中间件、pod的顺序创建资源，每创建一个就记录到实例中
+// 返回已经创建的资源，失败时用于回滚
+// 资源创建成功后先按名称记录，查询ID失败时回滚也能按照命名空间和名称删除
+func (a *AppStore) createResources(instance *model.AppInstance, tpl *installTemplate) ([]*model.AppInstanceResource, error) {
+	ctx := context.TODO()
+	namespace := instance.InstanceNamespace
+	var created []*model.AppInstanceResource
+	add := func(kind string, sourceID int64, name string) *model.AppInstanceResource {
+		resource := &model.AppInstanceResource{
+			InstanceID:   instance.ID,
+			ResourceKind: kind,
+			SourceID:     sourceID,
+			ResourceName: name,
+		}
+		created = append(created, resource)
+		return resource
+	}
+	record := func(resource *model.AppInstanceResource) error {
+		id, err := a.findResourceID(ctx, namespace, resource)
+		if err != nil {
+			return err
+		}
+		resource.ResourceID = id
+		return a.AppStoreRepository.CreateInstanceResource(resource)
+	}
+
+	for _, info := range tpl.volumes {
+		name := instance.InstancePrefix + "-" + info.VolumeName
+		_, err := a.Services.Volume.AddVolume(ctx, cloneVolume(info, namespace, name))
+		if err != nil {
+			return created, errors.New("创建存储 " + name + " 失败：" + err.Error())
+		}
+		if err = record(add(ResourceVolume, info.Id, name)); err != nil {
+			return created, err
+		}
+	}
+
+	for _, info := range tpl.middlewares {
+		name := instance.InstancePrefix + "-" + info.MiddleName
+		_, err := a.Services.Middleware.AddMiddleware(ctx, cloneMiddleware(info, namespace, name))
+		if err != nil {
+			return created, errors.New("创建中间件 " + name + " 失败：" + err.Error())
+		}
+		if err = record(add(ResourceMiddleware, info.Id, name)); err != nil {
+			return created, err
+		}
+	}
+
+	for _, info := range tpl.pods {
+		name := instance.InstancePrefix + "-" + info.PodName
+		_, err := a.Services.Pod.AddPod(ctx, clonePod(info, namespace, name))
+		if err != nil {
+			return created, errors.New("创建pod " + name + " 失败：" + err.Error())
+		}
+		if err = record(add(ResourcePod, info.Id, name)); err != nil {
+			return created, err
+		}
+	}
+	return created, nil
+}
+
+// findResourceID 按照命名空间和名称查询创建的资源ID
+func (a *AppStore) findResourceID(ctx context.Context, namespace string, resource *model.AppInstanceResource) (int64, error) {
+	switch resource.ResourceKind {
+	case ResourcePod:
+		result, err := a.Services.Pod.FindPodByNamespaceAndName(ctx, &pod.PodNamespaceName{Namespace: namespace, Name: resource.ResourceName})
+		if err != nil {
+			return 0, err
+		}
+		return result.Id, nil
+	case ResourceMiddleware:
+		result, err := a.Services.Middleware.FindMiddlewareByNamespaceAndName(ctx, &middleware.MiddlewareNamespaceName{Namespace: namespace, Name: resource.ResourceName})
+		if err != nil {
+			return 0, err
+		}
+		return result.Id, nil
+	case ResourceVolume:
+		result, err := a.Services.Volume.FindVolumeByNamespaceAndName(ctx, &volume.VolumeNamespaceName{Namespace: namespace, Name: resource.ResourceName})
+		if err != nil {
+			return 0, err
+		}
+		return result.Id, nil
+	}
+	return 0, errors.New("不支持的资源类型 " + resource.ResourceKind)
+}
+
+// rollback 按照与创建相反的顺序删除已经创建的资源
+// 没有记录ID的资源按照命名空间和名称重新查询后删除
+func (a *AppStore) rollback(namespace string, created []*model.AppInstanceResource) error {
+	ctx := context.TODO()
+	var errs []string
+	for i := len(created) - 1; i >= 0; i-- {
+		resource := created[i]
+		var err error
+		if resource.ResourceID == 0 {
+			resource.ResourceID, err = a.findResourceID(ctx, namespace, resource)
+			if err != nil {
+				errs = append(errs, resource.ResourceKind+" "+resource.ResourceName+"："+err.Error())
+				continue
+			}
+		}
+		switch resource.ResourceKind {
+		case ResourcePod:
+			_, err = a.Services.Pod.DeletePod(ctx, &pod.PodID{Id: resource.ResourceID})
+		case ResourceMiddleware:
+			_, err = a.Services.Middleware.DeleteMiddleware(ctx, &middleware.MiddlewareID{Id: resource.ResourceID})
+		case ResourceVolume:
+			// 使用存储的pod和中间件已经删除，pod退出前存储仍被使用，强制删除
+			_, err = a.Services.Volume.DeleteVolume(ctx, &volume.VolumeID{Id: resource.ResourceID, Force: true})
+		}
+		if err != nil {
+			errs = append(errs, resource.ResourceKind+" "+resource.ResourceName+"："+err.Error())
+		}
+	}
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// cloneVolume 克隆存储定义，去掉实时状态和数据来源，创建空的存储
+func cloneVolume(info *volume.VolumeInfo, namespace, name string) *volume.VolumeInfo {
+	return &volume.VolumeInfo{
+		VolumeName:                 name,
+		VolumeNamespace:            namespace,
+		VolumeAccessMode:           info.VolumeAccessMode,
+		VolumeStorageClassName:     info.VolumeStorageClassName,
+		VolumeRequest:              info.VolumeRequest,
+		VolumePersistentVolumeMode: info.VolumePersistentVolumeMode,
+	}
+}
+
+// cloneMiddleware 克隆中间件定义，密码和保存密码的Secret由中间件服务重新生成
+func cloneMiddleware(info *middleware.MiddlewareInfo, namespace, name string) *middleware.MiddlewareInfo {
+	clone := &middleware.MiddlewareInfo{
+		MiddleName:      name,
+		MiddleNamespace: namespace,
+		MiddleTypeId:    info.MiddleTypeId,
+		MiddleVersionId: info.MiddleVersionId,
+		MiddleCpu:       info.MiddleCpu,
+		MiddleMemory:    info.MiddleMemory,
+		MiddleReplicas:  info.MiddleReplicas,
+		MiddleSize:      info.MiddleSize,
+	}
+	for _, port := range info.MiddlePort {
+		clone.MiddlePort = append(clone.MiddlePort, &middleware.MiddlePort{
+			MiddlePort:     port.MiddlePort,
+			MiddleProtocol: port.MiddleProtocol,
+		})
+	}
+	for _, env := range info.MiddleEnv {
+		clone.MiddleEnv = append(clone.MiddleEnv, &middleware.MiddleEnv{
+			EbvKey:   env.EbvKey,
+			EnvValue: env.EnvValue,
+		})
+	}
+	for _, storage := range info.MiddleStorage {
+		clone.MiddleStorage = append(clone.MiddleStorage, &middleware.MiddleStorage{
+			MiddleStorageName:       storage.MiddleStorageName,
+			MiddleStorageSize:       storage.MiddleStorageSize,
+			MiddleStoragePath:       storage.MiddleStoragePath,
+			MiddleStorageClass:      storage.MiddleStorageClass,
+			MiddleStorageAccessMode: storage.MiddleStorageAccessMode,
+		})
+	}
+	if info.MiddleConfig != nil {
+		clone.MiddleConfig = &middleware.MiddleConfig{
+			MiddleConfigRootUser: info.MiddleConfig.MiddleConfigRootUser,
+			MiddleConfigUser:     info.MiddleConfig.MiddleConfigUser,
+			MiddleConfigDataBase: info.MiddleConfig.MiddleConfigDataBase,
+		}
+	}
+	return clone
+}
+
+// clonePod 克隆pod定义
+func clonePod(info *pod.PodInfo, namespace, name string) *pod.PodInfo {
+	clone := &pod.PodInfo{
+		PodNamespace:      namespace,
+		PodName:           name,
+		PodTeamId:         info.PodTeamId,
+		PodCpuMax:         info.PodCpuMax,
+		PodReplicas:       info.PodReplicas,
+		PodMemoryMax:      info.PodMemoryMax,
+		PodPullPolicy:     info.PodPullPolicy,
+		PodRestart:        info.PodRestart,
+		PodType:           info.PodType,
+		PodImage:          info.PodImage,
+		PodTagPolicy:      info.PodTagPolicy,
+		PodTagPolicyValue: info.PodTagPolicyValue,
+		PodImageDigest:    info.PodImageDigest,
+	}
+	for _, port := range info.PodPort {
+		clone.PodPort = append(clone.PodPort, &pod.PodPort{
+			ContainerPort: port.ContainerPort,
+			Protocol:      port.Protocol,
+		})
+	}
+	for _, env := range info.PodEnv {
+		clone.PodEnv = append(clone.PodEnv, &pod.PodEnv{
+			EnvKey:   env.EnvKey,
+			EnvValue: env.EnvValue,
+		})
+	}
+	return clone
+}
@@ -0,0 +1,140 @@
+package handler
+
+import (
+	"context"
+	"strconv"
+	"tini-paas/internal/build/model"
+	"tini-paas/internal/build/proto/build"
+	"tini-paas/internal/build/service"
+	"tini-paas/pkg/common"
+)
+
+// BuildHandler 操作接口
+type BuildHandler struct {
+	BuildService service.BuildService
+}
+
+// AddBuild 提交构建，先写入数据库获取ID，再在k8s中创建构建任务
+func (b *BuildHandler) AddBuild(ctx context.Context, info *build.BuildInfo, response *build.Response) error {
+	buildModel := &model.Build{}
+
+	// 将info信息映射到build
+	err := common.SwapTo(info, buildModel)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	buildModel.ID = 0
+	buildModel.BuildStatus = service.BuildPending
+
+	// 写入数据库
+	buildID, err := b.BuildService.AddBuild(buildModel)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+
+	// 在k8s中创建构建任务
+	err = b.BuildService.CreateBuildToK8s(buildModel)
+	if err != nil {
+		common.Error(err)
+		buildModel.BuildStatus = service.BuildFailed
+		buildModel.BuildMsg = err.Error()
+		_ = b.BuildService.UpdateBuild(buildModel)
+		return err
+	}
+
+	// 回写数据
+	common.Info("构建提交成功，ID为：" + strconv.FormatInt(buildID, 10))
+	response.Msg = "构建提交成功，ID为：" + strconv.FormatInt(buildID, 10)
+	return nil
+}
+
+// DeleteBuild 删除构建
+func (b *BuildHandler) DeleteBuild(ctx context.Context, id *build.BuildID, response *build.Response) error {
+	// 先查询是否存在
+	buildModel, err := b.BuildService.FindBuildByID(id.Id)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+
+	// 在k8s中删除
+	err = b.BuildService.DeleteBuildFromK8s(buildModel)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	response.Msg = "删除构建 ID: " + strconv.FormatInt(id.Id, 10) + " 成功"
+	return nil
+}
+
+// FindBuildByID 根据ID查询构建
+func (b *BuildHandler) FindBuildByID(ctx context.Context, id *build.BuildID, info *build.BuildInfo) error {
+	buildModel, err := b.BuildService.FindBuildByID(id.Id)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+
+	// 数据回写
+	err = common.SwapTo(buildModel, info)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	return nil
+}
+
+// FindAllBuild 查询全部构建
+func (b *BuildHandler) FindAllBuild(ctx context.Context, all *build.FindAll, allBuild *build.AllBuild) error {
+	builds, err := b.BuildService.FindAllBuild()
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	return appendBuilds(builds, allBuild)
+}
+
+// FindAllBuildByPodID 查询pod的构建历史
+func (b *BuildHandler) FindAllBuildByPodID(ctx context.Context, id *build.BuildPodID, allBuild *build.AllBuild) error {
+	builds, err := b.BuildService.FindAllBuildByPodID(id.PodId)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	return appendBuilds(builds, allBuild)
+}
+
+// StreamBuildLog 实时返回构建日志
+func (b *BuildHandler) StreamBuildLog(ctx context.Context, id *build.BuildID, stream build.Build_StreamBuildLogStream) error {
+	defer stream.Close()
+
+	buildModel, err := b.BuildService.FindBuildByID(id.Id)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+
+	return b.BuildService.StreamBuildLog(ctx, buildModel, func(line string) error {
+		return stream.Send(&build.BuildLog{Line: line})
+	})
+}
+
+// appendBuilds 整理格式
+func appendBuilds(builds []model.Build, allBuild *build.AllBuild) error {
+	for _, v := range builds {
+		// 创建实例
+		buildInfo := &build.BuildInfo{}
+		// 数据转换
+		err := common.SwapTo(v, buildInfo)
+		if err != nil {
+			common.Error(err)
+			return err
+		}
+
+		// 数据合并
+		allBuild.BuildInfo = append(allBuild.BuildInfo, buildInfo)
+	}
+	return nil
+}
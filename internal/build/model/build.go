@@ -0,0 +1,45 @@
+package model
+
+// Build 镜像构建记录
+type Build struct {
+	ID int64 `gorm:"primary_key;not_null;auto_increment" json:"id"`
+
+	// BuildNamespace 构建任务运行的命名空间
+	BuildNamespace string `json:"build_namespace"`
+
+	// BuildGitUrl 源码仓库地址
+	BuildGitUrl string `gorm:"not_null" json:"build_git_url"`
+
+	// BuildGitRef 分支、标签或者commit，为空时使用默认分支
+	BuildGitRef string `json:"build_git_ref"`
+
+	// BuildDockerfile Dockerfile在仓库中的路径，默认为 Dockerfile
+	BuildDockerfile string `json:"build_dockerfile"`
+
+	// BuildImage 构建完成后推送的镜像地址(带tag)
+	BuildImage string `gorm:"not_null" json:"build_image"`
+
+	// BuildRegistrySecret 推送镜像使用的仓库密钥(kubernetes.io/dockerconfigjson)
+	BuildRegistrySecret string `json:"build_registry_secret"`
+
+	// BuildPodID 构建成功后需要更新镜像的pod，为0时不更新
+	BuildPodID int64 `json:"build_pod_id"`
+
+	// BuildStatus 构建状态：Pending, Running, Succeeded, Failed
+	BuildStatus string `json:"build_status"`
+
+	// BuildJobName k8s中构建任务的名称
+	BuildJobName string `json:"build_job_name"`
+
+	// BuildLog 构建结束后保存的构建日志
+	BuildLog string `gorm:"type:longtext" json:"build_log"`
+
+	// BuildMsg 构建结果说明
+	BuildMsg string `json:"build_msg"`
+
+	// BuildStartTime 开始时间
+	BuildStartTime int64 `json:"build_start_time"`
+
+	// BuildFinishTime 结束时间
+	BuildFinishTime int64 `json:"build_finish_time"`
+}
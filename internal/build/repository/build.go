@@ -0,0 +1,100 @@
+package repository
+
+import (
+	"github.com/jinzhu/gorm"
+	"tini-paas/internal/build/model"
+	"tini-paas/pkg/common"
+)
+
+// BuildRepository 构建记录操作
+type BuildRepository interface {
+	// InitTable 初始化表
+	InitTable() error
+
+	// MigrateTable 创建缺少的构建记录表和字段，可以重复执行
+	MigrateTable() error
+
+	// CreateBuild 创建构建记录
+	CreateBuild(*model.Build) (int64, error)
+
+	// DeleteBuildByID 删除构建记录
+	DeleteBuildByID(int64) error
+
+	// UpdateBuild 更新构建记录
+	UpdateBuild(*model.Build) error
+
+	// FindBuildByID 查找构建记录
+	FindBuildByID(int64) (*model.Build, error)
+
+	// FindAll 查找所有构建记录
+	FindAll() ([]model.Build, error)
+
+	// FindAllByPodID 查找pod的构建历史
+	FindAllByPodID(int64) ([]model.Build, error)
+
+	// FindAllByStatus 查找处于指定状态的构建记录
+	FindAllByStatus(...string) ([]model.Build, error)
+}
+
+// NewBuildRepository 初始化BuildRepository
+func NewBuildRepository(db *gorm.DB) BuildRepository {
+	return &Build{
+		db: db,
+	}
+}
+
+// Build 构建记录repository
+type Build struct {
+	db *gorm.DB
+}
+
+// InitTable 初始化表
+func (b *Build) InitTable() error {
+	return b.db.CreateTable(&model.Build{}).Error
+}
+
+// MigrateTable 创建缺少的构建记录表和字段，可以重复执行
+func (b *Build) MigrateTable() error {
+	return common.MigrateTables(b.db, &model.Build{})
+}
+
+// CreateBuild 创建构建记录
+func (b *Build) CreateBuild(build *model.Build) (int64, error) {
+	// 构建任务名称依赖ID，需要在创建之后返回
+	err := b.db.Create(build).Error
+	return build.ID, err
+}
+
+// DeleteBuildByID 删除构建记录
+func (b *Build) DeleteBuildByID(i int64) error {
+	return b.db.Where("id = ?", i).Delete(&model.Build{}).Error
+}
+
+// UpdateBuild 更新构建记录
+func (b *Build) UpdateBuild(build *model.Build) error {
+	return b.db.Model(build).Update(build).Error
+}
+
+// FindBuildByID 查找构建记录
+func (b *Build) FindBuildByID(i int64) (*model.Build, error) {
+	build := &model.Build{}
+	return build, b.db.First(build, i).Error
+}
+
+// FindAll 查找所有构建记录
+func (b *Build) FindAll() ([]model.Build, error) {
+	var buildAll []model.Build
+	return buildAll, b.db.Order("id desc").Find(&buildAll).Error
+}
+
+// FindAllByPodID 查找pod的构建历史
+func (b *Build) FindAllByPodID(podID int64) ([]model.Build, error) {
+	var buildAll []model.Build
+	return buildAll, b.db.Where("build_pod_id = ?", podID).Order("id desc").Find(&buildAll).Error
+}
+
+// FindAllByStatus 查找处于指定状态的构建记录
+func (b *Build) FindAllByStatus(status ...string) ([]model.Build, error) {
+	var buildAll []model.Build
+	return buildAll, b.db.Where("build_status in (?)", status).Find(&buildAll).Error
+}
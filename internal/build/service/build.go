@@ -0,0 +1,536 @@
+package service
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	v1 "k8s.io/api/batch/v1"
+	v13 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	v12 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+	"tini-paas/internal/build/model"
+	"tini-paas/internal/build/repository"
+	"tini-paas/internal/pod/proto/pod"
+	"tini-paas/pkg/common"
+)
+
+const (
+	// gitImage 拉取源码使用的镜像
+	gitImage = "alpine/git:latest"
+
+	// kanikoImage 在集群中构建镜像使用的镜像
+	kanikoImage = "gcr.io/kaniko-project/executor:latest"
+
+	// workspace 源码目录
+	workspace = "/workspace"
+
+	// buildDeadline 单次构建的最长时间(秒)
+	buildDeadline int64 = 3600
+
+	// buildTTL 构建任务结束后在k8s中保留的时间(秒)，日志已经写入数据库
+	buildTTL int32 = 86400
+
+	// pollInterval 查询构建状态的间隔
+	pollInterval = 3 * time.Second
+)
+
+// 构建状态
+const (
+	BuildPending   = "Pending"
+	BuildRunning   = "Running"
+	BuildSucceeded = "Succeeded"
+	BuildFailed    = "Failed"
+)
+
+// BuildService 构建服务
+type BuildService interface {
+	// AddBuild 添加构建记录
+	AddBuild(*model.Build) (int64, error)
+
+	// DeleteBuild 删除构建记录
+	DeleteBuild(int64) error
+
+	// UpdateBuild 更新构建记录
+	UpdateBuild(*model.Build) error
+
+	// FindBuildByID 根据ID查找构建记录
+	FindBuildByID(int64) (*model.Build, error)
+
+	// FindAllBuild 查找全部构建记录
+	FindAllBuild() ([]model.Build, error)
+
+	// FindAllBuildByPodID 查找pod的构建历史
+	FindAllBuildByPodID(int64) ([]model.Build, error)
+
+	// CreateBuildToK8s 在k8s中创建构建任务，并在后台跟踪构建结果
+	CreateBuildToK8s(*model.Build) error
+
+	// DeleteBuildFromK8s 从k8s删除构建任务
+	DeleteBuildFromK8s(*model.Build) error
+
+	// StreamBuildLog 实时读取构建日志，每一行调用一次send
+	StreamBuildLog(context.Context, *model.Build, func(string) error) error
+
+	// ResumeBuilds 服务启动时继续跟踪没有结束的构建
+	ResumeBuilds() error
+}
+
+// NewBuildService 初始化构建服务
+func NewBuildService(buildRepository repository.BuildRepository, clientSet kubernetes.Interface, podService pod.PodService) BuildService {
+	return &BuildDataService{
+		BuildRepository: buildRepository,
+		K8sClientSet:    clientSet,
+		PodService:      podService,
+	}
+}
+
+// BuildDataService 构建数据服务
+type BuildDataService struct {
+	// BuildRepository 操作数据库接口
+	BuildRepository repository.BuildRepository
+
+	// K8sClientSet k8s客户端集合
+	K8sClientSet kubernetes.Interface
+
+	// PodService pod微服务客户端，构建成功后更新pod镜像
+	PodService pod.PodService
+}
+
+// AddBuild 添加构建记录
+func (b *BuildDataService) AddBuild(build *model.Build) (int64, error) {
+	return b.BuildRepository.CreateBuild(build)
+}
+
+// DeleteBuild 删除构建记录
+func (b *BuildDataService) DeleteBuild(i int64) error {
+	return b.BuildRepository.DeleteBuildByID(i)
+}
+
+// UpdateBuild 更新构建记录
+func (b *BuildDataService) UpdateBuild(build *model.Build) error {
+	return b.BuildRepository.UpdateBuild(build)
+}
+
+// FindBuildByID 根据ID查找构建记录
+func (b *BuildDataService) FindBuildByID(i int64) (*model.Build, error) {
+	return b.BuildRepository.FindBuildByID(i)
+}
+
+// FindAllBuild 查找全部构建记录
+func (b *BuildDataService) FindAllBuild() ([]model.Build, error) {
+	return b.BuildRepository.FindAll()
+}
+
+// FindAllBuildByPodID 查找pod的构建历史
+func (b *BuildDataService) FindAllBuildByPodID(podID int64) ([]model.Build, error) {
+	return b.BuildRepository.FindAllByPodID(podID)
+}
+
+// CreateBuildToK8s 在k8s中创建构建任务
+func (b *BuildDataService) CreateBuildToK8s(build *model.Build) error {
+	if build.BuildGitUrl == "" || build.BuildImage == "" {
+		return errors.New("构建需要指定源码仓库地址和目标镜像")
+	}
+	if build.BuildNamespace == "" {
+		build.BuildNamespace = "default"
+	}
+	if build.BuildDockerfile == "" {
+		build.BuildDockerfile = "Dockerfile"
+	}
+	build.BuildJobName = jobName(build.ID)
+
+	data, err := common.ApplyData(b.setJob(build))
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+
+	// 任务名称由构建ID决定，重复提交不会创建多个任务
+	opts, err := common.ApplyOptions(func() (v12.Object, error) {
+		return b.K8sClientSet.BatchV1().Jobs(build.BuildNamespace).Get(context.TODO(), build.BuildJobName, v12.GetOptions{})
+	})
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	_, err = b.K8sClientSet.BatchV1().Jobs(build.BuildNamespace).Patch(context.TODO(), build.BuildJobName, types.ApplyPatchType, data, opts)
+	if err != nil {
+		err = common.ApplyError("构建任务", build.BuildJobName, err)
+		common.Error(err)
+		return err
+	}
+
+	// 记录构建状态
+	build.BuildStatus = BuildRunning
+	build.BuildStartTime = time.Now().Unix()
+	err = b.BuildRepository.UpdateBuild(build)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+
+	// 后台跟踪构建结果
+	go b.watchBuild(build)
+	common.Info("构建任务 " + build.BuildJobName + " 创建成功")
+	return nil
+}
+
+// DeleteBuildFromK8s 从k8s删除构建任务
+func (b *BuildDataService) DeleteBuildFromK8s(build *model.Build) error {
+	if build.BuildJobName != "" {
+		// 同时删除任务创建的pod
+		propagation := v12.DeletePropagationBackground
+		err := b.K8sClientSet.BatchV1().Jobs(build.BuildNamespace).Delete(context.TODO(), build.BuildJobName, v12.DeleteOptions{
+			PropagationPolicy: &propagation,
+		})
+		if err != nil && !k8serrors.IsNotFound(err) {
+			common.Error(err)
+			return err
+		}
+	}
+
+	// 删除数据库记录
+	err := b.BuildRepository.DeleteBuildByID(build.ID)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	common.Info("删除构建 ID: " + strconv.FormatInt(build.ID, 10) + " 成功")
+	return nil
+}
+
+// StreamBuildLog 实时读取构建日志
+func (b *BuildDataService) StreamBuildLog(ctx context.Context, build *model.Build, send func(string) error) error {
+	// 构建已经结束，直接返回保存的日志
+	if build.BuildStatus == BuildSucceeded || build.BuildStatus == BuildFailed {
+		return sendLines(build.BuildLog, send)
+	}
+
+	// 等待构建pod启动
+	podName, err := b.waitBuildPod(ctx, build)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+
+	// 依次读取拉取源码和构建镜像的日志
+	for _, container := range []string{"git-clone", "kaniko"} {
+		err = b.followLog(ctx, build.BuildNamespace, podName, container, send)
+		if err != nil {
+			common.Error(err)
+			return err
+		}
+	}
+	return nil
+}
+
+// ResumeBuilds 服务启动时继续跟踪没有结束的构建
+// 跟踪构建的协程随服务退出，重启后需要重新跟踪，否则构建会一直处于运行状态
+func (b *BuildDataService) ResumeBuilds() error {
+	builds, err := b.BuildRepository.FindAllByStatus(BuildPending, BuildRunning)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	for i := range builds {
+		build := &builds[i]
+		if build.BuildJobName == "" {
+			// 服务可能在提交任务之后、写入数据库之前退出，按照任务名称规则查找
+			build.BuildJobName = jobName(build.ID)
+		}
+		if b.checkBuild(build) {
+			continue
+		}
+		go b.watchBuild(build)
+	}
+	return nil
+}
+
+// watchBuild 跟踪构建任务直到结束
+func (b *BuildDataService) watchBuild(build *model.Build) {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		if b.checkBuild(build) {
+			return
+		}
+	}
+}
+
+// checkBuild 检查一次构建任务的状态，任务结束时记录结果并返回true
+func (b *BuildDataService) checkBuild(build *model.Build) bool {
+	job, err := b.K8sClientSet.BatchV1().Jobs(build.BuildNamespace).Get(context.TODO(), build.BuildJobName, v12.GetOptions{})
+	if err != nil {
+		common.Error(err)
+		if k8serrors.IsNotFound(err) {
+			// 任务已经被删除或者没有提交成功
+			b.finishBuild(build, BuildFailed, "构建任务不存在，请重新构建")
+			return true
+		}
+		return false
+	}
+
+	if job.Status.Succeeded > 0 {
+		b.finishBuild(build, BuildSucceeded, "构建成功")
+		return true
+	}
+	if job.Status.Failed > 0 {
+		b.finishBuild(build, BuildFailed, jobFailedMsg(job))
+		return true
+	}
+	return false
+}
+
+// finishBuild 记录构建结果，构建成功时更新关联pod的镜像
+func (b *BuildDataService) finishBuild(build *model.Build, status, msg string) {
+	build.BuildStatus = status
+	build.BuildMsg = msg
+	build.BuildFinishTime = time.Now().Unix()
+	build.BuildLog = b.collectLog(build)
+
+	if status == BuildSucceeded && build.BuildPodID != 0 {
+		err := b.updatePodImage(build)
+		if err != nil {
+			common.Error(err)
+			build.BuildMsg = "构建成功，更新pod失败：" + err.Error()
+		} else {
+			build.BuildMsg = "构建成功，已更新pod镜像"
+		}
+	}
+
+	err := b.BuildRepository.UpdateBuild(build)
+	if err != nil {
+		common.Error(err)
+		return
+	}
+	common.Info("构建任务 " + build.BuildJobName + " 结束：" + build.BuildMsg)
+}
+
+// updatePodImage 将构建好的镜像更新到pod
+func (b *BuildDataService) updatePodImage(build *model.Build) error {
+	if b.PodService == nil {
+		return errors.New("未配置pod服务")
+	}
+
+	podInfo, err := b.PodService.FindPodByID(context.TODO(), &pod.PodID{Id: build.BuildPodID})
+	if err != nil {
+		return err
+	}
+
+	// 只替换镜像，其它配置保持不变
+	podInfo.PodImage = build.BuildImage
+	_, err = b.PodService.UpdatePod(context.TODO(), podInfo)
+	return err
+}
+
+// collectLog 收集构建pod的全部日志
+func (b *BuildDataService) collectLog(build *model.Build) string {
+	pods, err := b.K8sClientSet.CoreV1().Pods(build.BuildNamespace).List(context.TODO(), v12.ListOptions{
+		LabelSelector: "job-name=" + build.BuildJobName,
+	})
+	if err != nil || len(pods.Items) == 0 {
+		return build.BuildLog
+	}
+
+	var log strings.Builder
+	podName := pods.Items[len(pods.Items)-1].Name
+	for _, container := range []string{"git-clone", "kaniko"} {
+		_ = b.followLog(context.TODO(), build.BuildNamespace, podName, container, func(line string) error {
+			log.WriteString(line + "\n")
+			return nil
+		})
+	}
+	return log.String()
+}
+
+// waitBuildPod 等待构建pod启动，返回pod名称
+func (b *BuildDataService) waitBuildPod(ctx context.Context, build *model.Build) (string, error) {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		pods, err := b.K8sClientSet.CoreV1().Pods(build.BuildNamespace).List(ctx, v12.ListOptions{
+			LabelSelector: "job-name=" + build.BuildJobName,
+		})
+		if err != nil {
+			return "", err
+		}
+		for _, p := range pods.Items {
+			// 拉取源码的容器已经开始运行或者结束
+			for _, status := range p.Status.InitContainerStatuses {
+				if status.State.Running != nil || status.State.Terminated != nil {
+					return p.Name, nil
+				}
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return "", ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// followLog 持续读取容器日志直到容器结束
+func (b *BuildDataService) followLog(ctx context.Context, namespace, podName, container string, send func(string) error) error {
+	stream, err := b.K8sClientSet.CoreV1().Pods(namespace).GetLogs(podName, &v13.PodLogOptions{
+		Container: container,
+		Follow:    true,
+	}).Stream(ctx)
+	if err != nil {
+		return err
+	}
+	defer stream.Close()
+
+	scanner := bufio.NewScanner(stream)
+	for scanner.Scan() {
+		err = send(scanner.Text())
+		if err != nil {
+			return err
+		}
+	}
+	return scanner.Err()
+}
+
+// setJob 设置构建任务
+// 先用git容器拉取源码到共享目录，再由kaniko在集群内构建并推送镜像
+func (b *BuildDataService) setJob(build *model.Build) *v1.Job {
+	job := &v1.Job{}
+
+	job.TypeMeta = v12.TypeMeta{
+		Kind:       "Job",
+		APIVersion: "batch/v1",
+	}
+
+	job.ObjectMeta = v12.ObjectMeta{
+		Name:      build.BuildJobName,
+		Namespace: build.BuildNamespace,
+		Labels: map[string]string{
+			"app-name": build.BuildJobName,
+			"build-id": strconv.FormatInt(build.ID, 10),
+		},
+	}
+
+	// 构建失败不重试，由用户重新提交
+	backoffLimit := int32(0)
+	deadline := buildDeadline
+	ttl := buildTTL
+	job.Spec = v1.JobSpec{
+		BackoffLimit:            &backoffLimit,
+		ActiveDeadlineSeconds:   &deadline,
+		TTLSecondsAfterFinished: &ttl,
+		Template: v13.PodTemplateSpec{
+			ObjectMeta: v12.ObjectMeta{
+				Labels: map[string]string{
+					"app-name": build.BuildJobName,
+					"build-id": strconv.FormatInt(build.ID, 10),
+				},
+			},
+			Spec: v13.PodSpec{
+				RestartPolicy: v13.RestartPolicyNever,
+				InitContainers: []v13.Container{
+					{
+						Name:  "git-clone",
+						Image: gitImage,
+						// 仓库地址和分支通过环境变量传入，避免拼接命令
+						Command: []string{"sh", "-c", `git clone "$GIT_URL" ` + workspace + ` && cd ` + workspace + ` && if [ -n "$GIT_REF" ]; then git checkout "$GIT_REF"; fi`},
+						Env: []v13.EnvVar{
+							{Name: "GIT_URL", Value: build.BuildGitUrl},
+							{Name: "GIT_REF", Value: build.BuildGitRef},
+						},
+						VolumeMounts: []v13.VolumeMount{
+							{Name: "workspace", MountPath: workspace},
+						},
+					},
+				},
+				Containers: []v13.Container{
+					{
+						Name:  "kaniko",
+						Image: kanikoImage,
+						Args: []string{
+							"--dockerfile=" + path.Join(workspace, build.BuildDockerfile),
+							"--context=dir://" + workspace,
+							"--destination=" + build.BuildImage,
+						},
+						VolumeMounts: b.getMounts(build),
+					},
+				},
+				Volumes: b.getVolumes(build),
+			},
+		},
+	}
+	return job
+}
+
+// getMounts 设置构建容器的挂载目录
+func (b *BuildDataService) getMounts(build *model.Build) []v13.VolumeMount {
+	mounts := []v13.VolumeMount{
+		{Name: "workspace", MountPath: workspace},
+	}
+	if build.BuildRegistrySecret != "" {
+		// kaniko 从 /kaniko/.docker/config.json 读取仓库认证信息
+		mounts = append(mounts, v13.VolumeMount{Name: "docker-config", MountPath: "/kaniko/.docker"})
+	}
+	return mounts
+}
+
+// getVolumes 设置构建pod的存储
+func (b *BuildDataService) getVolumes(build *model.Build) []v13.Volume {
+	volumes := []v13.Volume{
+		{
+			Name: "workspace",
+			VolumeSource: v13.VolumeSource{
+				EmptyDir: &v13.EmptyDirVolumeSource{},
+			},
+		},
+	}
+	if build.BuildRegistrySecret != "" {
+		volumes = append(volumes, v13.Volume{
+			Name: "docker-config",
+			VolumeSource: v13.VolumeSource{
+				Secret: &v13.SecretVolumeSource{
+					SecretName: build.BuildRegistrySecret,
+					Items: []v13.KeyToPath{
+						{Key: v13.DockerConfigJsonKey, Path: "config.json"},
+					},
+				},
+			},
+		})
+	}
+	return volumes
+}
+
+// jobName 构建任务名称，由构建ID决定
+func jobName(id int64) string {
+	return "build-" + strconv.FormatInt(id, 10)
+}
+
+// jobFailedMsg 获取任务失败原因
+func jobFailedMsg(job *v1.Job) string {
+	for _, condition := range job.Status.Conditions {
+		if condition.Type == v1.JobFailed {
+			return "构建失败：" + condition.Reason + " " + condition.Message
+		}
+	}
+	return "构建失败"
+}
+
+// sendLines 按行发送日志
+func sendLines(log string, send func(string) error) error {
+	scanner := bufio.NewScanner(strings.NewReader(log))
+	for scanner.Scan() {
+		err := send(scanner.Text())
+		if err != nil {
+			return err
+		}
+	}
+	return scanner.Err()
+}
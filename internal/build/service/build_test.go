@@ -0,0 +1,294 @@
+package service
+
+import (
+	"context"
+	"errors"
+	v1 "k8s.io/api/batch/v1"
+	v13 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	v12 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	"path/filepath"
+	"sort"
+	"testing"
+	"tini-paas/internal/build/model"
+	"tini-paas/pkg/common"
+)
+
+// memoryRepository 内存中的构建记录，代替数据库
+type memoryRepository struct {
+	builds map[int64]*model.Build
+}
+
+func newMemoryRepository(builds ...model.Build) *memoryRepository {
+	r := &memoryRepository{builds: map[int64]*model.Build{}}
+	for i := range builds {
+		build := builds[i]
+		r.builds[build.ID] = &build
+	}
+	return r
+}
+
+func (r *memoryRepository) InitTable() error {
+	return nil
+}
+
+func (r *memoryRepository) MigrateTable() error {
+	return nil
+}
+
+func (r *memoryRepository) CreateBuild(build *model.Build) (int64, error) {
+	build.ID = int64(len(r.builds) + 1)
+	copied := *build
+	r.builds[build.ID] = &copied
+	return build.ID, nil
+}
+
+func (r *memoryRepository) DeleteBuildByID(id int64) error {
+	delete(r.builds, id)
+	return nil
+}
+
+func (r *memoryRepository) UpdateBuild(build *model.Build) error {
+	if _, ok := r.builds[build.ID]; !ok {
+		return errors.New("record not found")
+	}
+	copied := *build
+	r.builds[build.ID] = &copied
+	return nil
+}
+
+func (r *memoryRepository) FindBuildByID(id int64) (*model.Build, error) {
+	build, ok := r.builds[id]
+	if !ok {
+		return nil, errors.New("record not found")
+	}
+	copied := *build
+	return &copied, nil
+}
+
+func (r *memoryRepository) FindAll() ([]model.Build, error) {
+	var result []model.Build
+	for _, build := range r.builds {
+		result = append(result, *build)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].ID > result[j].ID })
+	return result, nil
+}
+
+func (r *memoryRepository) FindAllByPodID(podID int64) ([]model.Build, error) {
+	var result []model.Build
+	for _, build := range r.builds {
+		if build.BuildPodID == podID {
+			result = append(result, *build)
+		}
+	}
+	return result, nil
+}
+
+func (r *memoryRepository) FindAllByStatus(status ...string) ([]model.Build, error) {
+	var result []model.Build
+	for _, build := range r.builds {
+		for _, s := range status {
+			if build.BuildStatus == s {
+				result = append(result, *build)
+			}
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].ID < result[j].ID })
+	return result, nil
+}
+
+// newJob 构建任务，status 为任务状态
+func newJob(name string, status v1.JobStatus) *v1.Job {
+	return &v1.Job{
+		ObjectMeta: v12.ObjectMeta{Name: name, Namespace: "default"},
+		Status:     status,
+	}
+}
+
+// newTestService 日志写到测试的临时目录
+func newTestService(t *testing.T, repo *memoryRepository, objects ...runtime.Object) *BuildDataService {
+	common.SetLogFile(filepath.Join(t.TempDir(), "micro.log"))
+	return &BuildDataService{
+		BuildRepository: repo,
+		K8sClientSet:    fake.NewSimpleClientset(objects...),
+	}
+}
+
+func TestSetJob(t *testing.T) {
+	b := &BuildDataService{}
+	job := b.setJob(&model.Build{
+		ID:                  7,
+		BuildNamespace:      "ci",
+		BuildGitUrl:         "https://example.com/repo.git",
+		BuildGitRef:         "v1.0.0",
+		BuildDockerfile:     "build/Dockerfile",
+		BuildImage:          "registry.example.com/app:v1.0.0",
+		BuildRegistrySecret: "registry",
+		BuildJobName:        jobName(7),
+	})
+
+	if job.Name != "build-7" || job.Namespace != "ci" {
+		t.Fatalf("job = %s/%s, want ci/build-7", job.Namespace, job.Name)
+	}
+	if *job.Spec.BackoffLimit != 0 {
+		t.Errorf("backoffLimit = %d, want 0", *job.Spec.BackoffLimit)
+	}
+
+	spec := job.Spec.Template.Spec
+	env := map[string]string{}
+	for _, e := range spec.InitContainers[0].Env {
+		env[e.Name] = e.Value
+	}
+	if env["GIT_URL"] != "https://example.com/repo.git" || env["GIT_REF"] != "v1.0.0" {
+		t.Errorf("git env = %v", env)
+	}
+
+	args := spec.Containers[0].Args
+	want := []string{
+		"--dockerfile=/workspace/build/Dockerfile",
+		"--context=dir:///workspace",
+		"--destination=registry.example.com/app:v1.0.0",
+	}
+	if len(args) != len(want) {
+		t.Fatalf("kaniko args = %v, want %v", args, want)
+	}
+	for i := range want {
+		if args[i] != want[i] {
+			t.Errorf("kaniko args[%d] = %s, want %s", i, args[i], want[i])
+		}
+	}
+
+	if len(spec.Volumes) != 2 || spec.Volumes[1].Secret == nil || spec.Volumes[1].Secret.SecretName != "registry" {
+		t.Errorf("registry secret volume missing: %+v", spec.Volumes)
+	}
+	if len(spec.Containers[0].VolumeMounts) != 2 {
+		t.Errorf("kaniko mounts = %+v, want workspace and docker config", spec.Containers[0].VolumeMounts)
+	}
+}
+
+func TestCreateBuildToK8sRequiresSource(t *testing.T) {
+	b := newTestService(t, newMemoryRepository())
+	err := b.CreateBuildToK8s(&model.Build{ID: 1, BuildImage: "app:latest"})
+	if err == nil {
+		t.Fatal("expected error without git url")
+	}
+}
+
+func TestCheckBuild(t *testing.T) {
+	failed := v1.JobStatus{
+		Failed: 1,
+		Conditions: []v1.JobCondition{
+			{Type: v1.JobFailed, Status: v13.ConditionTrue, Reason: "BackoffLimitExceeded", Message: "Job has reached the specified backoff limit"},
+		},
+	}
+	tests := []struct {
+		name       string
+		job        *v1.Job
+		wantDone   bool
+		wantStatus string
+		wantMsg    string
+	}{
+		{"running", newJob("build-1", v1.JobStatus{Active: 1}), false, BuildRunning, ""},
+		{"succeeded", newJob("build-1", v1.JobStatus{Succeeded: 1}), true, BuildSucceeded, "构建成功"},
+		{"failed", newJob("build-1", failed), true, BuildFailed, "构建失败：BackoffLimitExceeded Job has reached the specified backoff limit"},
+		{"missing", nil, true, BuildFailed, "构建任务不存在，请重新构建"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			build := model.Build{ID: 1, BuildNamespace: "default", BuildJobName: "build-1", BuildStatus: BuildRunning}
+			repo := newMemoryRepository(build)
+			var objects []runtime.Object
+			if tt.job != nil {
+				objects = append(objects, tt.job)
+			}
+			b := newTestService(t, repo, objects...)
+
+			done := b.checkBuild(&build)
+			if done != tt.wantDone {
+				t.Fatalf("checkBuild = %v, want %v", done, tt.wantDone)
+			}
+			saved := repo.builds[1]
+			if saved.BuildStatus != tt.wantStatus {
+				t.Errorf("status = %s, want %s", saved.BuildStatus, tt.wantStatus)
+			}
+			if saved.BuildMsg != tt.wantMsg {
+				t.Errorf("msg = %q, want %q", saved.BuildMsg, tt.wantMsg)
+			}
+			if tt.wantDone && saved.BuildFinishTime == 0 {
+				t.Error("finish time not recorded")
+			}
+		})
+	}
+}
+
+func TestResumeBuilds(t *testing.T) {
+	repo := newMemoryRepository(
+		// 服务重启前已经结束的任务
+		model.Build{ID: 1, BuildNamespace: "default", BuildJobName: "build-1", BuildStatus: BuildRunning},
+		// 提交了任务，但是任务名称没有写入数据库
+		model.Build{ID: 2, BuildNamespace: "default", BuildStatus: BuildPending},
+		// 没有提交任务
+		model.Build{ID: 3, BuildNamespace: "default", BuildStatus: BuildPending},
+		// 已经结束的构建不处理
+		model.Build{ID: 4, BuildNamespace: "default", BuildJobName: "build-4", BuildStatus: BuildSucceeded, BuildMsg: "构建成功，已更新pod镜像"},
+	)
+	b := newTestService(t, repo,
+		newJob("build-1", v1.JobStatus{Succeeded: 1}),
+		newJob("build-2", v1.JobStatus{Failed: 1}),
+	)
+
+	err := b.ResumeBuilds()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[int64]string{
+		1: BuildSucceeded,
+		2: BuildFailed,
+		3: BuildFailed,
+		4: BuildSucceeded,
+	}
+	for id, status := range want {
+		if repo.builds[id].BuildStatus != status {
+			t.Errorf("build %d status = %s, want %s", id, repo.builds[id].BuildStatus, status)
+		}
+	}
+	if repo.builds[2].BuildJobName != "build-2" {
+		t.Errorf("build 2 job name = %q, want build-2", repo.builds[2].BuildJobName)
+	}
+	if repo.builds[4].BuildMsg != "构建成功，已更新pod镜像" {
+		t.Errorf("finished build was modified: %+v", repo.builds[4])
+	}
+}
+
+func TestDeleteBuildFromK8s(t *testing.T) {
+	build := model.Build{ID: 1, BuildNamespace: "default", BuildJobName: "build-1", BuildStatus: BuildSucceeded}
+	repo := newMemoryRepository(build)
+	b := newTestService(t, repo, newJob("build-1", v1.JobStatus{Succeeded: 1}))
+
+	err := b.DeleteBuildFromK8s(&build)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = b.K8sClientSet.BatchV1().Jobs("default").Get(context.TODO(), "build-1", v12.GetOptions{})
+	if !k8serrors.IsNotFound(err) {
+		t.Errorf("job still exists: %v", err)
+	}
+	if _, ok := repo.builds[1]; ok {
+		t.Error("build record not deleted")
+	}
+
+	// 任务已经不存在时只删除记录
+	repo = newMemoryRepository(build)
+	b = newTestService(t, repo)
+	err = b.DeleteBuildFromK8s(&build)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := repo.builds[1]; ok {
+		t.Error("build record not deleted")
+	}
+}
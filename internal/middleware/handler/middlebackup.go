@@ -0,0 +1,184 @@
+package handler
+
+import (
+	"context"
+	"google.golang.org/protobuf/proto"
+	"strconv"
+	"tini-paas/internal/middleware/model"
+	"tini-paas/internal/middleware/proto/middleware"
+	"tini-paas/pkg/common"
+)
+
+// SetBackupPolicy 设置中间件的定时备份策略
+func (m *MiddlewareHandler) SetBackupPolicy(ctx context.Context, req *middleware.MiddleBackupPolicy, rsp *middleware.Response) error {
+	info, err := m.getMiddlewareInfo(req.MiddleId)
+	if err != nil {
+		common.Error(err)
+		rsp.Msg = err.Error()
+		return err
+	}
+
+	policy := &model.MiddleBackupPolicy{}
+	err = common.SwapTo(req, policy)
+	if err != nil {
+		common.Error(err)
+		rsp.Msg = err.Error()
+		return err
+	}
+
+	err = m.MiddleBackupService.SetBackupPolicy(info, policy)
+	if err != nil {
+		common.Error(err)
+		rsp.Msg = err.Error()
+		return err
+	}
+	rsp.Msg = "中间件 " + info.MiddleName + " 的备份策略设置成功"
+	common.Info(rsp.Msg)
+	return nil
+}
+
+// FindBackupPolicy 查找中间件的备份策略
+func (m *MiddlewareHandler) FindBackupPolicy(ctx context.Context, req *middleware.MiddlewareID, rsp *middleware.MiddleBackupPolicy) error {
+	policy, err := m.MiddleBackupService.FindBackupPolicy(req.Id)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+
+	err = common.SwapTo(policy, rsp)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	return nil
+}
+
+// DeleteBackupPolicy 删除中间件的备份策略，已有的备份保留
+func (m *MiddlewareHandler) DeleteBackupPolicy(ctx context.Context, req *middleware.MiddlewareID, rsp *middleware.Response) error {
+	middleModel, err := m.MiddlewareService.FindMiddlewareByID(req.Id)
+	if err != nil {
+		common.Error(err)
+		rsp.Msg = err.Error()
+		return err
+	}
+
+	err = m.MiddleBackupService.DeleteBackupPolicy(middleModel)
+	if err != nil {
+		common.Error(err)
+		rsp.Msg = err.Error()
+		return err
+	}
+	rsp.Msg = "中间件 " + middleModel.MiddleName + " 的备份策略已删除"
+	return nil
+}
+
+// ListBackups 查找中间件的备份记录
+func (m *MiddlewareHandler) ListBackups(ctx context.Context, req *middleware.MiddlewareID, rsp *middleware.AllMiddleBackup) error {
+	info, err := m.getMiddlewareInfo(req.Id)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+
+	backups, err := m.MiddleBackupService.ListBackups(info)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+
+	for _, backup := range backups {
+		backupInfo := &middleware.MiddleBackup{}
+		err = common.SwapTo(backup, backupInfo)
+		if err != nil {
+			common.Error(err)
+			return err
+		}
+		rsp.MiddleBackup = append(rsp.MiddleBackup, backupInfo)
+	}
+	return nil
+}
+
+// RestoreMiddleware 从备份恢复中间件
+// into_new_instance 为true时创建一个新的中间件 <名称>-r<备份ID> 并恢复到其中，原中间件不受影响
+func (m *MiddlewareHandler) RestoreMiddleware(ctx context.Context, req *middleware.RestoreRequest, rsp *middleware.Response) error {
+	backup, err := m.MiddleBackupService.FindBackupByID(req.BackupId)
+	if err != nil {
+		common.Error(err)
+		rsp.Msg = err.Error()
+		return err
+	}
+
+	source, err := m.getMiddlewareInfo(backup.MiddleID)
+	if err != nil {
+		common.Error(err)
+		rsp.Msg = err.Error()
+		return err
+	}
+
+	target := source
+	if req.IntoNewInstance {
+		target, err = m.cloneMiddleware(ctx, source, source.MiddleName+"-r"+strconv.FormatInt(backup.ID, 10))
+		if err != nil {
+			common.Error(err)
+			rsp.Msg = err.Error()
+			return err
+		}
+	}
+
+	jobName, err := m.MiddleBackupService.RestoreMiddleware(source, target, backup)
+	if err != nil {
+		common.Error(err)
+		rsp.Msg = err.Error()
+		return err
+	}
+	rsp.Msg = "正在将备份 " + backup.BackupName + " 恢复到中间件 " + target.MiddleName + "，恢复任务：" + jobName
+	common.Info(rsp.Msg)
+	return nil
+}
+
+// ResumeRestores 服务启动时继续执行未完成的恢复
+func (m *MiddlewareHandler) ResumeRestores() error {
+	return m.MiddleBackupService.ResumeRestores(m.getMiddlewareInfo)
+}
+
+// cloneMiddleware 按照source的配置创建新的中间件
+// 使用相同的账号密码，恢复包含用户表的备份后仍然可以用Secret中的密码登录
+func (m *MiddlewareHandler) cloneMiddleware(ctx context.Context, source *middleware.MiddlewareInfo, name string) (*middleware.MiddlewareInfo, error) {
+	credentials, err := m.MiddlewareService.GetCredentials(source.MiddleNamespace, source.MiddleName)
+	if err != nil {
+		return nil, err
+	}
+
+	target := proto.Clone(source).(*middleware.MiddlewareInfo)
+	target.Id = 0
+	target.MiddleName = name
+	target.MiddleConfig = credentials
+	target.MiddleConfig.MiddleConfigSecretName = ""
+
+	err = m.AddMiddleware(ctx, target, &middleware.Response{})
+	if err != nil {
+		return nil, err
+	}
+	return target, nil
+}
+
+// getMiddlewareInfo 查找中间件的完整信息，包括镜像和类型名称
+func (m *MiddlewareHandler) getMiddlewareInfo(id int64) (*middleware.MiddlewareInfo, error) {
+	middleModel, err := m.MiddlewareService.FindMiddlewareDetailByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	info := &middleware.MiddlewareInfo{}
+	err = common.SwapTo(middleModel, info)
+	if err != nil {
+		return nil, err
+	}
+	info.Id = middleModel.ID
+
+	info.MiddleDockerImageVersion, err = m.MiddleTypeService.FindImageVersionByID(info.MiddleVersionId)
+	if err != nil {
+		return nil, err
+	}
+	return info, m.setTypeName(info)
+}
@@ -2,13 +2,21 @@ package handler
 
 import (
 	"context"
+	"errors"
+	microErrors "github.com/asim/go-micro/v3/errors"
+	"github.com/jinzhu/gorm"
 	"strconv"
 	"tini-paas/internal/middleware/model"
 	"tini-paas/internal/middleware/proto/middleware"
 	"tini-paas/internal/middleware/service"
+	"tini-paas/internal/middleware/template"
+	"tini-paas/internal/user/proto/user"
 	"tini-paas/pkg/common"
 )
 
+// credentialsAction 查看中间件账号密码需要的权限
+const credentialsAction = "middleware:credentials"
+
 // MiddlewareHandler 中间件处理接口(对API后端接口方法的实现)
 type MiddlewareHandler struct {
 	// MiddlewareService 中间件操作接口
@@ -16,16 +24,23 @@ type MiddlewareHandler struct {
 
 	// MiddleTypeService 中间件类型接口
 	MiddleTypeService service.MiddleTypeService
+
+	// UserService 用户服务，校验查看账号密码的权限
+	UserService user.UserService
+
+	// MiddleBackupService 中间件备份接口
+	MiddleBackupService service.MiddleBackupService
 }
 
 func (m *MiddlewareHandler) AddMiddleware(ctx context.Context, info *middleware.MiddlewareInfo, response *middleware.Response) error {
-	middleModel := &model.Middleware{}
-
-	// 将info信息映射到middleModel
-	err := common.SwapTo(info, middleModel)
-	if err != nil {
+	// 同一命名空间内名称唯一，避免覆盖k8s中已经存在的资源
+	// 查询出错时不能当作不存在，否则数据库异常时会绕过检查
+	_, err := m.MiddlewareService.FindMiddlewareByNamespaceAndName(info.MiddleNamespace, info.MiddleName)
+	if err == nil {
+		err = errors.New("中间件 " + info.MiddleNamespace + "/" + info.MiddleName + " 已经存在")
+	}
+	if !gorm.IsRecordNotFoundError(err) {
 		common.Error(err)
-		response.Msg = err.Error()
 		return err
 	}
 
@@ -38,6 +53,38 @@ func (m *MiddlewareHandler) AddMiddleware(ctx context.Context, info *middleware.
 	}
 	info.MiddleDockerImageVersion = imageAddress
 
+	// 根据类型生成账号密码对应的环境变量
+	err = m.setTypeName(info)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+
+	// 根据类型模板和规格补全端口、资源和存储
+	err = applyTemplate(info)
+	if err != nil {
+		common.Error(err)
+		response.Msg = err.Error()
+		return err
+	}
+
+	// 检查存储类，未指定时使用默认存储类
+	err = m.MiddlewareService.CheckStorageClass(info)
+	if err != nil {
+		common.Error(err)
+		response.Msg = err.Error()
+		return err
+	}
+
+	// 将补全后的info信息映射到middleModel
+	middleModel := &model.Middleware{}
+	err = common.SwapTo(info, middleModel)
+	if err != nil {
+		common.Error(err)
+		response.Msg = err.Error()
+		return err
+	}
+
 	// 在k8s中创建资源
 	err = m.MiddlewareService.CreateToK8s(info)
 	if err != nil {
@@ -45,6 +92,7 @@ func (m *MiddlewareHandler) AddMiddleware(ctx context.Context, info *middleware.
 		response.Msg = err.Error()
 		return err
 	}
+	clearPasswords(middleModel, info)
 
 	// 创建成功，写入数据库
 	middleID, err := m.MiddlewareService.AddMiddleware(middleModel)
@@ -53,6 +101,7 @@ func (m *MiddlewareHandler) AddMiddleware(ctx context.Context, info *middleware.
 		response.Msg = err.Error()
 		return err
 	}
+	info.Id = middleID
 
 	response.Msg = "中间件创建成功，ID为：" + strconv.FormatInt(middleID, 10)
 	common.Info(response.Msg)
@@ -76,19 +125,46 @@ func (m *MiddlewareHandler) DeleteMiddleware(ctx context.Context, id *middleware
 		return err
 	}
 
+	// 删除定时备份任务，已有的备份文件保留
+	err = m.MiddleBackupService.DeleteBackupPolicy(middleModel)
+	if err != nil {
+		common.Error(err)
+		response.Msg = err.Error()
+		return err
+	}
+
 	return nil
 }
 
 func (m *MiddlewareHandler) UpdateMiddleware(ctx context.Context, info *middleware.MiddlewareInfo, response *middleware.Response) error {
-	err := m.MiddlewareService.UpdateToK8s(info)
+	// 查询中间件信息
+	middleModel, err := m.MiddlewareService.FindMiddlewareByID(info.Id)
+	if err != nil {
+		common.Error(err)
+		response.Msg = err.Error()
+		return err
+	}
+	err = service.CheckUpdatable(middleModel.MiddleName, middleModel.MiddleUpgradeStatus)
 	if err != nil {
 		common.Error(err)
 		response.Msg = err.Error()
 		return err
 	}
 
-	// 查询中间件信息
-	middleModel, err := m.MiddlewareService.FindMiddlewareByID(info.Id)
+	err = m.setTypeName(info)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+
+	err = applyTemplate(info)
+	if err != nil {
+		common.Error(err)
+		response.Msg = err.Error()
+		return err
+	}
+
+	err = m.MiddlewareService.UpdateToK8s(info)
 	if err != nil {
 		common.Error(err)
 		response.Msg = err.Error()
@@ -102,6 +178,7 @@ func (m *MiddlewareHandler) UpdateMiddleware(ctx context.Context, info *middlewa
 		response.Msg = err.Error()
 		return err
 	}
+	clearPasswords(middleModel, info)
 
 	// 更新数据库信息
 	err = m.MiddlewareService.UpdateMiddleware(middleModel)
@@ -111,11 +188,19 @@ func (m *MiddlewareHandler) UpdateMiddleware(ctx context.Context, info *middlewa
 		return err
 	}
 
+	// 为0的副本数不会通过json和 Update 写入，单独保存，与k8s中的副本数一致
+	err = m.MiddlewareService.UpdateMiddlewareReplicas(info.Id, info.MiddleReplicas)
+	if err != nil {
+		common.Error(err)
+		response.Msg = err.Error()
+		return err
+	}
 	return nil
 }
 
+// FindMiddlewareByID 根据ID查找中间件，包含端口、账号、环境变量和存储
 func (m *MiddlewareHandler) FindMiddlewareByID(ctx context.Context, id *middleware.MiddlewareID, info *middleware.MiddlewareInfo) error {
-	middleModel, err := m.MiddlewareService.FindMiddlewareByID(id.Id)
+	middleModel, err := m.MiddlewareService.FindMiddlewareDetailByID(id.Id)
 	if err != nil {
 		common.Error(err)
 		return err
@@ -127,6 +212,8 @@ func (m *MiddlewareHandler) FindMiddlewareByID(ctx context.Context, id *middlewa
 		common.Error(err)
 		return err
 	}
+	info.Id = middleModel.ID
+	hidePasswords(info)
 
 	return nil
 }
@@ -278,3 +365,215 @@ func (m *MiddlewareHandler) FindAllMiddleType(ctx context.Context, all *middlewa
 	}
 	return nil
 }
+
+// FindMiddlewareByNamespaceAndName 根据命名空间和名称查找
+func (m *MiddlewareHandler) FindMiddlewareByNamespaceAndName(ctx context.Context, req *middleware.MiddlewareNamespaceName, info *middleware.MiddlewareInfo) error {
+	middleModel, err := m.MiddlewareService.FindMiddlewareByNamespaceAndName(req.Namespace, req.Name)
+	if err != nil {
+		common.Error(err)
+		if gorm.IsRecordNotFoundError(err) {
+			return microErrors.NotFound("go.micro.service.middleware", "middleware %s/%s 不存在", req.Namespace, req.Name)
+		}
+		return err
+	}
+	middleModel, err = m.MiddlewareService.FindMiddlewareDetailByID(middleModel.ID)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+
+	// 数据转换
+	err = common.SwapTo(middleModel, info)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	info.Id = middleModel.ID
+	hidePasswords(info)
+	return nil
+}
+
+// GetMiddlewareCredentials 获取中间件的账号密码，只返回给有权限的用户
+// 用户身份来自调用方携带的登录令牌，不接受请求参数中的用户ID
+func (m *MiddlewareHandler) GetMiddlewareCredentials(ctx context.Context, req *middleware.CredentialsRequest, config *middleware.MiddleConfig) error {
+	if m.UserService == nil {
+		return errors.New("未配置用户服务，无法鉴权")
+	}
+
+	userID, err := common.UserIDFromContext(ctx)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+
+	// 鉴权
+	right, err := m.UserService.IsRight(ctx, &user.UserRight{
+		UserId: userID,
+		Action: credentialsAction,
+	})
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	if !right.Access {
+		return errors.New("用户 " + strconv.FormatInt(userID, 10) + " 没有查看中间件账号密码的权限")
+	}
+
+	middleModel, err := m.MiddlewareService.FindMiddlewareByID(req.MiddlewareId)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+
+	// 账号密码从Secret中读取
+	credentials, err := m.MiddlewareService.GetCredentials(middleModel.MiddleNamespace, middleModel.MiddleName)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	config.MiddleId = middleModel.ID
+	config.MiddleConfigRootUser = credentials.MiddleConfigRootUser
+	config.MiddleConfigRootPwd = credentials.MiddleConfigRootPwd
+	config.MiddleConfigUser = credentials.MiddleConfigUser
+	config.MiddleConfigPwd = credentials.MiddleConfigPwd
+	config.MiddleConfigDataBase = credentials.MiddleConfigDataBase
+	config.MiddleConfigSecretName = credentials.MiddleConfigSecretName
+	common.Info("用户 " + strconv.FormatInt(userID, 10) + " 查看了中间件 " + middleModel.MiddleName + " 的账号密码")
+	return nil
+}
+
+// ResizeMiddlewareStorage 在线扩容中间件的存储
+func (m *MiddlewareHandler) ResizeMiddlewareStorage(ctx context.Context, req *middleware.MiddleStorageResize, response *middleware.Response) error {
+	middleModel, err := m.MiddlewareService.FindMiddlewareDetailByID(req.MiddleId)
+	if err != nil {
+		common.Error(err)
+		response.Msg = err.Error()
+		return err
+	}
+
+	err = m.MiddlewareService.ResizeStorage(middleModel, req.MiddleStorageName, req.MiddleStorageSize)
+	if err != nil {
+		common.Error(err)
+		response.Msg = err.Error()
+		return err
+	}
+	response.Msg = "中间件 " + middleModel.MiddleName + " 的存储 " + req.MiddleStorageName + " 正在扩容"
+	common.Info(response.Msg)
+	return nil
+}
+
+// ScaleMiddleware 调整中间件副本数，为0时停止中间件
+// 通过平台的应用方式修改副本数，避免与之后的更新产生字段冲突；调整后的副本数写入数据库
+func (m *MiddlewareHandler) ScaleMiddleware(ctx context.Context, req *middleware.MiddleScale, response *middleware.Response) error {
+	if req.MiddleReplicas < 0 {
+		err := errors.New("副本数不能小于0")
+		common.Error(err)
+		response.Msg = err.Error()
+		return err
+	}
+
+	info, err := m.getMiddlewareInfo(req.MiddleId)
+	if err != nil {
+		common.Error(err)
+		response.Msg = err.Error()
+		return err
+	}
+	err = service.CheckUpdatable(info.MiddleName, info.MiddleUpgradeStatus)
+	if err != nil {
+		common.Error(err)
+		response.Msg = err.Error()
+		return err
+	}
+
+	info.MiddleReplicas = req.MiddleReplicas
+	err = m.MiddlewareService.UpdateToK8s(info)
+	if err != nil {
+		common.Error(err)
+		response.Msg = err.Error()
+		return err
+	}
+
+	// 停止时也写入数据库，之后直接更新中间件不会重新启动
+	err = m.MiddlewareService.UpdateMiddlewareReplicas(req.MiddleId, req.MiddleReplicas)
+	if err != nil {
+		common.Error(err)
+		response.Msg = err.Error()
+		return err
+	}
+	response.Msg = "中间件 " + info.MiddleName + " 的副本数调整为 " + strconv.Itoa(int(req.MiddleReplicas))
+	common.Info(response.Msg)
+	return nil
+}
+
+// setTypeName 根据类型ID设置中间件类型名称
+// 没有填写类型ID时使用版本所属的类型，填写了类型ID时检查版本是否属于该类型
+func (m *MiddlewareHandler) setTypeName(info *middleware.MiddlewareInfo) error {
+	if info.MiddleVersionId != 0 {
+		version, err := m.MiddleTypeService.FindVersionByID(info.MiddleVersionId)
+		if err != nil {
+			return err
+		}
+		if info.MiddleTypeId == 0 {
+			info.MiddleTypeId = version.MiddleTypeID
+		}
+		if version.MiddleTypeID != info.MiddleTypeId {
+			return errors.New("版本 " + strconv.FormatInt(info.MiddleVersionId, 10) + " 不属于中间件类型 " + strconv.FormatInt(info.MiddleTypeId, 10))
+		}
+	}
+	middleType, err := m.MiddleTypeService.FindMiddleTypeByID(info.MiddleTypeId)
+	if err != nil {
+		return err
+	}
+	info.MiddleTypeName = middleType.MiddleTypeName
+	return nil
+}
+
+// applyTemplate 使用类型模板补全中间件信息，没有模板的类型保持不变
+func applyTemplate(info *middleware.MiddlewareInfo) error {
+	tpl, ok := template.Get(info.MiddleTypeName)
+	if !ok {
+		return nil
+	}
+	return tpl.Apply(info)
+}
+
+// clearPasswords 密码只保存在Secret中，写入数据库前清除
+func clearPasswords(middleModel *model.Middleware, info *middleware.MiddlewareInfo) {
+	if info.MiddleConfig != nil {
+		middleModel.MiddleConfig.MiddleConfigRootUser = info.MiddleConfig.MiddleConfigRootUser
+		middleModel.MiddleConfig.MiddleConfigUser = info.MiddleConfig.MiddleConfigUser
+		middleModel.MiddleConfig.MiddleConfigDataBase = info.MiddleConfig.MiddleConfigDataBase
+		middleModel.MiddleConfig.MiddleConfigSecretName = info.MiddleConfig.MiddleConfigSecretName
+	}
+	middleModel.MiddleConfig.MiddleConfigRootPwd = ""
+	middleModel.MiddleConfig.MiddleConfigPwd = ""
+}
+
+// hidePasswords 查询结果不返回密码，旧版本写入数据库的密码也不会返回，密码通过 GetMiddlewareCredentials 获取
+func hidePasswords(info *middleware.MiddlewareInfo) {
+	if info.MiddleConfig == nil {
+		return
+	}
+	info.MiddleConfig.MiddleConfigRootPwd = ""
+	info.MiddleConfig.MiddleConfigPwd = ""
+}
+
+// ExportMiddleware 导出中间件应用到k8s的全部对象，密码不导出
+func (m *MiddlewareHandler) ExportMiddleware(ctx context.Context, id *middleware.MiddlewareID, rsp *middleware.ExportResult) error {
+	info, err := m.getMiddlewareInfo(id.Id)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	err = applyTemplate(info)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	rsp.Yaml, err = m.MiddlewareService.ExportYAML(info)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	return nil
+}
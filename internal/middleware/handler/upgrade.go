@@ -0,0 +1,89 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"tini-paas/internal/middleware/proto/middleware"
+	"tini-paas/internal/middleware/service"
+	"tini-paas/internal/middleware/template"
+	"tini-paas/pkg/common"
+)
+
+// UpgradeMiddleware 升级中间件到同一类型的其他版本
+// 升级路径由类型模板声明，升级前按照备份策略备份一次，升级在后台逐个实例进行，进度见中间件的升级状态
+func (m *MiddlewareHandler) UpgradeMiddleware(ctx context.Context, req *middleware.UpgradeRequest, rsp *middleware.Response) error {
+	info, err := m.getMiddlewareInfo(req.MiddleId)
+	if err != nil {
+		common.Error(err)
+		rsp.Msg = err.Error()
+		return err
+	}
+
+	err = m.checkUpgrade(info, req.TargetVersionId)
+	if err != nil {
+		common.Error(err)
+		rsp.Msg = err.Error()
+		return err
+	}
+
+	image, err := m.MiddleTypeService.FindImageVersionByID(req.TargetVersionId)
+	if err != nil {
+		common.Error(err)
+		rsp.Msg = err.Error()
+		return err
+	}
+
+	err = m.MiddlewareService.UpgradeToK8s(info, image, req.TargetVersionId, m.preUpgrade(info, req.SkipBackup))
+	if err != nil {
+		common.Error(err)
+		rsp.Msg = err.Error()
+		return err
+	}
+	rsp.Msg = "中间件 " + info.MiddleName + " 开始升级到 " + image
+	common.Info(rsp.Msg)
+	return nil
+}
+
+// checkUpgrade 检查目标版本属于同一类型，并且在模板声明的升级路径中
+func (m *MiddlewareHandler) checkUpgrade(info *middleware.MiddlewareInfo, targetVersionID int64) error {
+	if targetVersionID == info.MiddleVersionId {
+		return errors.New("中间件 " + info.MiddleName + " 已经是目标版本")
+	}
+	target, err := m.MiddleTypeService.FindVersionByID(targetVersionID)
+	if err != nil {
+		return err
+	}
+	if target.MiddleTypeID != info.MiddleTypeId {
+		return errors.New("版本 " + strconv.FormatInt(targetVersionID, 10) + " 不属于中间件 " + info.MiddleName + " 的类型")
+	}
+	current, err := m.MiddleTypeService.FindVersionByID(info.MiddleVersionId)
+	if err != nil {
+		return err
+	}
+
+	tpl, ok := template.Get(info.MiddleTypeName)
+	if !ok {
+		return errors.New("中间件类型 " + info.MiddleTypeName + " 没有模板，不支持升级")
+	}
+	return tpl.CheckUpgrade(current.MiddleVersion, target.MiddleVersion)
+}
+
+// preUpgrade 升级前的备份，没有备份策略或者跳过备份时直接升级
+func (m *MiddlewareHandler) preUpgrade(info *middleware.MiddlewareInfo, skipBackup bool) func() error {
+	return func() error {
+		if skipBackup {
+			common.Info("中间件 " + info.MiddleName + " 跳过升级前备份")
+			return nil
+		}
+		jobName, err := m.MiddleBackupService.RunBackup(info)
+		if errors.Is(err, service.ErrNoBackupPolicy) {
+			common.Info("中间件 " + info.MiddleName + " 没有备份策略，跳过升级前备份")
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		return m.MiddleBackupService.WaitBackup(info.MiddleNamespace, jobName)
+	}
+}
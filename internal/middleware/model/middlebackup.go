@@ -0,0 +1,97 @@
+package model
+
+// MiddleBackupPolicy 中间件的定时备份策略，每个中间件只有一个
+type MiddleBackupPolicy struct {
+	ID int64 `gorm:"primary_key;not_null;auto_increment" json:"id"`
+
+	// MiddleID 关联的中间件ID
+	MiddleID int64 `gorm:"unique_index;not_null" json:"middle_id"`
+
+	// BackupSchedule 备份周期，cron格式，如 0 3 * * *
+	BackupSchedule string `gorm:"not_null" json:"backup_schedule"`
+
+	// BackupRetention 保留的备份数量
+	BackupRetention int32 `json:"backup_retention"`
+
+	// BackupTarget 备份保存的位置：pvc, s3
+	BackupTarget string `json:"backup_target"`
+
+	// BackupPvcName 保存备份的PVC，需要与中间件在同一个命名空间
+	BackupPvcName string `json:"backup_pvc_name"`
+
+	// BackupS3Endpoint S3兼容存储的地址，如 http://minio.minio:9000
+	BackupS3Endpoint string `json:"backup_s3_endpoint"`
+
+	// BackupS3Bucket 保存备份的桶
+	BackupS3Bucket string `json:"backup_s3_bucket"`
+
+	// BackupS3AccessKey 访问密钥，只保存在k8s的Secret中
+	BackupS3AccessKey string `gorm:"-" json:"backup_s3_access_key"`
+
+	// BackupS3SecretKey 访问密钥，只保存在k8s的Secret中
+	BackupS3SecretKey string `gorm:"-" json:"backup_s3_secret_key"`
+}
+
+// MiddleBackup 中间件的备份记录，由定时任务产生
+type MiddleBackup struct {
+	ID int64 `gorm:"primary_key;not_null;auto_increment" json:"id"`
+
+	// MiddleID 关联的中间件ID，不同命名空间的同名中间件备份任务名称相同，与任务名称组成联合唯一索引
+	MiddleID int64 `gorm:"unique_index:idx_middle_backups_middle_job" json:"middle_id"`
+
+	// BackupName 备份文件名称
+	BackupName string `json:"backup_name"`
+
+	// BackupJobName 执行备份的任务名称
+	BackupJobName string `gorm:"unique_index:idx_middle_backups_middle_job" json:"backup_job_name"`
+
+	// BackupStatus 备份状态：Running, Succeeded, Failed
+	BackupStatus string `json:"backup_status"`
+
+	// BackupTarget 备份时使用的保存位置：pvc, s3
+	BackupTarget string `json:"backup_target"`
+
+	// BackupLocation 备份文件的完整位置，如 s3://bucket/namespace/name/file
+	BackupLocation string `json:"backup_location"`
+
+	// BackupMsg 备份结果说明
+	BackupMsg string `json:"backup_msg"`
+
+	// BackupStartTime 开始时间
+	BackupStartTime int64 `json:"backup_start_time"`
+
+	// BackupFinishTime 结束时间
+	BackupFinishTime int64 `json:"backup_finish_time"`
+}
+
+// MiddleRestore 中间件的恢复记录，服务重启后根据记录继续未完成的恢复
+type MiddleRestore struct {
+	ID int64 `gorm:"primary_key;not_null;auto_increment" json:"id"`
+
+	// MiddleID 恢复到的中间件ID
+	MiddleID int64 `gorm:"index" json:"middle_id"`
+
+	// BackupID 使用的备份ID
+	BackupID int64 `json:"backup_id"`
+
+	// RestoreJobName 执行恢复的任务名称
+	RestoreJobName string `json:"restore_job_name"`
+
+	// RestoreJob 恢复任务的定义，服务重启后重新提交任务时使用
+	RestoreJob string `gorm:"type:text" json:"restore_job"`
+
+	// RestoreOffline 是否需要先停止中间件
+	RestoreOffline bool `json:"restore_offline"`
+
+	// RestoreStatus 恢复状态：Running, Succeeded, Failed
+	RestoreStatus string `gorm:"index" json:"restore_status"`
+
+	// RestoreMsg 恢复结果说明
+	RestoreMsg string `json:"restore_msg"`
+
+	// RestoreStartTime 开始时间
+	RestoreStartTime int64 `json:"restore_start_time"`
+
+	// RestoreFinishTime 结束时间
+	RestoreFinishTime int64 `json:"restore_finish_time"`
+}
@@ -10,15 +10,18 @@ type MiddleConfig struct {
 	// MiddleConfigRootUser 可能存在的root用户
 	MiddleConfigRootUser string `json:"middle_config_root_user"`
 
-	// MiddleConfigRootPwd 可能存在的root密码
+	// MiddleConfigRootPwd 可能存在的root密码，只保存在k8s的Secret中，数据库中为空
 	MiddleConfigRootPwd string `json:"middle_config_root_pwd"`
 
 	// MiddleConfigUser 可能存在的普通用户
 	MiddleConfigUser string `json:"middle_config_user"`
 
-	// MiddleConfigPwd 普通用户的密码
+	// MiddleConfigPwd 普通用户的密码，只保存在k8s的Secret中，数据库中为空
 	MiddleConfigPwd string `json:"middle_config_user_pwd"`
 
 	// MiddleConfigDataBase 预置数据库名字
 	MiddleConfigDataBase string `json:"middle_config_data_base"`
+
+	// MiddleConfigSecretName 保存账号密码的Secret名称
+	MiddleConfigSecretName string `json:"middle_config_secret_name"`
 }
@@ -21,4 +21,7 @@ type MiddleStorage struct {
 
 	// MiddleStorageAccessMode 存储的权限
 	MiddleStorageAccessMode string `json:"middle_storage_access_mode"`
+
+	// MiddleStorageResizeStatus 最近一次扩容的状态
+	MiddleStorageResizeStatus string `json:"middle_storage_resize_status"`
 }
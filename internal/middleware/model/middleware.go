@@ -4,11 +4,11 @@ package model
 type Middleware struct {
 	ID int64 `gorm:"primary_key;not_null;auto_increment"`
 
-	// MiddleName 中间件名称
-	MiddleName string `json:"middle_name"`
+	// MiddleName 中间件名称，同一命名空间内唯一
+	MiddleName string `gorm:"unique_index:idx_middleware_namespace_name;not_null" json:"middle_name"`
 
 	// MiddleNamespace 中间件命名空间
-	MiddleNamespace string `json:"middle_namespace"`
+	MiddleNamespace string `gorm:"unique_index:idx_middleware_namespace_name;not_null" json:"middle_namespace"`
 
 	// MiddleTypeID 中间件类型
 	MiddleTypeID int64 `json:"middle_type_id"`
@@ -36,4 +36,13 @@ type Middleware struct {
 
 	// MiddleReplicas 中间件副本
 	MiddleReplicas int32 `json:"middle_replicas"`
+
+	// MiddleSize 中间件规格
+	MiddleSize string `json:"middle_size"`
+
+	// MiddleUpgradeStatus 最近一次升级的状态
+	MiddleUpgradeStatus string `json:"middle_upgrade_status"`
+
+	// MiddleUpgradeMsg 升级进度或失败原因
+	MiddleUpgradeMsg string `json:"middle_upgrade_msg"`
 }
@@ -40,6 +40,13 @@ type MiddlewareInfo struct {
 	MiddleReplicas  int32            `protobuf:"varint,12,opt,name=middle_replicas,json=middleReplicas,proto3" json:"middle_replicas,omitempty"`
 	// 添加需要的镜像版本
 	MiddleDockerImageVersion string `protobuf:"bytes,13,opt,name=middle_docker_image_version,json=middleDockerImageVersion,proto3" json:"middle_docker_image_version,omitempty"`
+	// 中间件类型名称，用于生成对应的环境变量
+	MiddleTypeName string `protobuf:"bytes,14,opt,name=middle_type_name,json=middleTypeName,proto3" json:"middle_type_name,omitempty"`
+	// 规格 small/medium/large，未设置资源和存储时使用规格的默认值
+	MiddleSize string `protobuf:"bytes,15,opt,name=middle_size,json=middleSize,proto3" json:"middle_size,omitempty"`
+	// 最近一次升级的状态：BackingUp, Upgrading, Succeeded, Failed
+	MiddleUpgradeStatus string `protobuf:"bytes,16,opt,name=middle_upgrade_status,json=middleUpgradeStatus,proto3" json:"middle_upgrade_status,omitempty"`
+	MiddleUpgradeMsg    string `protobuf:"bytes,17,opt,name=middle_upgrade_msg,json=middleUpgradeMsg,proto3" json:"middle_upgrade_msg,omitempty"`
 }
 
 func (x *MiddlewareInfo) Reset() {
@@ -165,6 +172,34 @@ func (x *MiddlewareInfo) GetMiddleDockerImageVersion() string {
 	return ""
 }
 
+func (x *MiddlewareInfo) GetMiddleTypeName() string {
+	if x != nil {
+		return x.MiddleTypeName
+	}
+	return ""
+}
+
+func (x *MiddlewareInfo) GetMiddleSize() string {
+	if x != nil {
+		return x.MiddleSize
+	}
+	return ""
+}
+
+func (x *MiddlewareInfo) GetMiddleUpgradeStatus() string {
+	if x != nil {
+		return x.MiddleUpgradeStatus
+	}
+	return ""
+}
+
+func (x *MiddlewareInfo) GetMiddleUpgradeMsg() string {
+	if x != nil {
+		return x.MiddleUpgradeMsg
+	}
+	return ""
+}
+
 // 中间件端口
 type MiddlePort struct {
 	state         protoimpl.MessageState
@@ -235,12 +270,13 @@ type MiddleConfig struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	MiddleId             int64  `protobuf:"varint,1,opt,name=middle_id,json=middleId,proto3" json:"middle_id,omitempty"`
-	MiddleConfigRootUser string `protobuf:"bytes,2,opt,name=middle_config_root_user,json=middleConfigRootUser,proto3" json:"middle_config_root_user,omitempty"`
-	MiddleConfigRootPwd  string `protobuf:"bytes,3,opt,name=middle_config_root_pwd,json=middleConfigRootPwd,proto3" json:"middle_config_root_pwd,omitempty"`
-	MiddleConfigUser     string `protobuf:"bytes,4,opt,name=middle_config_user,json=middleConfigUser,proto3" json:"middle_config_user,omitempty"`
-	MiddleConfigPwd      string `protobuf:"bytes,5,opt,name=middle_config_pwd,json=middleConfigPwd,proto3" json:"middle_config_pwd,omitempty"`
-	MiddleConfigDataBase string `protobuf:"bytes,6,opt,name=middle_config_data_base,json=middleConfigDataBase,proto3" json:"middle_config_data_base,omitempty"`
+	MiddleId               int64  `protobuf:"varint,1,opt,name=middle_id,json=middleId,proto3" json:"middle_id,omitempty"`
+	MiddleConfigRootUser   string `protobuf:"bytes,2,opt,name=middle_config_root_user,json=middleConfigRootUser,proto3" json:"middle_config_root_user,omitempty"`
+	MiddleConfigRootPwd    string `protobuf:"bytes,3,opt,name=middle_config_root_pwd,json=middleConfigRootPwd,proto3" json:"middle_config_root_pwd,omitempty"`
+	MiddleConfigUser       string `protobuf:"bytes,4,opt,name=middle_config_user,json=middleConfigUser,proto3" json:"middle_config_user,omitempty"`
+	MiddleConfigPwd        string `protobuf:"bytes,5,opt,name=middle_config_pwd,json=middleConfigPwd,proto3" json:"middle_config_pwd,omitempty"`
+	MiddleConfigDataBase   string `protobuf:"bytes,6,opt,name=middle_config_data_base,json=middleConfigDataBase,proto3" json:"middle_config_data_base,omitempty"`
+	MiddleConfigSecretName string `protobuf:"bytes,7,opt,name=middle_config_secret_name,json=middleConfigSecretName,proto3" json:"middle_config_secret_name,omitempty"`
 }
 
 func (x *MiddleConfig) Reset() {
@@ -317,6 +353,13 @@ func (x *MiddleConfig) GetMiddleConfigDataBase() string {
 	return ""
 }
 
+func (x *MiddleConfig) GetMiddleConfigSecretName() string {
+	if x != nil {
+		return x.MiddleConfigSecretName
+	}
+	return ""
+}
+
 type MiddleEnv struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -391,6 +434,8 @@ type MiddleStorage struct {
 	MiddleStoragePath       string  `protobuf:"bytes,4,opt,name=middle_storage_path,json=middleStoragePath,proto3" json:"middle_storage_path,omitempty"`
 	MiddleStorageClass      string  `protobuf:"bytes,5,opt,name=middle_storage_class,json=middleStorageClass,proto3" json:"middle_storage_class,omitempty"`
 	MiddleStorageAccessMode string  `protobuf:"bytes,6,opt,name=middle_storage_access_mode,json=middleStorageAccessMode,proto3" json:"middle_storage_access_mode,omitempty"`
+	// 最近一次扩容的状态：Resizing, FileSystemResizePending, Completed, Failed
+	MiddleStorageResizeStatus string `protobuf:"bytes,7,opt,name=middle_storage_resize_status,json=middleStorageResizeStatus,proto3" json:"middle_storage_resize_status,omitempty"`
 }
 
 func (x *MiddleStorage) Reset() {
@@ -467,16 +512,26 @@ func (x *MiddleStorage) GetMiddleStorageAccessMode() string {
 	return ""
 }
 
-type FindAllByTypeID struct {
+func (x *MiddleStorage) GetMiddleStorageResizeStatus() string {
+	if x != nil {
+		return x.MiddleStorageResizeStatus
+	}
+	return ""
+}
+
+// MiddleStorageResize 在线扩容中间件的存储，每个实例的PVC分别扩容
+type MiddleStorageResize struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TypeId int64 `protobuf:"varint,1,opt,name=type_id,json=typeId,proto3" json:"type_id,omitempty"`
+	MiddleId          int64   `protobuf:"varint,1,opt,name=middle_id,json=middleId,proto3" json:"middle_id,omitempty"`
+	MiddleStorageName string  `protobuf:"bytes,2,opt,name=middle_storage_name,json=middleStorageName,proto3" json:"middle_storage_name,omitempty"`
+	MiddleStorageSize float32 `protobuf:"fixed32,3,opt,name=middle_storage_size,json=middleStorageSize,proto3" json:"middle_storage_size,omitempty"`
 }
 
-func (x *FindAllByTypeID) Reset() {
-	*x = FindAllByTypeID{}
+func (x *MiddleStorageResize) Reset() {
+	*x = MiddleStorageResize{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_middleware_middleware_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -484,13 +539,13 @@ func (x *FindAllByTypeID) Reset() {
 	}
 }
 
-func (x *FindAllByTypeID) String() string {
+func (x *MiddleStorageResize) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FindAllByTypeID) ProtoMessage() {}
+func (*MiddleStorageResize) ProtoMessage() {}
 
-func (x *FindAllByTypeID) ProtoReflect() protoreflect.Message {
+func (x *MiddleStorageResize) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_middleware_middleware_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -502,28 +557,43 @@ func (x *FindAllByTypeID) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FindAllByTypeID.ProtoReflect.Descriptor instead.
-func (*FindAllByTypeID) Descriptor() ([]byte, []int) {
+// Deprecated: Use MiddleStorageResize.ProtoReflect.Descriptor instead.
+func (*MiddleStorageResize) Descriptor() ([]byte, []int) {
 	return file_proto_middleware_middleware_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *FindAllByTypeID) GetTypeId() int64 {
+func (x *MiddleStorageResize) GetMiddleId() int64 {
 	if x != nil {
-		return x.TypeId
+		return x.MiddleId
 	}
 	return 0
 }
 
-type MiddleTypeID struct {
+func (x *MiddleStorageResize) GetMiddleStorageName() string {
+	if x != nil {
+		return x.MiddleStorageName
+	}
+	return ""
+}
+
+func (x *MiddleStorageResize) GetMiddleStorageSize() float32 {
+	if x != nil {
+		return x.MiddleStorageSize
+	}
+	return 0
+}
+
+type MiddleScale struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	MiddleId       int64 `protobuf:"varint,1,opt,name=middle_id,json=middleId,proto3" json:"middle_id,omitempty"`
+	MiddleReplicas int32 `protobuf:"varint,2,opt,name=middle_replicas,json=middleReplicas,proto3" json:"middle_replicas,omitempty"`
 }
 
-func (x *MiddleTypeID) Reset() {
-	*x = MiddleTypeID{}
+func (x *MiddleScale) Reset() {
+	*x = MiddleScale{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_middleware_middleware_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -531,13 +601,13 @@ func (x *MiddleTypeID) Reset() {
 	}
 }
 
-func (x *MiddleTypeID) String() string {
+func (x *MiddleScale) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MiddleTypeID) ProtoMessage() {}
+func (*MiddleScale) ProtoMessage() {}
 
-func (x *MiddleTypeID) ProtoReflect() protoreflect.Message {
+func (x *MiddleScale) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_middleware_middleware_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -549,28 +619,35 @@ func (x *MiddleTypeID) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MiddleTypeID.ProtoReflect.Descriptor instead.
-func (*MiddleTypeID) Descriptor() ([]byte, []int) {
+// Deprecated: Use MiddleScale.ProtoReflect.Descriptor instead.
+func (*MiddleScale) Descriptor() ([]byte, []int) {
 	return file_proto_middleware_middleware_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *MiddleTypeID) GetId() int64 {
+func (x *MiddleScale) GetMiddleId() int64 {
 	if x != nil {
-		return x.Id
+		return x.MiddleId
 	}
 	return 0
 }
 
-type MiddlewareID struct {
+func (x *MiddleScale) GetMiddleReplicas() int32 {
+	if x != nil {
+		return x.MiddleReplicas
+	}
+	return 0
+}
+
+type FindAllByTypeID struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TypeId int64 `protobuf:"varint,1,opt,name=type_id,json=typeId,proto3" json:"type_id,omitempty"`
 }
 
-func (x *MiddlewareID) Reset() {
-	*x = MiddlewareID{}
+func (x *FindAllByTypeID) Reset() {
+	*x = FindAllByTypeID{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_middleware_middleware_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -578,13 +655,13 @@ func (x *MiddlewareID) Reset() {
 	}
 }
 
-func (x *MiddlewareID) String() string {
+func (x *FindAllByTypeID) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MiddlewareID) ProtoMessage() {}
+func (*FindAllByTypeID) ProtoMessage() {}
 
-func (x *MiddlewareID) ProtoReflect() protoreflect.Message {
+func (x *FindAllByTypeID) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_middleware_middleware_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -596,26 +673,28 @@ func (x *MiddlewareID) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MiddlewareID.ProtoReflect.Descriptor instead.
-func (*MiddlewareID) Descriptor() ([]byte, []int) {
+// Deprecated: Use FindAllByTypeID.ProtoReflect.Descriptor instead.
+func (*FindAllByTypeID) Descriptor() ([]byte, []int) {
 	return file_proto_middleware_middleware_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *MiddlewareID) GetId() int64 {
+func (x *FindAllByTypeID) GetTypeId() int64 {
 	if x != nil {
-		return x.Id
+		return x.TypeId
 	}
 	return 0
 }
 
-type FindAll struct {
+type MiddleTypeID struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *FindAll) Reset() {
-	*x = FindAll{}
+func (x *MiddleTypeID) Reset() {
+	*x = MiddleTypeID{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_middleware_middleware_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -623,13 +702,13 @@ func (x *FindAll) Reset() {
 	}
 }
 
-func (x *FindAll) String() string {
+func (x *MiddleTypeID) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FindAll) ProtoMessage() {}
+func (*MiddleTypeID) ProtoMessage() {}
 
-func (x *FindAll) ProtoReflect() protoreflect.Message {
+func (x *MiddleTypeID) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_middleware_middleware_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -641,21 +720,28 @@ func (x *FindAll) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FindAll.ProtoReflect.Descriptor instead.
-func (*FindAll) Descriptor() ([]byte, []int) {
+// Deprecated: Use MiddleTypeID.ProtoReflect.Descriptor instead.
+func (*MiddleTypeID) Descriptor() ([]byte, []int) {
 	return file_proto_middleware_middleware_proto_rawDescGZIP(), []int{8}
 }
 
-type Response struct {
+func (x *MiddleTypeID) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type MiddlewareID struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Msg string `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *Response) Reset() {
-	*x = Response{}
+func (x *MiddlewareID) Reset() {
+	*x = MiddlewareID{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_middleware_middleware_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -663,13 +749,13 @@ func (x *Response) Reset() {
 	}
 }
 
-func (x *Response) String() string {
+func (x *MiddlewareID) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Response) ProtoMessage() {}
+func (*MiddlewareID) ProtoMessage() {}
 
-func (x *Response) ProtoReflect() protoreflect.Message {
+func (x *MiddlewareID) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_middleware_middleware_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -681,28 +767,30 @@ func (x *Response) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Response.ProtoReflect.Descriptor instead.
-func (*Response) Descriptor() ([]byte, []int) {
+// Deprecated: Use MiddlewareID.ProtoReflect.Descriptor instead.
+func (*MiddlewareID) Descriptor() ([]byte, []int) {
 	return file_proto_middleware_middleware_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *Response) GetMsg() string {
+func (x *MiddlewareID) GetId() int64 {
 	if x != nil {
-		return x.Msg
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-type AllMiddleware struct {
+// 根据命名空间和名称查找
+type MiddlewareNamespaceName struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	MiddlewareInfo []*MiddlewareInfo `protobuf:"bytes,1,rep,name=middleware_info,json=middlewareInfo,proto3" json:"middleware_info,omitempty"`
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name      string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *AllMiddleware) Reset() {
-	*x = AllMiddleware{}
+func (x *MiddlewareNamespaceName) Reset() {
+	*x = MiddlewareNamespaceName{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_middleware_middleware_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -710,13 +798,13 @@ func (x *AllMiddleware) Reset() {
 	}
 }
 
-func (x *AllMiddleware) String() string {
+func (x *MiddlewareNamespaceName) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AllMiddleware) ProtoMessage() {}
+func (*MiddlewareNamespaceName) ProtoMessage() {}
 
-func (x *AllMiddleware) ProtoReflect() protoreflect.Message {
+func (x *MiddlewareNamespaceName) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_middleware_middleware_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -728,31 +816,36 @@ func (x *AllMiddleware) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AllMiddleware.ProtoReflect.Descriptor instead.
-func (*AllMiddleware) Descriptor() ([]byte, []int) {
+// Deprecated: Use MiddlewareNamespaceName.ProtoReflect.Descriptor instead.
+func (*MiddlewareNamespaceName) Descriptor() ([]byte, []int) {
 	return file_proto_middleware_middleware_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *AllMiddleware) GetMiddlewareInfo() []*MiddlewareInfo {
+func (x *MiddlewareNamespaceName) GetNamespace() string {
 	if x != nil {
-		return x.MiddlewareInfo
+		return x.Namespace
 	}
-	return nil
+	return ""
 }
 
-type MiddleTypeInfo struct {
+func (x *MiddlewareNamespaceName) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// 获取账号密码的请求，用户身份从 Authorization metadata 中的登录令牌获取
+type CredentialsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id                 int64            `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	MiddleTypeName     string           `protobuf:"bytes,2,opt,name=middle_type_name,json=middleTypeName,proto3" json:"middle_type_name,omitempty"`
-	MiddleTypeImageSrc string           `protobuf:"bytes,3,opt,name=middle_type_image_src,json=middleTypeImageSrc,proto3" json:"middle_type_image_src,omitempty"`
-	MiddleVersion      []*MiddleVersion `protobuf:"bytes,4,rep,name=middle_version,json=middleVersion,proto3" json:"middle_version,omitempty"`
+	MiddlewareId int64 `protobuf:"varint,1,opt,name=middleware_id,json=middlewareId,proto3" json:"middleware_id,omitempty"`
 }
 
-func (x *MiddleTypeInfo) Reset() {
-	*x = MiddleTypeInfo{}
+func (x *CredentialsRequest) Reset() {
+	*x = CredentialsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_middleware_middleware_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -760,13 +853,13 @@ func (x *MiddleTypeInfo) Reset() {
 	}
 }
 
-func (x *MiddleTypeInfo) String() string {
+func (x *CredentialsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MiddleTypeInfo) ProtoMessage() {}
+func (*CredentialsRequest) ProtoMessage() {}
 
-func (x *MiddleTypeInfo) ProtoReflect() protoreflect.Message {
+func (x *CredentialsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_middleware_middleware_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -778,51 +871,26 @@ func (x *MiddleTypeInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MiddleTypeInfo.ProtoReflect.Descriptor instead.
-func (*MiddleTypeInfo) Descriptor() ([]byte, []int) {
+// Deprecated: Use CredentialsRequest.ProtoReflect.Descriptor instead.
+func (*CredentialsRequest) Descriptor() ([]byte, []int) {
 	return file_proto_middleware_middleware_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *MiddleTypeInfo) GetId() int64 {
+func (x *CredentialsRequest) GetMiddlewareId() int64 {
 	if x != nil {
-		return x.Id
+		return x.MiddlewareId
 	}
 	return 0
 }
 
-func (x *MiddleTypeInfo) GetMiddleTypeName() string {
-	if x != nil {
-		return x.MiddleTypeName
-	}
-	return ""
-}
-
-func (x *MiddleTypeInfo) GetMiddleTypeImageSrc() string {
-	if x != nil {
-		return x.MiddleTypeImageSrc
-	}
-	return ""
-}
-
-func (x *MiddleTypeInfo) GetMiddleVersion() []*MiddleVersion {
-	if x != nil {
-		return x.MiddleVersion
-	}
-	return nil
-}
-
-type MiddleVersion struct {
+type FindAll struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	MiddleTypeId      int64  `protobuf:"varint,1,opt,name=middle_type_id,json=middleTypeId,proto3" json:"middle_type_id,omitempty"`
-	MiddleDockerImage string `protobuf:"bytes,2,opt,name=middle_docker_image,json=middleDockerImage,proto3" json:"middle_docker_image,omitempty"`
-	MiddleVersion     string `protobuf:"bytes,3,opt,name=middle_version,json=middleVersion,proto3" json:"middle_version,omitempty"`
 }
 
-func (x *MiddleVersion) Reset() {
-	*x = MiddleVersion{}
+func (x *FindAll) Reset() {
+	*x = FindAll{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_middleware_middleware_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -830,13 +898,13 @@ func (x *MiddleVersion) Reset() {
 	}
 }
 
-func (x *MiddleVersion) String() string {
+func (x *FindAll) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MiddleVersion) ProtoMessage() {}
+func (*FindAll) ProtoMessage() {}
 
-func (x *MiddleVersion) ProtoReflect() protoreflect.Message {
+func (x *FindAll) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_middleware_middleware_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -848,42 +916,34 @@ func (x *MiddleVersion) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MiddleVersion.ProtoReflect.Descriptor instead.
-func (*MiddleVersion) Descriptor() ([]byte, []int) {
+// Deprecated: Use FindAll.ProtoReflect.Descriptor instead.
+func (*FindAll) Descriptor() ([]byte, []int) {
 	return file_proto_middleware_middleware_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *MiddleVersion) GetMiddleTypeId() int64 {
-	if x != nil {
-		return x.MiddleTypeId
-	}
-	return 0
-}
-
-func (x *MiddleVersion) GetMiddleDockerImage() string {
-	if x != nil {
-		return x.MiddleDockerImage
-	}
-	return ""
-}
-
-func (x *MiddleVersion) GetMiddleVersion() string {
-	if x != nil {
-		return x.MiddleVersion
-	}
-	return ""
-}
-
-type AllMiddleType struct {
+// MiddleBackupPolicy 备份策略，备份保存到同一命名空间的PVC或者S3兼容存储
+type MiddleBackupPolicy struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	MiddleTypeInfo []*MiddleTypeInfo `protobuf:"bytes,1,rep,name=middle_type_info,json=middleTypeInfo,proto3" json:"middle_type_info,omitempty"`
-}
-
-func (x *AllMiddleType) Reset() {
-	*x = AllMiddleType{}
+	Id       int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	MiddleId int64 `protobuf:"varint,2,opt,name=middle_id,json=middleId,proto3" json:"middle_id,omitempty"`
+	// cron格式的备份周期
+	BackupSchedule  string `protobuf:"bytes,3,opt,name=backup_schedule,json=backupSchedule,proto3" json:"backup_schedule,omitempty"`
+	BackupRetention int32  `protobuf:"varint,4,opt,name=backup_retention,json=backupRetention,proto3" json:"backup_retention,omitempty"`
+	// pvc 或 s3
+	BackupTarget     string `protobuf:"bytes,5,opt,name=backup_target,json=backupTarget,proto3" json:"backup_target,omitempty"`
+	BackupPvcName    string `protobuf:"bytes,6,opt,name=backup_pvc_name,json=backupPvcName,proto3" json:"backup_pvc_name,omitempty"`
+	BackupS3Endpoint string `protobuf:"bytes,7,opt,name=backup_s3_endpoint,json=backupS3Endpoint,proto3" json:"backup_s3_endpoint,omitempty"`
+	BackupS3Bucket   string `protobuf:"bytes,8,opt,name=backup_s3_bucket,json=backupS3Bucket,proto3" json:"backup_s3_bucket,omitempty"`
+	// 访问密钥只在设置时传入，保存在Secret中
+	BackupS3AccessKey string `protobuf:"bytes,9,opt,name=backup_s3_access_key,json=backupS3AccessKey,proto3" json:"backup_s3_access_key,omitempty"`
+	BackupS3SecretKey string `protobuf:"bytes,10,opt,name=backup_s3_secret_key,json=backupS3SecretKey,proto3" json:"backup_s3_secret_key,omitempty"`
+}
+
+func (x *MiddleBackupPolicy) Reset() {
+	*x = MiddleBackupPolicy{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_middleware_middleware_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -891,13 +951,13 @@ func (x *AllMiddleType) Reset() {
 	}
 }
 
-func (x *AllMiddleType) String() string {
+func (x *MiddleBackupPolicy) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AllMiddleType) ProtoMessage() {}
+func (*MiddleBackupPolicy) ProtoMessage() {}
 
-func (x *AllMiddleType) ProtoReflect() protoreflect.Message {
+func (x *MiddleBackupPolicy) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_middleware_middleware_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -909,25 +969,698 @@ func (x *AllMiddleType) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AllMiddleType.ProtoReflect.Descriptor instead.
-func (*AllMiddleType) Descriptor() ([]byte, []int) {
+// Deprecated: Use MiddleBackupPolicy.ProtoReflect.Descriptor instead.
+func (*MiddleBackupPolicy) Descriptor() ([]byte, []int) {
 	return file_proto_middleware_middleware_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *AllMiddleType) GetMiddleTypeInfo() []*MiddleTypeInfo {
+func (x *MiddleBackupPolicy) GetId() int64 {
 	if x != nil {
-		return x.MiddleTypeInfo
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-var File_proto_middleware_middleware_proto protoreflect.FileDescriptor
-
+func (x *MiddleBackupPolicy) GetMiddleId() int64 {
+	if x != nil {
+		return x.MiddleId
+	}
+	return 0
+}
+
+func (x *MiddleBackupPolicy) GetBackupSchedule() string {
+	if x != nil {
+		return x.BackupSchedule
+	}
+	return ""
+}
+
+func (x *MiddleBackupPolicy) GetBackupRetention() int32 {
+	if x != nil {
+		return x.BackupRetention
+	}
+	return 0
+}
+
+func (x *MiddleBackupPolicy) GetBackupTarget() string {
+	if x != nil {
+		return x.BackupTarget
+	}
+	return ""
+}
+
+func (x *MiddleBackupPolicy) GetBackupPvcName() string {
+	if x != nil {
+		return x.BackupPvcName
+	}
+	return ""
+}
+
+func (x *MiddleBackupPolicy) GetBackupS3Endpoint() string {
+	if x != nil {
+		return x.BackupS3Endpoint
+	}
+	return ""
+}
+
+func (x *MiddleBackupPolicy) GetBackupS3Bucket() string {
+	if x != nil {
+		return x.BackupS3Bucket
+	}
+	return ""
+}
+
+func (x *MiddleBackupPolicy) GetBackupS3AccessKey() string {
+	if x != nil {
+		return x.BackupS3AccessKey
+	}
+	return ""
+}
+
+func (x *MiddleBackupPolicy) GetBackupS3SecretKey() string {
+	if x != nil {
+		return x.BackupS3SecretKey
+	}
+	return ""
+}
+
+type MiddleBackup struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id               int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	MiddleId         int64  `protobuf:"varint,2,opt,name=middle_id,json=middleId,proto3" json:"middle_id,omitempty"`
+	BackupName       string `protobuf:"bytes,3,opt,name=backup_name,json=backupName,proto3" json:"backup_name,omitempty"`
+	BackupJobName    string `protobuf:"bytes,4,opt,name=backup_job_name,json=backupJobName,proto3" json:"backup_job_name,omitempty"`
+	BackupStatus     string `protobuf:"bytes,5,opt,name=backup_status,json=backupStatus,proto3" json:"backup_status,omitempty"`
+	BackupTarget     string `protobuf:"bytes,6,opt,name=backup_target,json=backupTarget,proto3" json:"backup_target,omitempty"`
+	BackupLocation   string `protobuf:"bytes,7,opt,name=backup_location,json=backupLocation,proto3" json:"backup_location,omitempty"`
+	BackupMsg        string `protobuf:"bytes,8,opt,name=backup_msg,json=backupMsg,proto3" json:"backup_msg,omitempty"`
+	BackupStartTime  int64  `protobuf:"varint,9,opt,name=backup_start_time,json=backupStartTime,proto3" json:"backup_start_time,omitempty"`
+	BackupFinishTime int64  `protobuf:"varint,10,opt,name=backup_finish_time,json=backupFinishTime,proto3" json:"backup_finish_time,omitempty"`
+}
+
+func (x *MiddleBackup) Reset() {
+	*x = MiddleBackup{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_middleware_middleware_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MiddleBackup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MiddleBackup) ProtoMessage() {}
+
+func (x *MiddleBackup) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_middleware_middleware_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MiddleBackup.ProtoReflect.Descriptor instead.
+func (*MiddleBackup) Descriptor() ([]byte, []int) {
+	return file_proto_middleware_middleware_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *MiddleBackup) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MiddleBackup) GetMiddleId() int64 {
+	if x != nil {
+		return x.MiddleId
+	}
+	return 0
+}
+
+func (x *MiddleBackup) GetBackupName() string {
+	if x != nil {
+		return x.BackupName
+	}
+	return ""
+}
+
+func (x *MiddleBackup) GetBackupJobName() string {
+	if x != nil {
+		return x.BackupJobName
+	}
+	return ""
+}
+
+func (x *MiddleBackup) GetBackupStatus() string {
+	if x != nil {
+		return x.BackupStatus
+	}
+	return ""
+}
+
+func (x *MiddleBackup) GetBackupTarget() string {
+	if x != nil {
+		return x.BackupTarget
+	}
+	return ""
+}
+
+func (x *MiddleBackup) GetBackupLocation() string {
+	if x != nil {
+		return x.BackupLocation
+	}
+	return ""
+}
+
+func (x *MiddleBackup) GetBackupMsg() string {
+	if x != nil {
+		return x.BackupMsg
+	}
+	return ""
+}
+
+func (x *MiddleBackup) GetBackupStartTime() int64 {
+	if x != nil {
+		return x.BackupStartTime
+	}
+	return 0
+}
+
+func (x *MiddleBackup) GetBackupFinishTime() int64 {
+	if x != nil {
+		return x.BackupFinishTime
+	}
+	return 0
+}
+
+type AllMiddleBackup struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MiddleBackup []*MiddleBackup `protobuf:"bytes,1,rep,name=middle_backup,json=middleBackup,proto3" json:"middle_backup,omitempty"`
+}
+
+func (x *AllMiddleBackup) Reset() {
+	*x = AllMiddleBackup{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_middleware_middleware_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AllMiddleBackup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllMiddleBackup) ProtoMessage() {}
+
+func (x *AllMiddleBackup) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_middleware_middleware_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllMiddleBackup.ProtoReflect.Descriptor instead.
+func (*AllMiddleBackup) Descriptor() ([]byte, []int) {
+	return file_proto_middleware_middleware_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *AllMiddleBackup) GetMiddleBackup() []*MiddleBackup {
+	if x != nil {
+		return x.MiddleBackup
+	}
+	return nil
+}
+
+// RestoreRequest 恢复备份，into_new_instance 为true时恢复到新创建的中间件
+type RestoreRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BackupId        int64 `protobuf:"varint,1,opt,name=backup_id,json=backupId,proto3" json:"backup_id,omitempty"`
+	IntoNewInstance bool  `protobuf:"varint,2,opt,name=into_new_instance,json=intoNewInstance,proto3" json:"into_new_instance,omitempty"`
+}
+
+func (x *RestoreRequest) Reset() {
+	*x = RestoreRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_middleware_middleware_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreRequest) ProtoMessage() {}
+
+func (x *RestoreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_middleware_middleware_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreRequest.ProtoReflect.Descriptor instead.
+func (*RestoreRequest) Descriptor() ([]byte, []int) {
+	return file_proto_middleware_middleware_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *RestoreRequest) GetBackupId() int64 {
+	if x != nil {
+		return x.BackupId
+	}
+	return 0
+}
+
+func (x *RestoreRequest) GetIntoNewInstance() bool {
+	if x != nil {
+		return x.IntoNewInstance
+	}
+	return false
+}
+
+// UpgradeRequest 升级中间件，目标版本必须属于同一个中间件类型
+type UpgradeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MiddleId        int64 `protobuf:"varint,1,opt,name=middle_id,json=middleId,proto3" json:"middle_id,omitempty"`
+	TargetVersionId int64 `protobuf:"varint,2,opt,name=target_version_id,json=targetVersionId,proto3" json:"target_version_id,omitempty"`
+	// 跳过升级前的备份，没有备份策略时不会备份
+	SkipBackup bool `protobuf:"varint,3,opt,name=skip_backup,json=skipBackup,proto3" json:"skip_backup,omitempty"`
+}
+
+func (x *UpgradeRequest) Reset() {
+	*x = UpgradeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_middleware_middleware_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpgradeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpgradeRequest) ProtoMessage() {}
+
+func (x *UpgradeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_middleware_middleware_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpgradeRequest.ProtoReflect.Descriptor instead.
+func (*UpgradeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_middleware_middleware_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *UpgradeRequest) GetMiddleId() int64 {
+	if x != nil {
+		return x.MiddleId
+	}
+	return 0
+}
+
+func (x *UpgradeRequest) GetTargetVersionId() int64 {
+	if x != nil {
+		return x.TargetVersionId
+	}
+	return 0
+}
+
+func (x *UpgradeRequest) GetSkipBackup() bool {
+	if x != nil {
+		return x.SkipBackup
+	}
+	return false
+}
+
+type Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Msg string `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
+}
+
+func (x *Response) Reset() {
+	*x = Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_middleware_middleware_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Response) ProtoMessage() {}
+
+func (x *Response) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_middleware_middleware_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Response.ProtoReflect.Descriptor instead.
+func (*Response) Descriptor() ([]byte, []int) {
+	return file_proto_middleware_middleware_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *Response) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+type AllMiddleware struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MiddlewareInfo []*MiddlewareInfo `protobuf:"bytes,1,rep,name=middleware_info,json=middlewareInfo,proto3" json:"middleware_info,omitempty"`
+}
+
+func (x *AllMiddleware) Reset() {
+	*x = AllMiddleware{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_middleware_middleware_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AllMiddleware) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllMiddleware) ProtoMessage() {}
+
+func (x *AllMiddleware) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_middleware_middleware_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllMiddleware.ProtoReflect.Descriptor instead.
+func (*AllMiddleware) Descriptor() ([]byte, []int) {
+	return file_proto_middleware_middleware_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *AllMiddleware) GetMiddlewareInfo() []*MiddlewareInfo {
+	if x != nil {
+		return x.MiddlewareInfo
+	}
+	return nil
+}
+
+type MiddleTypeInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                 int64            `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	MiddleTypeName     string           `protobuf:"bytes,2,opt,name=middle_type_name,json=middleTypeName,proto3" json:"middle_type_name,omitempty"`
+	MiddleTypeImageSrc string           `protobuf:"bytes,3,opt,name=middle_type_image_src,json=middleTypeImageSrc,proto3" json:"middle_type_image_src,omitempty"`
+	MiddleVersion      []*MiddleVersion `protobuf:"bytes,4,rep,name=middle_version,json=middleVersion,proto3" json:"middle_version,omitempty"`
+}
+
+func (x *MiddleTypeInfo) Reset() {
+	*x = MiddleTypeInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_middleware_middleware_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MiddleTypeInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MiddleTypeInfo) ProtoMessage() {}
+
+func (x *MiddleTypeInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_middleware_middleware_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MiddleTypeInfo.ProtoReflect.Descriptor instead.
+func (*MiddleTypeInfo) Descriptor() ([]byte, []int) {
+	return file_proto_middleware_middleware_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *MiddleTypeInfo) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MiddleTypeInfo) GetMiddleTypeName() string {
+	if x != nil {
+		return x.MiddleTypeName
+	}
+	return ""
+}
+
+func (x *MiddleTypeInfo) GetMiddleTypeImageSrc() string {
+	if x != nil {
+		return x.MiddleTypeImageSrc
+	}
+	return ""
+}
+
+func (x *MiddleTypeInfo) GetMiddleVersion() []*MiddleVersion {
+	if x != nil {
+		return x.MiddleVersion
+	}
+	return nil
+}
+
+type MiddleVersion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MiddleTypeId      int64  `protobuf:"varint,1,opt,name=middle_type_id,json=middleTypeId,proto3" json:"middle_type_id,omitempty"`
+	MiddleDockerImage string `protobuf:"bytes,2,opt,name=middle_docker_image,json=middleDockerImage,proto3" json:"middle_docker_image,omitempty"`
+	MiddleVersion     string `protobuf:"bytes,3,opt,name=middle_version,json=middleVersion,proto3" json:"middle_version,omitempty"`
+}
+
+func (x *MiddleVersion) Reset() {
+	*x = MiddleVersion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_middleware_middleware_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MiddleVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MiddleVersion) ProtoMessage() {}
+
+func (x *MiddleVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_middleware_middleware_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MiddleVersion.ProtoReflect.Descriptor instead.
+func (*MiddleVersion) Descriptor() ([]byte, []int) {
+	return file_proto_middleware_middleware_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *MiddleVersion) GetMiddleTypeId() int64 {
+	if x != nil {
+		return x.MiddleTypeId
+	}
+	return 0
+}
+
+func (x *MiddleVersion) GetMiddleDockerImage() string {
+	if x != nil {
+		return x.MiddleDockerImage
+	}
+	return ""
+}
+
+func (x *MiddleVersion) GetMiddleVersion() string {
+	if x != nil {
+		return x.MiddleVersion
+	}
+	return ""
+}
+
+type AllMiddleType struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MiddleTypeInfo []*MiddleTypeInfo `protobuf:"bytes,1,rep,name=middle_type_info,json=middleTypeInfo,proto3" json:"middle_type_info,omitempty"`
+}
+
+func (x *AllMiddleType) Reset() {
+	*x = AllMiddleType{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_middleware_middleware_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AllMiddleType) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllMiddleType) ProtoMessage() {}
+
+func (x *AllMiddleType) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_middleware_middleware_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllMiddleType.ProtoReflect.Descriptor instead.
+func (*AllMiddleType) Descriptor() ([]byte, []int) {
+	return file_proto_middleware_middleware_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *AllMiddleType) GetMiddleTypeInfo() []*MiddleTypeInfo {
+	if x != nil {
+		return x.MiddleTypeInfo
+	}
+	return nil
+}
+
+// 导出的k8s对象
+type ExportResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Yaml string `protobuf:"bytes,1,opt,name=yaml,proto3" json:"yaml,omitempty"`
+}
+
+func (x *ExportResult) Reset() {
+	*x = ExportResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_middleware_middleware_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportResult) ProtoMessage() {}
+
+func (x *ExportResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_middleware_middleware_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportResult.ProtoReflect.Descriptor instead.
+func (*ExportResult) Descriptor() ([]byte, []int) {
+	return file_proto_middleware_middleware_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ExportResult) GetYaml() string {
+	if x != nil {
+		return x.Yaml
+	}
+	return ""
+}
+
+var File_proto_middleware_middleware_proto protoreflect.FileDescriptor
+
 var file_proto_middleware_middleware_proto_rawDesc = []byte{
 	0x0a, 0x21, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61,
 	0x72, 0x65, 0x2f, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x12, 0x0a, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x22,
-	0xda, 0x04, 0x0a, 0x0e, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x49, 0x6e,
+	0x87, 0x06, 0x0a, 0x0e, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x49, 0x6e,
 	0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02,
 	0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d,
 	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x4e,
@@ -964,153 +1697,320 @@ var file_proto_middleware_middleware_proto_rawDesc = []byte{
 	0x1b, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x5f, 0x64, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x5f, 0x69,
 	0x6d, 0x61, 0x67, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0d, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x18, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x44, 0x6f, 0x63, 0x6b, 0x65, 0x72,
-	0x49, 0x6d, 0x61, 0x67, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x73, 0x0a, 0x0a,
-	0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69,
-	0x64, 0x64, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d,
-	0x69, 0x64, 0x64, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x69, 0x64, 0x64, 0x6c,
-	0x65, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x69,
-	0x64, 0x64, 0x6c, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x6d, 0x69, 0x64, 0x64,
-	0x6c, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0e, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
-	0x6c, 0x22, 0xa8, 0x02, 0x0a, 0x0c, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x49, 0x64, 0x12,
-	0x35, 0x0a, 0x17, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x14, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x6f,
-	0x6f, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x33, 0x0a, 0x16, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65,
-	0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x70, 0x77, 0x64,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x52, 0x6f, 0x6f, 0x74, 0x50, 0x77, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x6d,
-	0x69, 0x64, 0x64, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x75, 0x73, 0x65,
-	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x55, 0x73, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x69, 0x64,
-	0x64, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x70, 0x77, 0x64, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x50, 0x77, 0x64, 0x12, 0x35, 0x0a, 0x17, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x5f,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x62, 0x61, 0x73, 0x65,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x44, 0x61, 0x74, 0x61, 0x42, 0x61, 0x73, 0x65, 0x22, 0x5e, 0x0a, 0x09,
-	0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x45, 0x6e, 0x76, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x64,
-	0x64, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x69,
-	0x64, 0x64, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x65, 0x62, 0x76, 0x5f, 0x6b, 0x65,
-	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x62, 0x76, 0x4b, 0x65, 0x79, 0x12,
-	0x1b, 0x0a, 0x09, 0x65, 0x6e, 0x76, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x76, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xab, 0x02, 0x0a,
-	0x0d, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x12, 0x1b,
-	0x0a, 0x09, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x08, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x13, 0x6d,
-	0x69, 0x64, 0x64, 0x6c, 0x65, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65,
-	0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x6d,
-	0x69, 0x64, 0x64, 0x6c, 0x65, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69,
-	0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x11, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65,
-	0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x6d,
-	0x69, 0x64, 0x64, 0x6c, 0x65, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x70, 0x61,
-	0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65,
-	0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x30, 0x0a, 0x14, 0x6d,
-	0x69, 0x64, 0x64, 0x6c, 0x65, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x63, 0x6c,
-	0x61, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x6d, 0x69, 0x64, 0x64, 0x6c,
-	0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x3b, 0x0a,
-	0x1a, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f,
-	0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x17, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
-	0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4d, 0x6f, 0x64, 0x65, 0x22, 0x2a, 0x0a, 0x0f, 0x46, 0x69,
-	0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x42, 0x79, 0x54, 0x79, 0x70, 0x65, 0x49, 0x44, 0x12, 0x17, 0x0a,
-	0x07, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
-	0x74, 0x79, 0x70, 0x65, 0x49, 0x64, 0x22, 0x1e, 0x0a, 0x0c, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65,
-	0x54, 0x79, 0x70, 0x65, 0x49, 0x44, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x1e, 0x0a, 0x0c, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65,
-	0x77, 0x61, 0x72, 0x65, 0x49, 0x44, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x09, 0x0a, 0x07, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c,
-	0x6c, 0x22, 0x1c, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a,
-	0x03, 0x6d, 0x73, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x22,
-	0x54, 0x0a, 0x0d, 0x41, 0x6c, 0x6c, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65,
-	0x12, 0x43, 0x0a, 0x0f, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x5f, 0x69,
-	0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6d, 0x69, 0x64, 0x64,
-	0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x2e, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72,
-	0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0e, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72,
-	0x65, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0xbf, 0x01, 0x0a, 0x0e, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65,
-	0x54, 0x79, 0x70, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x69, 0x64, 0x64,
-	0x6c, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0e, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x4e, 0x61,
-	0x6d, 0x65, 0x12, 0x31, 0x0a, 0x15, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x5f, 0x74, 0x79, 0x70,
-	0x65, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x72, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x12, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x49, 0x6d, 0x61,
-	0x67, 0x65, 0x53, 0x72, 0x63, 0x12, 0x40, 0x0a, 0x0e, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x5f,
-	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e,
-	0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x2e, 0x4d, 0x69, 0x64, 0x64, 0x6c,
-	0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65,
-	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x8c, 0x01, 0x0a, 0x0d, 0x4d, 0x69, 0x64, 0x64,
-	0x6c, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x69, 0x64,
-	0x64, 0x6c, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x0c, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x49, 0x64, 0x12,
-	0x2e, 0x0a, 0x13, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x5f, 0x64, 0x6f, 0x63, 0x6b, 0x65, 0x72,
-	0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6d, 0x69,
-	0x64, 0x64, 0x6c, 0x65, 0x44, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12,
-	0x25, 0x0a, 0x0e, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x56,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x55, 0x0a, 0x0d, 0x41, 0x6c, 0x6c, 0x4d, 0x69, 0x64,
-	0x64, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x44, 0x0a, 0x10, 0x6d, 0x69, 0x64, 0x64, 0x6c,
-	0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x2e, 0x4d,
-	0x69, 0x64, 0x64, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0e, 0x6d,
-	0x69, 0x64, 0x64, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x32, 0xb3, 0x06,
-	0x0a, 0x0a, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x12, 0x43, 0x0a, 0x0d,
-	0x41, 0x64, 0x64, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x12, 0x1a, 0x2e,
-	0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x2e, 0x4d, 0x69, 0x64, 0x64, 0x6c,
-	0x65, 0x77, 0x61, 0x72, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x14, 0x2e, 0x6d, 0x69, 0x64, 0x64,
-	0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x44, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x69, 0x64, 0x64, 0x6c,
-	0x65, 0x77, 0x61, 0x72, 0x65, 0x12, 0x18, 0x2e, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61,
-	0x72, 0x65, 0x2e, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x49, 0x44, 0x1a,
-	0x14, 0x2e, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x2e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x12, 0x1a, 0x2e, 0x6d, 0x69,
-	0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x2e, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77,
-	0x61, 0x72, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x14, 0x2e, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65,
-	0x77, 0x61, 0x72, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x4c, 0x0a, 0x12, 0x46, 0x69, 0x6e, 0x64, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72,
-	0x65, 0x42, 0x79, 0x49, 0x44, 0x12, 0x18, 0x2e, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61,
-	0x72, 0x65, 0x2e, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x49, 0x44, 0x1a,
+	0x49, 0x6d, 0x61, 0x67, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x10,
+	0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65,
+	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x69, 0x64,
+	0x64, 0x6c, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x6d, 0x69, 0x64, 0x64, 0x6c,
+	0x65, 0x5f, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x55, 0x70,
+	0x67, 0x72, 0x61, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x6d,
+	0x69, 0x64, 0x64, 0x6c, 0x65, 0x5f, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x6d, 0x73,
+	0x67, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x55,
+	0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x4d, 0x73, 0x67, 0x22, 0x73, 0x0a, 0x0a, 0x4d, 0x69, 0x64,
+	0x64, 0x6c, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x64, 0x64, 0x6c,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x69, 0x64, 0x64,
+	0x6c, 0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x5f, 0x70,
+	0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x69, 0x64, 0x64, 0x6c,
+	0x65, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x5f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x22, 0xe3,
+	0x02, 0x0a, 0x0c, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
+	0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x08, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x17,
+	0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x72, 0x6f,
+	0x6f, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x6d,
+	0x69, 0x64, 0x64, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x6f, 0x6f, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x12, 0x33, 0x0a, 0x16, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x5f, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x70, 0x77, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x13, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x52, 0x6f, 0x6f, 0x74, 0x50, 0x77, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x69, 0x64, 0x64,
+	0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x55, 0x73, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65,
+	0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x70, 0x77, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0f, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x50,
+	0x77, 0x64, 0x12, 0x35, 0x0a, 0x17, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x14, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x44, 0x61, 0x74, 0x61, 0x42, 0x61, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x19, 0x6d, 0x69, 0x64,
+	0x64, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x73, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x6d, 0x69,
+	0x64, 0x64, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x4e, 0x61, 0x6d, 0x65, 0x22, 0x5e, 0x0a, 0x09, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x45, 0x6e,
+	0x76, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x17,
+	0x0a, 0x07, 0x65, 0x62, 0x76, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x65, 0x62, 0x76, 0x4b, 0x65, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x6e, 0x76, 0x5f, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x76, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x22, 0xec, 0x02, 0x0a, 0x0d, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x53,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x69, 0x64, 0x64, 0x6c,
+	0x65, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x13, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x5f, 0x73, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x11, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x5f, 0x73, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02,
+	0x52, 0x11, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x53,
+	0x69, 0x7a, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x5f, 0x73, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x11, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x50,
+	0x61, 0x74, 0x68, 0x12, 0x30, 0x0a, 0x14, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x5f, 0x73, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x12, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x3b, 0x0a, 0x1a, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x5f,
+	0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x6d,
+	0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x17, 0x6d, 0x69, 0x64, 0x64, 0x6c,
+	0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4d, 0x6f,
+	0x64, 0x65, 0x12, 0x3f, 0x0a, 0x1c, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x5f, 0x73, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x19, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65,
+	0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x69, 0x7a, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x22, 0x92, 0x01, 0x0a, 0x13, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x53, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d,
+	0x69, 0x64, 0x64, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x13, 0x6d, 0x69, 0x64, 0x64,
+	0x6c, 0x65, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x53, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x6d, 0x69, 0x64, 0x64,
+	0x6c, 0x65, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x11, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x53, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x53, 0x0a, 0x0b, 0x4d, 0x69, 0x64, 0x64,
+	0x6c, 0x65, 0x53, 0x63, 0x61, 0x6c, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x64, 0x64, 0x6c,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x69, 0x64, 0x64,
+	0x6c, 0x65, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x5f, 0x72,
+	0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x6d,
+	0x69, 0x64, 0x64, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x22, 0x2a, 0x0a,
+	0x0f, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x42, 0x79, 0x54, 0x79, 0x70, 0x65, 0x49, 0x44,
+	0x12, 0x17, 0x0a, 0x07, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x74, 0x79, 0x70, 0x65, 0x49, 0x64, 0x22, 0x1e, 0x0a, 0x0c, 0x4d, 0x69, 0x64,
+	0x64, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x49, 0x44, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x1e, 0x0a, 0x0c, 0x4d, 0x69, 0x64,
+	0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x49, 0x44, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x4b, 0x0a, 0x17, 0x4d, 0x69, 0x64,
+	0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x48, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d,
+	0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0c, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x49,
+	0x64, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x22, 0x09, 0x0a, 0x07, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x22, 0x9c, 0x03, 0x0a, 0x12,
+	0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x49, 0x64, 0x12,
+	0x27, 0x0a, 0x0f, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x62, 0x61, 0x63, 0x6b,
+	0x75, 0x70, 0x5f, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0f, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x5f, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x62, 0x61, 0x63, 0x6b,
+	0x75, 0x70, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x62, 0x61, 0x63, 0x6b,
+	0x75, 0x70, 0x5f, 0x70, 0x76, 0x63, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x50, 0x76, 0x63, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x2c, 0x0a, 0x12, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x5f, 0x73, 0x33, 0x5f, 0x65, 0x6e,
+	0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x62, 0x61,
+	0x63, 0x6b, 0x75, 0x70, 0x53, 0x33, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x28,
+	0x0a, 0x10, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x5f, 0x73, 0x33, 0x5f, 0x62, 0x75, 0x63, 0x6b,
+	0x65, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70,
+	0x53, 0x33, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x2f, 0x0a, 0x14, 0x62, 0x61, 0x63, 0x6b,
+	0x75, 0x70, 0x5f, 0x73, 0x33, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x6b, 0x65, 0x79,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x53, 0x33,
+	0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x12, 0x2f, 0x0a, 0x14, 0x62, 0x61, 0x63,
+	0x6b, 0x75, 0x70, 0x5f, 0x73, 0x33, 0x5f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x5f, 0x6b, 0x65,
+	0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x53,
+	0x33, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x4b, 0x65, 0x79, 0x22, 0xf0, 0x02, 0x0a, 0x0c, 0x4d,
+	0x69, 0x64, 0x64, 0x6c, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6d,
+	0x69, 0x64, 0x64, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x61, 0x63, 0x6b,
+	0x75, 0x70, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62,
+	0x61, 0x63, 0x6b, 0x75, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x62, 0x61, 0x63,
+	0x6b, 0x75, 0x70, 0x5f, 0x6a, 0x6f, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x4a, 0x6f, 0x62, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x5f, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70,
+	0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x62,
+	0x61, 0x63, 0x6b, 0x75, 0x70, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x62,
+	0x61, 0x63, 0x6b, 0x75, 0x70, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x4c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x5f, 0x6d,
+	0x73, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70,
+	0x4d, 0x73, 0x67, 0x12, 0x2a, 0x0a, 0x11, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x5f, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f,
+	0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x2c, 0x0a, 0x12, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x5f, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x62, 0x61, 0x63,
+	0x6b, 0x75, 0x70, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x50, 0x0a,
+	0x0f, 0x41, 0x6c, 0x6c, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70,
+	0x12, 0x3d, 0x0a, 0x0d, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x75,
+	0x70, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65,
+	0x77, 0x61, 0x72, 0x65, 0x2e, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x75,
+	0x70, 0x52, 0x0c, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x22,
+	0x59, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x49, 0x64, 0x12, 0x2a,
+	0x0a, 0x11, 0x69, 0x6e, 0x74, 0x6f, 0x5f, 0x6e, 0x65, 0x77, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x6e, 0x74, 0x6f, 0x4e,
+	0x65, 0x77, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x7a, 0x0a, 0x0e, 0x55, 0x70,
+	0x67, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09,
+	0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6b, 0x69, 0x70, 0x5f, 0x62, 0x61,
+	0x63, 0x6b, 0x75, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x73, 0x6b, 0x69, 0x70,
+	0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x22, 0x1c, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6d, 0x73, 0x67, 0x22, 0x54, 0x0a, 0x0d, 0x41, 0x6c, 0x6c, 0x4d, 0x69, 0x64, 0x64, 0x6c,
+	0x65, 0x77, 0x61, 0x72, 0x65, 0x12, 0x43, 0x0a, 0x0f, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77,
+	0x61, 0x72, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x2e, 0x4d, 0x69, 0x64, 0x64,
+	0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0e, 0x6d, 0x69, 0x64, 0x64,
+	0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0xbf, 0x01, 0x0a, 0x0e, 0x4d,
+	0x69, 0x64, 0x64, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x28, 0x0a,
+	0x10, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x31, 0x0a, 0x15, 0x6d, 0x69, 0x64, 0x64, 0x6c,
+	0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x72, 0x63,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x53, 0x72, 0x63, 0x12, 0x40, 0x0a, 0x0e, 0x6d, 0x69,
+	0x64, 0x64, 0x6c, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x2e,
+	0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x6d,
+	0x69, 0x64, 0x64, 0x6c, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x8c, 0x01, 0x0a,
+	0x0d, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x24,
+	0x0a, 0x0e, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x13, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x5f, 0x64,
+	0x6f, 0x63, 0x6b, 0x65, 0x72, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x11, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x44, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x49,
+	0x6d, 0x61, 0x67, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x5f, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6d, 0x69,
+	0x64, 0x64, 0x6c, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x55, 0x0a, 0x0d, 0x41,
+	0x6c, 0x6c, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x44, 0x0a, 0x10,
+	0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77,
+	0x61, 0x72, 0x65, 0x2e, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x0e, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x49, 0x6e,
+	0x66, 0x6f, 0x22, 0x22, 0x0a, 0x0c, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x79, 0x61, 0x6d, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x79, 0x61, 0x6d, 0x6c, 0x32, 0x91, 0x0d, 0x0a, 0x0a, 0x4d, 0x69, 0x64, 0x64, 0x6c,
+	0x65, 0x77, 0x61, 0x72, 0x65, 0x12, 0x43, 0x0a, 0x0d, 0x41, 0x64, 0x64, 0x4d, 0x69, 0x64, 0x64,
+	0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x12, 0x1a, 0x2e, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77,
+	0x61, 0x72, 0x65, 0x2e, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x49, 0x6e,
+	0x66, 0x6f, 0x1a, 0x14, 0x2e, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x2e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x10, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x12, 0x18,
+	0x2e, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x2e, 0x4d, 0x69, 0x64, 0x64,
+	0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x49, 0x44, 0x1a, 0x14, 0x2e, 0x6d, 0x69, 0x64, 0x64, 0x6c,
+	0x65, 0x77, 0x61, 0x72, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x46, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65,
+	0x77, 0x61, 0x72, 0x65, 0x12, 0x1a, 0x2e, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72,
+	0x65, 0x2e, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x49, 0x6e, 0x66, 0x6f,
+	0x1a, 0x14, 0x2e, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x2e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x12, 0x46, 0x69, 0x6e, 0x64,
+	0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x42, 0x79, 0x49, 0x44, 0x12, 0x18,
+	0x2e, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x2e, 0x4d, 0x69, 0x64, 0x64,
+	0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x49, 0x44, 0x1a, 0x1a, 0x2e, 0x6d, 0x69, 0x64, 0x64, 0x6c,
+	0x65, 0x77, 0x61, 0x72, 0x65, 0x2e, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65,
+	0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x12, 0x65, 0x0a, 0x20, 0x46, 0x69, 0x6e, 0x64, 0x4d, 0x69,
+	0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x41, 0x6e, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x2e, 0x6d, 0x69, 0x64,
+	0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x2e, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61,
+	0x72, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x1a,
 	0x1a, 0x2e, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x2e, 0x4d, 0x69, 0x64,
 	0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x12, 0x45, 0x0a,
 	0x11, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61,
 	0x72, 0x65, 0x12, 0x13, 0x2e, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x2e,
 	0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x1a, 0x19, 0x2e, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65,
 	0x77, 0x61, 0x72, 0x65, 0x2e, 0x41, 0x6c, 0x6c, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61,
-	0x72, 0x65, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x19, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x4d,
-	0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x42, 0x79, 0x54, 0x79, 0x70, 0x65, 0x49,
-	0x44, 0x12, 0x1b, 0x2e, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x2e, 0x46,
-	0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x42, 0x79, 0x54, 0x79, 0x70, 0x65, 0x49, 0x44, 0x1a, 0x19,
-	0x2e, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x2e, 0x41, 0x6c, 0x6c, 0x4d,
-	0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0d, 0x41,
-	0x64, 0x64, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x2e, 0x6d,
-	0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x2e, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65,
-	0x54, 0x79, 0x70, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x14, 0x2e, 0x6d, 0x69, 0x64, 0x64, 0x6c,
-	0x65, 0x77, 0x61, 0x72, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x44, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65,
-	0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x2e, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72,
-	0x65, 0x2e, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x49, 0x44, 0x1a, 0x14,
-	0x2e, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x2e, 0x6d, 0x69, 0x64,
-	0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x2e, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x54, 0x79,
-	0x70, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x14, 0x2e, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77,
-	0x61, 0x72, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c,
-	0x0a, 0x12, 0x46, 0x69, 0x6e, 0x64, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65,
-	0x42, 0x79, 0x49, 0x44, 0x12, 0x18, 0x2e, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72,
-	0x65, 0x2e, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x49, 0x44, 0x1a, 0x1a,
+	0x72, 0x65, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x64, 0x64, 0x6c,
+	0x65, 0x77, 0x61, 0x72, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73,
+	0x12, 0x1e, 0x2e, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x2e, 0x43, 0x72,
+	0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x18, 0x2e, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x2e, 0x4d, 0x69,
+	0x64, 0x64, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x19,
+	0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72,
+	0x65, 0x42, 0x79, 0x54, 0x79, 0x70, 0x65, 0x49, 0x44, 0x12, 0x1b, 0x2e, 0x6d, 0x69, 0x64, 0x64,
+	0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x42, 0x79,
+	0x54, 0x79, 0x70, 0x65, 0x49, 0x44, 0x1a, 0x19, 0x2e, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77,
+	0x61, 0x72, 0x65, 0x2e, 0x41, 0x6c, 0x6c, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72,
+	0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x17, 0x52, 0x65, 0x73, 0x69, 0x7a, 0x65, 0x4d, 0x69, 0x64,
+	0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x12, 0x1f,
 	0x2e, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x2e, 0x4d, 0x69, 0x64, 0x64,
-	0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x11,
-	0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x13, 0x2e, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x2e, 0x46,
-	0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x1a, 0x19, 0x2e, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77,
-	0x61, 0x72, 0x65, 0x2e, 0x41, 0x6c, 0x6c, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x54, 0x79, 0x70,
-	0x65, 0x22, 0x00, 0x42, 0x1f, 0x5a, 0x1d, 0x2e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6d,
-	0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x3b, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65,
-	0x77, 0x61, 0x72, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6c, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x69, 0x7a, 0x65, 0x1a,
+	0x14, 0x2e, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x2e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x42, 0x61,
+	0x63, 0x6b, 0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1e, 0x2e, 0x6d, 0x69, 0x64,
+	0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x2e, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x42, 0x61,
+	0x63, 0x6b, 0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x1a, 0x14, 0x2e, 0x6d, 0x69, 0x64,
+	0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x4e, 0x0a, 0x10, 0x46, 0x69, 0x6e, 0x64, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x18, 0x2e, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77,
+	0x61, 0x72, 0x65, 0x2e, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x49, 0x44,
+	0x1a, 0x1e, 0x2e, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x2e, 0x4d, 0x69,
+	0x64, 0x64, 0x6c, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x22, 0x00, 0x12, 0x46, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x61, 0x63, 0x6b,
+	0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x18, 0x2e, 0x6d, 0x69, 0x64, 0x64, 0x6c,
+	0x65, 0x77, 0x61, 0x72, 0x65, 0x2e, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65,
+	0x49, 0x44, 0x1a, 0x14, 0x2e, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x2e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0b, 0x4c, 0x69,
+	0x73, 0x74, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x73, 0x12, 0x18, 0x2e, 0x6d, 0x69, 0x64, 0x64,
+	0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x2e, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72,
+	0x65, 0x49, 0x44, 0x1a, 0x1b, 0x2e, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65,
+	0x2e, 0x41, 0x6c, 0x6c, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70,
+	0x22, 0x00, 0x12, 0x47, 0x0a, 0x11, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x4d, 0x69, 0x64,
+	0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x12, 0x1a, 0x2e, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65,
+	0x77, 0x61, 0x72, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65,
+	0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x11, 0x55,
+	0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65,
+	0x12, 0x1a, 0x2e, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x2e, 0x55, 0x70,
+	0x67, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x6d,
+	0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x0f, 0x53, 0x63, 0x61, 0x6c, 0x65, 0x4d, 0x69, 0x64,
+	0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x12, 0x17, 0x2e, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65,
+	0x77, 0x61, 0x72, 0x65, 0x2e, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x53, 0x63, 0x61, 0x6c, 0x65,
+	0x1a, 0x14, 0x2e, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x2e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0d, 0x41, 0x64, 0x64, 0x4d,
+	0x69, 0x64, 0x64, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x2e, 0x6d, 0x69, 0x64, 0x64,
+	0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x2e, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x14, 0x2e, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61,
+	0x72, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x44, 0x0a,
+	0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x18, 0x2e, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x2e, 0x4d,
+	0x69, 0x64, 0x64, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x49, 0x44, 0x1a, 0x14, 0x2e, 0x6d, 0x69,
+	0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x69, 0x64,
+	0x64, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x2e, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65,
+	0x77, 0x61, 0x72, 0x65, 0x2e, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x49,
+	0x6e, 0x66, 0x6f, 0x1a, 0x14, 0x2e, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65,
+	0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x12, 0x46,
+	0x69, 0x6e, 0x64, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x42, 0x79, 0x49,
+	0x44, 0x12, 0x18, 0x2e, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x2e, 0x4d,
+	0x69, 0x64, 0x64, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x49, 0x44, 0x1a, 0x1a, 0x2e, 0x6d, 0x69,
+	0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x2e, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x11, 0x46, 0x69, 0x6e,
+	0x64, 0x41, 0x6c, 0x6c, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x13,
+	0x2e, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x2e, 0x46, 0x69, 0x6e, 0x64,
+	0x41, 0x6c, 0x6c, 0x1a, 0x19, 0x2e, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65,
+	0x2e, 0x41, 0x6c, 0x6c, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x00,
+	0x12, 0x48, 0x0a, 0x10, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65,
+	0x77, 0x61, 0x72, 0x65, 0x12, 0x18, 0x2e, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72,
+	0x65, 0x2e, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x49, 0x44, 0x1a, 0x18,
+	0x2e, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x2e, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x00, 0x42, 0x1f, 0x5a, 0x1d, 0x2e, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65,
+	0x3b, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1125,58 +2025,91 @@ func file_proto_middleware_middleware_proto_rawDescGZIP() []byte {
 	return file_proto_middleware_middleware_proto_rawDescData
 }
 
-var file_proto_middleware_middleware_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_proto_middleware_middleware_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
 var file_proto_middleware_middleware_proto_goTypes = []interface{}{
-	(*MiddlewareInfo)(nil),  // 0: middleware.MiddlewareInfo
-	(*MiddlePort)(nil),      // 1: middleware.MiddlePort
-	(*MiddleConfig)(nil),    // 2: middleware.MiddleConfig
-	(*MiddleEnv)(nil),       // 3: middleware.MiddleEnv
-	(*MiddleStorage)(nil),   // 4: middleware.MiddleStorage
-	(*FindAllByTypeID)(nil), // 5: middleware.FindAllByTypeID
-	(*MiddleTypeID)(nil),    // 6: middleware.MiddleTypeID
-	(*MiddlewareID)(nil),    // 7: middleware.MiddlewareID
-	(*FindAll)(nil),         // 8: middleware.FindAll
-	(*Response)(nil),        // 9: middleware.Response
-	(*AllMiddleware)(nil),   // 10: middleware.AllMiddleware
-	(*MiddleTypeInfo)(nil),  // 11: middleware.MiddleTypeInfo
-	(*MiddleVersion)(nil),   // 12: middleware.MiddleVersion
-	(*AllMiddleType)(nil),   // 13: middleware.AllMiddleType
+	(*MiddlewareInfo)(nil),          // 0: middleware.MiddlewareInfo
+	(*MiddlePort)(nil),              // 1: middleware.MiddlePort
+	(*MiddleConfig)(nil),            // 2: middleware.MiddleConfig
+	(*MiddleEnv)(nil),               // 3: middleware.MiddleEnv
+	(*MiddleStorage)(nil),           // 4: middleware.MiddleStorage
+	(*MiddleStorageResize)(nil),     // 5: middleware.MiddleStorageResize
+	(*MiddleScale)(nil),             // 6: middleware.MiddleScale
+	(*FindAllByTypeID)(nil),         // 7: middleware.FindAllByTypeID
+	(*MiddleTypeID)(nil),            // 8: middleware.MiddleTypeID
+	(*MiddlewareID)(nil),            // 9: middleware.MiddlewareID
+	(*MiddlewareNamespaceName)(nil), // 10: middleware.MiddlewareNamespaceName
+	(*CredentialsRequest)(nil),      // 11: middleware.CredentialsRequest
+	(*FindAll)(nil),                 // 12: middleware.FindAll
+	(*MiddleBackupPolicy)(nil),      // 13: middleware.MiddleBackupPolicy
+	(*MiddleBackup)(nil),            // 14: middleware.MiddleBackup
+	(*AllMiddleBackup)(nil),         // 15: middleware.AllMiddleBackup
+	(*RestoreRequest)(nil),          // 16: middleware.RestoreRequest
+	(*UpgradeRequest)(nil),          // 17: middleware.UpgradeRequest
+	(*Response)(nil),                // 18: middleware.Response
+	(*AllMiddleware)(nil),           // 19: middleware.AllMiddleware
+	(*MiddleTypeInfo)(nil),          // 20: middleware.MiddleTypeInfo
+	(*MiddleVersion)(nil),           // 21: middleware.MiddleVersion
+	(*AllMiddleType)(nil),           // 22: middleware.AllMiddleType
+	(*ExportResult)(nil),            // 23: middleware.ExportResult
 }
 var file_proto_middleware_middleware_proto_depIdxs = []int32{
 	1,  // 0: middleware.MiddlewareInfo.middle_port:type_name -> middleware.MiddlePort
 	2,  // 1: middleware.MiddlewareInfo.middle_config:type_name -> middleware.MiddleConfig
 	3,  // 2: middleware.MiddlewareInfo.middle_env:type_name -> middleware.MiddleEnv
 	4,  // 3: middleware.MiddlewareInfo.middle_storage:type_name -> middleware.MiddleStorage
-	0,  // 4: middleware.AllMiddleware.middleware_info:type_name -> middleware.MiddlewareInfo
-	12, // 5: middleware.MiddleTypeInfo.middle_version:type_name -> middleware.MiddleVersion
-	11, // 6: middleware.AllMiddleType.middle_type_info:type_name -> middleware.MiddleTypeInfo
-	0,  // 7: middleware.Middleware.AddMiddleware:input_type -> middleware.MiddlewareInfo
-	7,  // 8: middleware.Middleware.DeleteMiddleware:input_type -> middleware.MiddlewareID
-	0,  // 9: middleware.Middleware.UpdateMiddleware:input_type -> middleware.MiddlewareInfo
-	7,  // 10: middleware.Middleware.FindMiddlewareByID:input_type -> middleware.MiddlewareID
-	8,  // 11: middleware.Middleware.FindAllMiddleware:input_type -> middleware.FindAll
-	5,  // 12: middleware.Middleware.FindAllMiddlewareByTypeID:input_type -> middleware.FindAllByTypeID
-	11, // 13: middleware.Middleware.AddMiddleType:input_type -> middleware.MiddleTypeInfo
-	6,  // 14: middleware.Middleware.DeleteMiddleType:input_type -> middleware.MiddleTypeID
-	11, // 15: middleware.Middleware.UpdateMiddleType:input_type -> middleware.MiddleTypeInfo
-	6,  // 16: middleware.Middleware.FindMiddleTypeByID:input_type -> middleware.MiddleTypeID
-	8,  // 17: middleware.Middleware.FindAllMiddleType:input_type -> middleware.FindAll
-	9,  // 18: middleware.Middleware.AddMiddleware:output_type -> middleware.Response
-	9,  // 19: middleware.Middleware.DeleteMiddleware:output_type -> middleware.Response
-	9,  // 20: middleware.Middleware.UpdateMiddleware:output_type -> middleware.Response
-	0,  // 21: middleware.Middleware.FindMiddlewareByID:output_type -> middleware.MiddlewareInfo
-	10, // 22: middleware.Middleware.FindAllMiddleware:output_type -> middleware.AllMiddleware
-	10, // 23: middleware.Middleware.FindAllMiddlewareByTypeID:output_type -> middleware.AllMiddleware
-	9,  // 24: middleware.Middleware.AddMiddleType:output_type -> middleware.Response
-	9,  // 25: middleware.Middleware.DeleteMiddleType:output_type -> middleware.Response
-	9,  // 26: middleware.Middleware.UpdateMiddleType:output_type -> middleware.Response
-	11, // 27: middleware.Middleware.FindMiddleTypeByID:output_type -> middleware.MiddleTypeInfo
-	13, // 28: middleware.Middleware.FindAllMiddleType:output_type -> middleware.AllMiddleType
-	18, // [18:29] is the sub-list for method output_type
-	7,  // [7:18] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	14, // 4: middleware.AllMiddleBackup.middle_backup:type_name -> middleware.MiddleBackup
+	0,  // 5: middleware.AllMiddleware.middleware_info:type_name -> middleware.MiddlewareInfo
+	21, // 6: middleware.MiddleTypeInfo.middle_version:type_name -> middleware.MiddleVersion
+	20, // 7: middleware.AllMiddleType.middle_type_info:type_name -> middleware.MiddleTypeInfo
+	0,  // 8: middleware.Middleware.AddMiddleware:input_type -> middleware.MiddlewareInfo
+	9,  // 9: middleware.Middleware.DeleteMiddleware:input_type -> middleware.MiddlewareID
+	0,  // 10: middleware.Middleware.UpdateMiddleware:input_type -> middleware.MiddlewareInfo
+	9,  // 11: middleware.Middleware.FindMiddlewareByID:input_type -> middleware.MiddlewareID
+	10, // 12: middleware.Middleware.FindMiddlewareByNamespaceAndName:input_type -> middleware.MiddlewareNamespaceName
+	12, // 13: middleware.Middleware.FindAllMiddleware:input_type -> middleware.FindAll
+	11, // 14: middleware.Middleware.GetMiddlewareCredentials:input_type -> middleware.CredentialsRequest
+	7,  // 15: middleware.Middleware.FindAllMiddlewareByTypeID:input_type -> middleware.FindAllByTypeID
+	5,  // 16: middleware.Middleware.ResizeMiddlewareStorage:input_type -> middleware.MiddleStorageResize
+	13, // 17: middleware.Middleware.SetBackupPolicy:input_type -> middleware.MiddleBackupPolicy
+	9,  // 18: middleware.Middleware.FindBackupPolicy:input_type -> middleware.MiddlewareID
+	9,  // 19: middleware.Middleware.DeleteBackupPolicy:input_type -> middleware.MiddlewareID
+	9,  // 20: middleware.Middleware.ListBackups:input_type -> middleware.MiddlewareID
+	16, // 21: middleware.Middleware.RestoreMiddleware:input_type -> middleware.RestoreRequest
+	17, // 22: middleware.Middleware.UpgradeMiddleware:input_type -> middleware.UpgradeRequest
+	6,  // 23: middleware.Middleware.ScaleMiddleware:input_type -> middleware.MiddleScale
+	20, // 24: middleware.Middleware.AddMiddleType:input_type -> middleware.MiddleTypeInfo
+	8,  // 25: middleware.Middleware.DeleteMiddleType:input_type -> middleware.MiddleTypeID
+	20, // 26: middleware.Middleware.UpdateMiddleType:input_type -> middleware.MiddleTypeInfo
+	8,  // 27: middleware.Middleware.FindMiddleTypeByID:input_type -> middleware.MiddleTypeID
+	12, // 28: middleware.Middleware.FindAllMiddleType:input_type -> middleware.FindAll
+	9,  // 29: middleware.Middleware.ExportMiddleware:input_type -> middleware.MiddlewareID
+	18, // 30: middleware.Middleware.AddMiddleware:output_type -> middleware.Response
+	18, // 31: middleware.Middleware.DeleteMiddleware:output_type -> middleware.Response
+	18, // 32: middleware.Middleware.UpdateMiddleware:output_type -> middleware.Response
+	0,  // 33: middleware.Middleware.FindMiddlewareByID:output_type -> middleware.MiddlewareInfo
+	0,  // 34: middleware.Middleware.FindMiddlewareByNamespaceAndName:output_type -> middleware.MiddlewareInfo
+	19, // 35: middleware.Middleware.FindAllMiddleware:output_type -> middleware.AllMiddleware
+	2,  // 36: middleware.Middleware.GetMiddlewareCredentials:output_type -> middleware.MiddleConfig
+	19, // 37: middleware.Middleware.FindAllMiddlewareByTypeID:output_type -> middleware.AllMiddleware
+	18, // 38: middleware.Middleware.ResizeMiddlewareStorage:output_type -> middleware.Response
+	18, // 39: middleware.Middleware.SetBackupPolicy:output_type -> middleware.Response
+	13, // 40: middleware.Middleware.FindBackupPolicy:output_type -> middleware.MiddleBackupPolicy
+	18, // 41: middleware.Middleware.DeleteBackupPolicy:output_type -> middleware.Response
+	15, // 42: middleware.Middleware.ListBackups:output_type -> middleware.AllMiddleBackup
+	18, // 43: middleware.Middleware.RestoreMiddleware:output_type -> middleware.Response
+	18, // 44: middleware.Middleware.UpgradeMiddleware:output_type -> middleware.Response
+	18, // 45: middleware.Middleware.ScaleMiddleware:output_type -> middleware.Response
+	18, // 46: middleware.Middleware.AddMiddleType:output_type -> middleware.Response
+	18, // 47: middleware.Middleware.DeleteMiddleType:output_type -> middleware.Response
+	18, // 48: middleware.Middleware.UpdateMiddleType:output_type -> middleware.Response
+	20, // 49: middleware.Middleware.FindMiddleTypeByID:output_type -> middleware.MiddleTypeInfo
+	22, // 50: middleware.Middleware.FindAllMiddleType:output_type -> middleware.AllMiddleType
+	23, // 51: middleware.Middleware.ExportMiddleware:output_type -> middleware.ExportResult
+	30, // [30:52] is the sub-list for method output_type
+	8,  // [8:30] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_proto_middleware_middleware_proto_init() }
@@ -1246,7 +2179,7 @@ func file_proto_middleware_middleware_proto_init() {
 			}
 		}
 		file_proto_middleware_middleware_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FindAllByTypeID); i {
+			switch v := v.(*MiddleStorageResize); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1258,7 +2191,7 @@ func file_proto_middleware_middleware_proto_init() {
 			}
 		}
 		file_proto_middleware_middleware_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MiddleTypeID); i {
+			switch v := v.(*MiddleScale); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1270,7 +2203,7 @@ func file_proto_middleware_middleware_proto_init() {
 			}
 		}
 		file_proto_middleware_middleware_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MiddlewareID); i {
+			switch v := v.(*FindAllByTypeID); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1282,7 +2215,7 @@ func file_proto_middleware_middleware_proto_init() {
 			}
 		}
 		file_proto_middleware_middleware_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FindAll); i {
+			switch v := v.(*MiddleTypeID); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1294,7 +2227,7 @@ func file_proto_middleware_middleware_proto_init() {
 			}
 		}
 		file_proto_middleware_middleware_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Response); i {
+			switch v := v.(*MiddlewareID); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1306,7 +2239,7 @@ func file_proto_middleware_middleware_proto_init() {
 			}
 		}
 		file_proto_middleware_middleware_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AllMiddleware); i {
+			switch v := v.(*MiddlewareNamespaceName); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1318,7 +2251,7 @@ func file_proto_middleware_middleware_proto_init() {
 			}
 		}
 		file_proto_middleware_middleware_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MiddleTypeInfo); i {
+			switch v := v.(*CredentialsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1330,7 +2263,7 @@ func file_proto_middleware_middleware_proto_init() {
 			}
 		}
 		file_proto_middleware_middleware_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MiddleVersion); i {
+			switch v := v.(*FindAll); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1342,6 +2275,114 @@ func file_proto_middleware_middleware_proto_init() {
 			}
 		}
 		file_proto_middleware_middleware_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MiddleBackupPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_middleware_middleware_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MiddleBackup); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_middleware_middleware_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AllMiddleBackup); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_middleware_middleware_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_middleware_middleware_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpgradeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_middleware_middleware_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_middleware_middleware_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AllMiddleware); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_middleware_middleware_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MiddleTypeInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_middleware_middleware_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MiddleVersion); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_middleware_middleware_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AllMiddleType); i {
 			case 0:
 				return &v.state
@@ -1353,6 +2394,18 @@ func file_proto_middleware_middleware_proto_init() {
 				return nil
 			}
 		}
+		file_proto_middleware_middleware_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1360,7 +2413,7 @@ func file_proto_middleware_middleware_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_middleware_middleware_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   14,
+			NumMessages:   24,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -41,15 +41,32 @@ type MiddlewareService interface {
 	DeleteMiddleware(ctx context.Context, in *MiddlewareID, opts ...client.CallOption) (*Response, error)
 	UpdateMiddleware(ctx context.Context, in *MiddlewareInfo, opts ...client.CallOption) (*Response, error)
 	FindMiddlewareByID(ctx context.Context, in *MiddlewareID, opts ...client.CallOption) (*MiddlewareInfo, error)
+	FindMiddlewareByNamespaceAndName(ctx context.Context, in *MiddlewareNamespaceName, opts ...client.CallOption) (*MiddlewareInfo, error)
 	FindAllMiddleware(ctx context.Context, in *FindAll, opts ...client.CallOption) (*AllMiddleware, error)
+	// 获取中间件的账号密码，需要有权限的用户
+	GetMiddlewareCredentials(ctx context.Context, in *CredentialsRequest, opts ...client.CallOption) (*MiddleConfig, error)
 	// 根据中间件的类型查找所有中间件
 	FindAllMiddlewareByTypeID(ctx context.Context, in *FindAllByTypeID, opts ...client.CallOption) (*AllMiddleware, error)
+	// 在线扩容存储
+	ResizeMiddlewareStorage(ctx context.Context, in *MiddleStorageResize, opts ...client.CallOption) (*Response, error)
+	// 定时备份和恢复
+	SetBackupPolicy(ctx context.Context, in *MiddleBackupPolicy, opts ...client.CallOption) (*Response, error)
+	FindBackupPolicy(ctx context.Context, in *MiddlewareID, opts ...client.CallOption) (*MiddleBackupPolicy, error)
+	DeleteBackupPolicy(ctx context.Context, in *MiddlewareID, opts ...client.CallOption) (*Response, error)
+	ListBackups(ctx context.Context, in *MiddlewareID, opts ...client.CallOption) (*AllMiddleBackup, error)
+	RestoreMiddleware(ctx context.Context, in *RestoreRequest, opts ...client.CallOption) (*Response, error)
+	// 升级到同一类型的其他版本
+	UpgradeMiddleware(ctx context.Context, in *UpgradeRequest, opts ...client.CallOption) (*Response, error)
+	// 调整副本数，为0时停止中间件，数据库中保存调整后的副本数
+	ScaleMiddleware(ctx context.Context, in *MiddleScale, opts ...client.CallOption) (*Response, error)
 	// 中间件类型
 	AddMiddleType(ctx context.Context, in *MiddleTypeInfo, opts ...client.CallOption) (*Response, error)
 	DeleteMiddleType(ctx context.Context, in *MiddleTypeID, opts ...client.CallOption) (*Response, error)
 	UpdateMiddleType(ctx context.Context, in *MiddleTypeInfo, opts ...client.CallOption) (*Response, error)
 	FindMiddleTypeByID(ctx context.Context, in *MiddleTypeID, opts ...client.CallOption) (*MiddleTypeInfo, error)
 	FindAllMiddleType(ctx context.Context, in *FindAll, opts ...client.CallOption) (*AllMiddleType, error)
+	// 导出应用到k8s的对象，多文档YAML
+	ExportMiddleware(ctx context.Context, in *MiddlewareID, opts ...client.CallOption) (*ExportResult, error)
 }
 
 type middlewareService struct {
@@ -104,6 +121,16 @@ func (c *middlewareService) FindMiddlewareByID(ctx context.Context, in *Middlewa
 	return out, nil
 }
 
+func (c *middlewareService) FindMiddlewareByNamespaceAndName(ctx context.Context, in *MiddlewareNamespaceName, opts ...client.CallOption) (*MiddlewareInfo, error) {
+	req := c.c.NewRequest(c.name, "Middleware.FindMiddlewareByNamespaceAndName", in)
+	out := new(MiddlewareInfo)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *middlewareService) FindAllMiddleware(ctx context.Context, in *FindAll, opts ...client.CallOption) (*AllMiddleware, error) {
 	req := c.c.NewRequest(c.name, "Middleware.FindAllMiddleware", in)
 	out := new(AllMiddleware)
@@ -114,6 +141,16 @@ func (c *middlewareService) FindAllMiddleware(ctx context.Context, in *FindAll,
 	return out, nil
 }
 
+func (c *middlewareService) GetMiddlewareCredentials(ctx context.Context, in *CredentialsRequest, opts ...client.CallOption) (*MiddleConfig, error) {
+	req := c.c.NewRequest(c.name, "Middleware.GetMiddlewareCredentials", in)
+	out := new(MiddleConfig)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *middlewareService) FindAllMiddlewareByTypeID(ctx context.Context, in *FindAllByTypeID, opts ...client.CallOption) (*AllMiddleware, error) {
 	req := c.c.NewRequest(c.name, "Middleware.FindAllMiddlewareByTypeID", in)
 	out := new(AllMiddleware)
@@ -124,6 +161,86 @@ func (c *middlewareService) FindAllMiddlewareByTypeID(ctx context.Context, in *F
 	return out, nil
 }
 
+func (c *middlewareService) ResizeMiddlewareStorage(ctx context.Context, in *MiddleStorageResize, opts ...client.CallOption) (*Response, error) {
+	req := c.c.NewRequest(c.name, "Middleware.ResizeMiddlewareStorage", in)
+	out := new(Response)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *middlewareService) SetBackupPolicy(ctx context.Context, in *MiddleBackupPolicy, opts ...client.CallOption) (*Response, error) {
+	req := c.c.NewRequest(c.name, "Middleware.SetBackupPolicy", in)
+	out := new(Response)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *middlewareService) FindBackupPolicy(ctx context.Context, in *MiddlewareID, opts ...client.CallOption) (*MiddleBackupPolicy, error) {
+	req := c.c.NewRequest(c.name, "Middleware.FindBackupPolicy", in)
+	out := new(MiddleBackupPolicy)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *middlewareService) DeleteBackupPolicy(ctx context.Context, in *MiddlewareID, opts ...client.CallOption) (*Response, error) {
+	req := c.c.NewRequest(c.name, "Middleware.DeleteBackupPolicy", in)
+	out := new(Response)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *middlewareService) ListBackups(ctx context.Context, in *MiddlewareID, opts ...client.CallOption) (*AllMiddleBackup, error) {
+	req := c.c.NewRequest(c.name, "Middleware.ListBackups", in)
+	out := new(AllMiddleBackup)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *middlewareService) RestoreMiddleware(ctx context.Context, in *RestoreRequest, opts ...client.CallOption) (*Response, error) {
+	req := c.c.NewRequest(c.name, "Middleware.RestoreMiddleware", in)
+	out := new(Response)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *middlewareService) UpgradeMiddleware(ctx context.Context, in *UpgradeRequest, opts ...client.CallOption) (*Response, error) {
+	req := c.c.NewRequest(c.name, "Middleware.UpgradeMiddleware", in)
+	out := new(Response)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *middlewareService) ScaleMiddleware(ctx context.Context, in *MiddleScale, opts ...client.CallOption) (*Response, error) {
+	req := c.c.NewRequest(c.name, "Middleware.ScaleMiddleware", in)
+	out := new(Response)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *middlewareService) AddMiddleType(ctx context.Context, in *MiddleTypeInfo, opts ...client.CallOption) (*Response, error) {
 	req := c.c.NewRequest(c.name, "Middleware.AddMiddleType", in)
 	out := new(Response)
@@ -174,6 +291,16 @@ func (c *middlewareService) FindAllMiddleType(ctx context.Context, in *FindAll,
 	return out, nil
 }
 
+func (c *middlewareService) ExportMiddleware(ctx context.Context, in *MiddlewareID, opts ...client.CallOption) (*ExportResult, error) {
+	req := c.c.NewRequest(c.name, "Middleware.ExportMiddleware", in)
+	out := new(ExportResult)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for Middleware service
 
 type MiddlewareHandler interface {
@@ -182,15 +309,32 @@ type MiddlewareHandler interface {
 	DeleteMiddleware(context.Context, *MiddlewareID, *Response) error
 	UpdateMiddleware(context.Context, *MiddlewareInfo, *Response) error
 	FindMiddlewareByID(context.Context, *MiddlewareID, *MiddlewareInfo) error
+	FindMiddlewareByNamespaceAndName(context.Context, *MiddlewareNamespaceName, *MiddlewareInfo) error
 	FindAllMiddleware(context.Context, *FindAll, *AllMiddleware) error
+	// 获取中间件的账号密码，需要有权限的用户
+	GetMiddlewareCredentials(context.Context, *CredentialsRequest, *MiddleConfig) error
 	// 根据中间件的类型查找所有中间件
 	FindAllMiddlewareByTypeID(context.Context, *FindAllByTypeID, *AllMiddleware) error
+	// 在线扩容存储
+	ResizeMiddlewareStorage(context.Context, *MiddleStorageResize, *Response) error
+	// 定时备份和恢复
+	SetBackupPolicy(context.Context, *MiddleBackupPolicy, *Response) error
+	FindBackupPolicy(context.Context, *MiddlewareID, *MiddleBackupPolicy) error
+	DeleteBackupPolicy(context.Context, *MiddlewareID, *Response) error
+	ListBackups(context.Context, *MiddlewareID, *AllMiddleBackup) error
+	RestoreMiddleware(context.Context, *RestoreRequest, *Response) error
+	// 升级到同一类型的其他版本
+	UpgradeMiddleware(context.Context, *UpgradeRequest, *Response) error
+	// 调整副本数，为0时停止中间件，数据库中保存调整后的副本数
+	ScaleMiddleware(context.Context, *MiddleScale, *Response) error
 	// 中间件类型
 	AddMiddleType(context.Context, *MiddleTypeInfo, *Response) error
 	DeleteMiddleType(context.Context, *MiddleTypeID, *Response) error
 	UpdateMiddleType(context.Context, *MiddleTypeInfo, *Response) error
 	FindMiddleTypeByID(context.Context, *MiddleTypeID, *MiddleTypeInfo) error
 	FindAllMiddleType(context.Context, *FindAll, *AllMiddleType) error
+	// 导出应用到k8s的对象，多文档YAML
+	ExportMiddleware(context.Context, *MiddlewareID, *ExportResult) error
 }
 
 func RegisterMiddlewareHandler(s server.Server, hdlr MiddlewareHandler, opts ...server.HandlerOption) error {
@@ -199,13 +343,24 @@ func RegisterMiddlewareHandler(s server.Server, hdlr MiddlewareHandler, opts ...
 		DeleteMiddleware(ctx context.Context, in *MiddlewareID, out *Response) error
 		UpdateMiddleware(ctx context.Context, in *MiddlewareInfo, out *Response) error
 		FindMiddlewareByID(ctx context.Context, in *MiddlewareID, out *MiddlewareInfo) error
+		FindMiddlewareByNamespaceAndName(ctx context.Context, in *MiddlewareNamespaceName, out *MiddlewareInfo) error
 		FindAllMiddleware(ctx context.Context, in *FindAll, out *AllMiddleware) error
+		GetMiddlewareCredentials(ctx context.Context, in *CredentialsRequest, out *MiddleConfig) error
 		FindAllMiddlewareByTypeID(ctx context.Context, in *FindAllByTypeID, out *AllMiddleware) error
+		ResizeMiddlewareStorage(ctx context.Context, in *MiddleStorageResize, out *Response) error
+		SetBackupPolicy(ctx context.Context, in *MiddleBackupPolicy, out *Response) error
+		FindBackupPolicy(ctx context.Context, in *MiddlewareID, out *MiddleBackupPolicy) error
+		DeleteBackupPolicy(ctx context.Context, in *MiddlewareID, out *Response) error
+		ListBackups(ctx context.Context, in *MiddlewareID, out *AllMiddleBackup) error
+		RestoreMiddleware(ctx context.Context, in *RestoreRequest, out *Response) error
+		UpgradeMiddleware(ctx context.Context, in *UpgradeRequest, out *Response) error
+		ScaleMiddleware(ctx context.Context, in *MiddleScale, out *Response) error
 		AddMiddleType(ctx context.Context, in *MiddleTypeInfo, out *Response) error
 		DeleteMiddleType(ctx context.Context, in *MiddleTypeID, out *Response) error
 		UpdateMiddleType(ctx context.Context, in *MiddleTypeInfo, out *Response) error
 		FindMiddleTypeByID(ctx context.Context, in *MiddleTypeID, out *MiddleTypeInfo) error
 		FindAllMiddleType(ctx context.Context, in *FindAll, out *AllMiddleType) error
+		ExportMiddleware(ctx context.Context, in *MiddlewareID, out *ExportResult) error
 	}
 	type Middleware struct {
 		middleware
@@ -234,14 +389,54 @@ func (h *middlewareHandler) FindMiddlewareByID(ctx context.Context, in *Middlewa
 	return h.MiddlewareHandler.FindMiddlewareByID(ctx, in, out)
 }
 
+func (h *middlewareHandler) FindMiddlewareByNamespaceAndName(ctx context.Context, in *MiddlewareNamespaceName, out *MiddlewareInfo) error {
+	return h.MiddlewareHandler.FindMiddlewareByNamespaceAndName(ctx, in, out)
+}
+
 func (h *middlewareHandler) FindAllMiddleware(ctx context.Context, in *FindAll, out *AllMiddleware) error {
 	return h.MiddlewareHandler.FindAllMiddleware(ctx, in, out)
 }
 
+func (h *middlewareHandler) GetMiddlewareCredentials(ctx context.Context, in *CredentialsRequest, out *MiddleConfig) error {
+	return h.MiddlewareHandler.GetMiddlewareCredentials(ctx, in, out)
+}
+
 func (h *middlewareHandler) FindAllMiddlewareByTypeID(ctx context.Context, in *FindAllByTypeID, out *AllMiddleware) error {
 	return h.MiddlewareHandler.FindAllMiddlewareByTypeID(ctx, in, out)
 }
 
+func (h *middlewareHandler) ResizeMiddlewareStorage(ctx context.Context, in *MiddleStorageResize, out *Response) error {
+	return h.MiddlewareHandler.ResizeMiddlewareStorage(ctx, in, out)
+}
+
+func (h *middlewareHandler) SetBackupPolicy(ctx context.Context, in *MiddleBackupPolicy, out *Response) error {
+	return h.MiddlewareHandler.SetBackupPolicy(ctx, in, out)
+}
+
+func (h *middlewareHandler) FindBackupPolicy(ctx context.Context, in *MiddlewareID, out *MiddleBackupPolicy) error {
+	return h.MiddlewareHandler.FindBackupPolicy(ctx, in, out)
+}
+
+func (h *middlewareHandler) DeleteBackupPolicy(ctx context.Context, in *MiddlewareID, out *Response) error {
+	return h.MiddlewareHandler.DeleteBackupPolicy(ctx, in, out)
+}
+
+func (h *middlewareHandler) ListBackups(ctx context.Context, in *MiddlewareID, out *AllMiddleBackup) error {
+	return h.MiddlewareHandler.ListBackups(ctx, in, out)
+}
+
+func (h *middlewareHandler) RestoreMiddleware(ctx context.Context, in *RestoreRequest, out *Response) error {
+	return h.MiddlewareHandler.RestoreMiddleware(ctx, in, out)
+}
+
+func (h *middlewareHandler) UpgradeMiddleware(ctx context.Context, in *UpgradeRequest, out *Response) error {
+	return h.MiddlewareHandler.UpgradeMiddleware(ctx, in, out)
+}
+
+func (h *middlewareHandler) ScaleMiddleware(ctx context.Context, in *MiddleScale, out *Response) error {
+	return h.MiddlewareHandler.ScaleMiddleware(ctx, in, out)
+}
+
 func (h *middlewareHandler) AddMiddleType(ctx context.Context, in *MiddleTypeInfo, out *Response) error {
 	return h.MiddlewareHandler.AddMiddleType(ctx, in, out)
 }
@@ -261,3 +456,7 @@ func (h *middlewareHandler) FindMiddleTypeByID(ctx context.Context, in *MiddleTy
 func (h *middlewareHandler) FindAllMiddleType(ctx context.Context, in *FindAll, out *AllMiddleType) error {
 	return h.MiddlewareHandler.FindAllMiddleType(ctx, in, out)
 }
+
+func (h *middlewareHandler) ExportMiddleware(ctx context.Context, in *MiddlewareID, out *ExportResult) error {
+	return h.MiddlewareHandler.ExportMiddleware(ctx, in, out)
+}
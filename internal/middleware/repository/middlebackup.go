@@ -0,0 +1,143 @@
+package repository
+
+import (
+	"github.com/jinzhu/gorm"
+	"tini-paas/internal/middleware/model"
+	"tini-paas/pkg/common"
+)
+
+// MiddleBackupRepository 中间件备份策略和备份记录操作
+type MiddleBackupRepository interface {
+	// InitTable 初始化表
+	InitTable() error
+
+	// MigrateTable 迁移已有数据表，备份任务名称改为同一中间件内唯一，并创建恢复记录表
+	MigrateTable() error
+
+	// SavePolicy 创建或更新中间件的备份策略
+	SavePolicy(*model.MiddleBackupPolicy) error
+
+	// DeletePolicyByMiddleID 删除中间件的备份策略
+	DeletePolicyByMiddleID(int64) error
+
+	// FindPolicyByMiddleID 查找中间件的备份策略
+	FindPolicyByMiddleID(int64) (*model.MiddleBackupPolicy, error)
+
+	// CreateBackup 创建备份记录
+	CreateBackup(*model.MiddleBackup) (int64, error)
+
+	// UpdateBackup 更新备份记录
+	UpdateBackup(*model.MiddleBackup) error
+
+	// DeleteBackupByID 删除备份记录
+	DeleteBackupByID(int64) error
+
+	// FindBackupByID 查找备份记录
+	FindBackupByID(int64) (*model.MiddleBackup, error)
+
+	// FindAllBackupByMiddleID 查找中间件的备份记录，新的在前
+	FindAllBackupByMiddleID(int64) ([]model.MiddleBackup, error)
+
+	// CreateRestore 创建恢复记录
+	CreateRestore(*model.MiddleRestore) (int64, error)
+
+	// UpdateRestore 更新恢复记录
+	UpdateRestore(*model.MiddleRestore) error
+
+	// FindAllRestoreByStatus 查找指定状态的恢复记录
+	FindAllRestoreByStatus(...string) ([]model.MiddleRestore, error)
+}
+
+// NewMiddleBackupRepository 初始化MiddleBackupRepository
+func NewMiddleBackupRepository(db *gorm.DB) MiddleBackupRepository {
+	return &MiddleBackup{
+		db: db,
+	}
+}
+
+// MiddleBackup 中间件备份repository
+type MiddleBackup struct {
+	db *gorm.DB
+}
+
+// InitTable 初始化表
+func (m *MiddleBackup) InitTable() error {
+	return m.db.CreateTable(&model.MiddleBackupPolicy{}, &model.MiddleBackup{}, &model.MiddleRestore{}).Error
+}
+
+// MigrateTable 迁移已有数据表，备份任务名称改为同一中间件内唯一，并创建缺少的备份和恢复记录表
+func (m *MiddleBackup) MigrateTable() error {
+	err := common.MigrateUniqueIndex(m.db, &model.MiddleBackup{}, "uix_middle_backups_backup_job_name", "idx_middle_backups_middle_job", "middle_id", "backup_job_name")
+	if err != nil {
+		return err
+	}
+	return common.MigrateTables(m.db, &model.MiddleBackupPolicy{}, &model.MiddleBackup{}, &model.MiddleRestore{})
+}
+
+// SavePolicy 创建或更新中间件的备份策略
+func (m *MiddleBackup) SavePolicy(policy *model.MiddleBackupPolicy) error {
+	exist := &model.MiddleBackupPolicy{}
+	err := m.db.Where("middle_id = ?", policy.MiddleID).First(exist).Error
+	if err != nil && !gorm.IsRecordNotFoundError(err) {
+		return err
+	}
+	policy.ID = exist.ID
+	// Save 会更新所有字段，保留次数等允许设置为0
+	return m.db.Save(policy).Error
+}
+
+// DeletePolicyByMiddleID 删除中间件的备份策略
+func (m *MiddleBackup) DeletePolicyByMiddleID(middleID int64) error {
+	return m.db.Where("middle_id = ?", middleID).Delete(&model.MiddleBackupPolicy{}).Error
+}
+
+// FindPolicyByMiddleID 查找中间件的备份策略
+func (m *MiddleBackup) FindPolicyByMiddleID(middleID int64) (*model.MiddleBackupPolicy, error) {
+	policy := &model.MiddleBackupPolicy{}
+	return policy, m.db.Where("middle_id = ?", middleID).First(policy).Error
+}
+
+// CreateBackup 创建备份记录
+func (m *MiddleBackup) CreateBackup(backup *model.MiddleBackup) (int64, error) {
+	err := m.db.Create(backup).Error
+	return backup.ID, err
+}
+
+// UpdateBackup 更新备份记录
+func (m *MiddleBackup) UpdateBackup(backup *model.MiddleBackup) error {
+	return m.db.Model(backup).Update(backup).Error
+}
+
+// DeleteBackupByID 删除备份记录
+func (m *MiddleBackup) DeleteBackupByID(i int64) error {
+	return m.db.Where("id = ?", i).Delete(&model.MiddleBackup{}).Error
+}
+
+// FindBackupByID 查找备份记录
+func (m *MiddleBackup) FindBackupByID(i int64) (*model.MiddleBackup, error) {
+	backup := &model.MiddleBackup{}
+	return backup, m.db.First(backup, i).Error
+}
+
+// FindAllBackupByMiddleID 查找中间件的备份记录，新的在前
+func (m *MiddleBackup) FindAllBackupByMiddleID(middleID int64) ([]model.MiddleBackup, error) {
+	var backupAll []model.MiddleBackup
+	return backupAll, m.db.Where("middle_id = ?", middleID).Order("backup_start_time desc").Find(&backupAll).Error
+}
+
+// CreateRestore 创建恢复记录
+func (m *MiddleBackup) CreateRestore(restore *model.MiddleRestore) (int64, error) {
+	err := m.db.Create(restore).Error
+	return restore.ID, err
+}
+
+// UpdateRestore 更新恢复记录
+func (m *MiddleBackup) UpdateRestore(restore *model.MiddleRestore) error {
+	return m.db.Model(restore).Update(restore).Error
+}
+
+// FindAllRestoreByStatus 查找指定状态的恢复记录
+func (m *MiddleBackup) FindAllRestoreByStatus(status ...string) ([]model.MiddleRestore, error) {
+	var restoreAll []model.MiddleRestore
+	return restoreAll, m.db.Where("restore_status in (?)", status).Find(&restoreAll).Error
+}
@@ -3,19 +3,48 @@ package repository
 import (
 	"github.com/jinzhu/gorm"
 	"tini-paas/internal/middleware/model"
+	"tini-paas/pkg/common"
 )
 
 // MiddlewareRepository 中间件数据库操作接口
 type MiddlewareRepository interface {
 	InitTable() error
+	MigrateTable() error
 	CreateMiddleware(*model.Middleware) (int64, error)
 	DeleteMiddleware(int64) error
 	UpdateMiddleware(*model.Middleware) error
 	FindMiddlewareByID(int64) (*model.Middleware, error)
+
+	// FindMiddlewareDetailByID 查找中间件及其端口、账号、环境变量和存储
+	FindMiddlewareDetailByID(int64) (*model.Middleware, error)
+
+	// FindMiddlewareByNamespaceAndName 根据命名空间和名称查找中间件
+	FindMiddlewareByNamespaceAndName(string, string) (*model.Middleware, error)
 	FindAll() ([]model.Middleware, error)
 
 	// FindAllByTypeID 根据类型查找中间件
 	FindAllByTypeID(int64) ([]model.Middleware, error)
+
+	// UpdateMiddleStorage 更新中间件的存储
+	UpdateMiddleStorage(*model.MiddleStorage) error
+
+	// FindAllStorageByResizeStatus 查找处于指定扩容状态的存储
+	FindAllStorageByResizeStatus(...string) ([]model.MiddleStorage, error)
+
+	// UpdateMiddlewareReplicas 只修改副本数，允许为0
+	UpdateMiddlewareReplicas(int64, int32) error
+
+	// UpdateMiddlewareUpgrade 更新中间件的版本和升级状态
+	UpdateMiddlewareUpgrade(*model.Middleware) error
+
+	// StartMiddlewareUpgrade 升级状态不属于busy时更新升级状态，返回是否更新成功
+	StartMiddlewareUpgrade(middleware *model.Middleware, busy ...string) (bool, error)
+
+	// FindAllConfigWithPassword 查找旧版本以明文保存了密码的账号信息
+	FindAllConfigWithPassword() ([]model.MiddleConfig, error)
+
+	// ClearConfigPassword 密码迁移到Secret后清除数据库中的密码
+	ClearConfigPassword(int64, string) error
 }
 
 // NewMiddlewareRepository 初始化中间件
@@ -34,6 +63,15 @@ func (m *Middleware) InitTable() error {
 	return m.db.CreateTable(&model.Middleware{}, &model.MiddleConfig{}, &model.MiddlePort{}, &model.MiddleEnv{}, &model.MiddleStorage{}).Error
 }
 
+// MigrateTable 迁移已有数据表，名称唯一改为同一命名空间内唯一，并补充新增的字段
+func (m *Middleware) MigrateTable() error {
+	err := common.MigrateUniqueIndex(m.db, &model.Middleware{}, "", "idx_middleware_namespace_name", "middle_namespace", "middle_name")
+	if err != nil {
+		return err
+	}
+	return common.MigrateTables(m.db, &model.Middleware{}, &model.MiddleConfig{}, &model.MiddlePort{}, &model.MiddleEnv{}, &model.MiddleStorage{})
+}
+
 func (m *Middleware) CreateMiddleware(middleware *model.Middleware) (int64, error) {
 	return middleware.ID, m.db.Create(middleware).Error
 }
@@ -90,7 +128,16 @@ func (m *Middleware) DeleteMiddleware(i int64) error {
 }
 
 func (m *Middleware) UpdateMiddleware(middleware *model.Middleware) error {
-	return m.db.Model(middleware).Update(middleware).Error
+	err := m.db.Model(middleware).Update(middleware).Error
+	if err != nil {
+		return err
+	}
+
+	// 密码只保存在k8s的Secret中，清除之前以明文保存的密码
+	return m.db.Model(&model.MiddleConfig{}).Where("middle_id = ?", middleware.ID).Updates(map[string]interface{}{
+		"middle_config_root_pwd": "",
+		"middle_config_pwd":      "",
+	}).Error
 }
 
 func (m *Middleware) FindMiddlewareByID(i int64) (*model.Middleware, error) {
@@ -98,6 +145,18 @@ func (m *Middleware) FindMiddlewareByID(i int64) (*model.Middleware, error) {
 	return middleware, m.db.First(&middleware, i).Error
 }
 
+// FindMiddlewareDetailByID 查找中间件及其端口、账号、环境变量和存储
+func (m *Middleware) FindMiddlewareDetailByID(i int64) (*model.Middleware, error) {
+	middleware := &model.Middleware{}
+	return middleware, m.db.Preload("MiddlePort").Preload("MiddleConfig").Preload("MiddleEnv").Preload("MiddleStorage").First(middleware, i).Error
+}
+
+// FindMiddlewareByNamespaceAndName 根据命名空间和名称查找中间件
+func (m *Middleware) FindMiddlewareByNamespaceAndName(namespace, name string) (*model.Middleware, error) {
+	middleware := &model.Middleware{}
+	return middleware, m.db.Where("middle_namespace = ? AND middle_name = ?", namespace, name).First(middleware).Error
+}
+
 func (m *Middleware) FindAll() ([]model.Middleware, error) {
 	var middleAll []model.Middleware
 	return middleAll, m.db.Find(&middleAll).Error
@@ -107,3 +166,54 @@ func (m *Middleware) FindAllByTypeID(i int64) ([]model.Middleware, error) {
 	var middleAll []model.Middleware
 	return middleAll, m.db.Find(&middleAll).Where("middle_type_id = ?", i).Error
 }
+
+// UpdateMiddleStorage 更新中间件的存储
+func (m *Middleware) UpdateMiddleStorage(storage *model.MiddleStorage) error {
+	return m.db.Model(storage).Update(storage).Error
+}
+
+// UpdateMiddlewareReplicas 只修改副本数，Update 会忽略为0的字段
+func (m *Middleware) UpdateMiddlewareReplicas(middleID int64, replicas int32) error {
+	return m.db.Model(&model.Middleware{}).Where("id = ?", middleID).UpdateColumn("middle_replicas", replicas).Error
+}
+
+// FindAllStorageByResizeStatus 查找处于指定扩容状态的存储
+func (m *Middleware) FindAllStorageByResizeStatus(status ...string) ([]model.MiddleStorage, error) {
+	var storageAll []model.MiddleStorage
+	return storageAll, m.db.Where("middle_storage_resize_status in (?)", status).Find(&storageAll).Error
+}
+
+// UpdateMiddlewareUpgrade 只更新版本和升级状态，升级在后台执行，避免覆盖期间修改的其他字段
+func (m *Middleware) UpdateMiddlewareUpgrade(middleware *model.Middleware) error {
+	return m.db.Model(&model.Middleware{}).Where("id = ?", middleware.ID).Updates(map[string]interface{}{
+		"middle_version_id":     middleware.MiddleVersionID,
+		"middle_upgrade_status": middleware.MiddleUpgradeStatus,
+		"middle_upgrade_msg":    middleware.MiddleUpgradeMsg,
+	}).Error
+}
+
+// StartMiddlewareUpgrade 在一条更新语句中检查并设置升级状态，避免同时发起的升级都通过检查
+func (m *Middleware) StartMiddlewareUpgrade(middleware *model.Middleware, busy ...string) (bool, error) {
+	db := m.db.Model(&model.Middleware{}).
+		Where("id = ? AND (middle_upgrade_status IS NULL OR middle_upgrade_status NOT IN (?))", middleware.ID, busy).
+		Updates(map[string]interface{}{
+			"middle_upgrade_status": middleware.MiddleUpgradeStatus,
+			"middle_upgrade_msg":    middleware.MiddleUpgradeMsg,
+		})
+	return db.RowsAffected > 0, db.Error
+}
+
+// FindAllConfigWithPassword 查找旧版本以明文保存了密码的账号信息
+func (m *Middleware) FindAllConfigWithPassword() ([]model.MiddleConfig, error) {
+	var configAll []model.MiddleConfig
+	return configAll, m.db.Where("middle_config_root_pwd <> '' OR middle_config_pwd <> ''").Find(&configAll).Error
+}
+
+// ClearConfigPassword 密码迁移到Secret后清除数据库中的密码，记录Secret名称
+func (m *Middleware) ClearConfigPassword(middleID int64, secretName string) error {
+	return m.db.Model(&model.MiddleConfig{}).Where("middle_id = ?", middleID).Updates(map[string]interface{}{
+		"middle_config_root_pwd":    "",
+		"middle_config_pwd":         "",
+		"middle_config_secret_name": secretName,
+	}).Error
+}
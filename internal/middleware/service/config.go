@@ -0,0 +1,275 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	v1 "k8s.io/api/apps/v1"
+	v13 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	v12 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"tini-paas/internal/middleware/proto/middleware"
+	"tini-paas/internal/middleware/template"
+	"tini-paas/pkg/common"
+)
+
+// configVolumeName 配置文件使用的卷名称
+const configVolumeName = "paas-config"
+
+// configChecksumAnnotation pod模板上配置文件的摘要
+// 配置文件通过subPath挂载，ConfigMap变化不会同步到运行中的pod，摘要变化时触发滚动更新
+const configChecksumAnnotation = "tini-paas/config-checksum"
+
+// ConfigMapName 保存中间件配置文件的ConfigMap名称
+func ConfigMapName(name string) string {
+	return name + "-config"
+}
+
+// applyConfig 根据模板渲染配置文件并保存到ConfigMap
+// 模板没有配置文件时删除之前创建的ConfigMap
+func (m *MiddlewareDataService) applyConfig(info *middleware.MiddlewareInfo) error {
+	tpl, ok := template.Get(info.MiddleTypeName)
+	if !ok || tpl.ConfigPath == "" {
+		err := m.deleteConfig(info.MiddleNamespace, info.MiddleName)
+		if err != nil {
+			common.Error(err)
+			return err
+		}
+		return nil
+	}
+
+	configMap, err := m.setConfigMap(info, tpl)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	data, err := common.ApplyData(configMap)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	opts, err := common.ApplyOptions(func() (v12.Object, error) {
+		return m.K8sClientSet.CoreV1().ConfigMaps(info.MiddleNamespace).Get(context.TODO(), configMap.Name, v12.GetOptions{})
+	})
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	_, err = m.K8sClientSet.CoreV1().ConfigMaps(info.MiddleNamespace).Patch(context.TODO(), configMap.Name, types.ApplyPatchType, data, opts)
+	if err != nil {
+		err = common.ApplyError("中间件配置", configMap.Name, err)
+		common.Error(err)
+		return err
+	}
+	return nil
+}
+
+// deleteConfig 删除保存配置文件的ConfigMap
+func (m *MiddlewareDataService) deleteConfig(namespace, name string) error {
+	err := m.K8sClientSet.CoreV1().ConfigMaps(namespace).Delete(context.TODO(), ConfigMapName(name), v12.DeleteOptions{})
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// setConfigMap 设置保存配置文件的ConfigMap
+func (m *MiddlewareDataService) setConfigMap(info *middleware.MiddlewareInfo, tpl *template.Template) (*v13.ConfigMap, error) {
+	config, err := tpl.RenderConfig(info)
+	if err != nil {
+		return nil, err
+	}
+
+	configMap := &v13.ConfigMap{}
+	configMap.TypeMeta = v12.TypeMeta{
+		Kind:       "ConfigMap",
+		APIVersion: "v1",
+	}
+	configMap.ObjectMeta = v12.ObjectMeta{
+		Name:      ConfigMapName(info.MiddleName),
+		Namespace: info.MiddleNamespace,
+		Labels: map[string]string{
+			"app-name": info.MiddleName,
+			"author":   "Paas",
+		},
+	}
+	configMap.Data = map[string]string{
+		tpl.ConfigFile(): config,
+	}
+	return configMap, nil
+}
+
+// setTemplate 根据中间件类型的模板设置启动命令、参数、探针和配置文件
+func (m *MiddlewareDataService) setTemplate(info *middleware.MiddlewareInfo, podTemplate *v13.PodTemplateSpec) {
+	tpl, ok := template.Get(info.MiddleTypeName)
+	if !ok {
+		return
+	}
+
+	podSpec := &podTemplate.Spec
+	container := &podSpec.Containers[0]
+	container.Args = tpl.Args
+	container.ReadinessProbe = m.getProbe(tpl.Readiness, 10)
+	container.LivenessProbe = m.getProbe(tpl.Liveness, 30)
+
+	// 模板内容是内置的，渲染失败说明模板有误，记录错误后使用镜像默认配置
+	command, err := tpl.RenderCommand(info)
+	if err != nil {
+		common.Error(err)
+	}
+	container.Command = command
+	runtimeEnv, err := tpl.RenderRuntimeEnv(info)
+	if err != nil {
+		common.Error(err)
+	}
+	container.Env = overrideEnv(container.Env, runtimeEnv)
+
+	// 新建的中间件按照模板的布局使用数据目录，已经创建的由 keepDataLayout 恢复原来的布局
+	container.Env = appendMissingEnv(container.Env, tpl.DataEnv)
+	if tpl.DataSubPath != "" {
+		for i := range container.VolumeMounts {
+			if container.VolumeMounts[i].MountPath == tpl.DataPath {
+				container.VolumeMounts[i].SubPath = tpl.DataSubPath
+			}
+		}
+	}
+
+	// 只挂载配置文件，不覆盖目录中的其他文件
+	if tpl.ConfigPath != "" {
+		container.VolumeMounts = append(container.VolumeMounts, v13.VolumeMount{
+			Name:      configVolumeName,
+			MountPath: tpl.ConfigPath,
+			SubPath:   tpl.ConfigFile(),
+			ReadOnly:  true,
+		})
+		podSpec.Volumes = append(podSpec.Volumes, v13.Volume{
+			Name: configVolumeName,
+			VolumeSource: v13.VolumeSource{
+				ConfigMap: &v13.ConfigMapVolumeSource{
+					LocalObjectReference: v13.LocalObjectReference{Name: ConfigMapName(info.MiddleName)},
+				},
+			},
+		})
+
+		config, err := tpl.RenderConfig(info)
+		if err != nil {
+			common.Error(err)
+			return
+		}
+		sum := sha256.Sum256([]byte(config))
+		if podTemplate.Annotations == nil {
+			podTemplate.Annotations = map[string]string{}
+		}
+		podTemplate.Annotations[configChecksumAnnotation] = hex.EncodeToString(sum[:])
+	}
+}
+
+// keepDataLayout 已经创建的statefulSet保持原来的数据子目录和数据目录变量
+// 修改已有中间件的数据目录会让pod使用空目录重新初始化，模板的数据目录布局只用于新建的中间件
+func keepDataLayout(info *middleware.MiddlewareInfo, statefulSet, existing *v1.StatefulSet) {
+	tpl, ok := template.Get(info.MiddleTypeName)
+	if !ok || tpl.DataPath == "" {
+		return
+	}
+	if len(statefulSet.Spec.Template.Spec.Containers) == 0 || len(existing.Spec.Template.Spec.Containers) == 0 {
+		return
+	}
+	container := &statefulSet.Spec.Template.Spec.Containers[0]
+	current := existing.Spec.Template.Spec.Containers[0]
+
+	for _, mount := range current.VolumeMounts {
+		if mount.MountPath != tpl.DataPath {
+			continue
+		}
+		for i := range container.VolumeMounts {
+			if container.VolumeMounts[i].MountPath == tpl.DataPath {
+				container.VolumeMounts[i].SubPath = mount.SubPath
+			}
+		}
+	}
+
+	// 用户设置的变量保持不变，模板的变量使用原来的值，原来没有的不添加
+	userEnv := map[string]bool{}
+	for _, env := range info.MiddleEnv {
+		userEnv[env.EbvKey] = true
+	}
+	currentEnv := map[string]v13.EnvVar{}
+	for _, env := range current.Env {
+		currentEnv[env.Name] = env
+	}
+	dataEnv := map[string]bool{}
+	for _, env := range tpl.DataEnv {
+		dataEnv[env.Key] = !userEnv[env.Key]
+	}
+	var envVar []v13.EnvVar
+	for _, env := range container.Env {
+		if dataEnv[env.Name] {
+			currentValue, ok := currentEnv[env.Name]
+			if !ok {
+				continue
+			}
+			env = currentValue
+		}
+		envVar = append(envVar, env)
+	}
+	container.Env = envVar
+}
+
+// appendMissingEnv 添加模板中的变量，已经存在的同名变量优先
+func appendMissingEnv(envVar []v13.EnvVar, envs []template.Env) []v13.EnvVar {
+	exists := map[string]bool{}
+	for _, env := range envVar {
+		exists[env.Name] = true
+	}
+	for _, env := range envs {
+		if !exists[env.Key] {
+			envVar = append(envVar, v13.EnvVar{Name: env.Key, Value: env.Value})
+		}
+	}
+	return envVar
+}
+
+// overrideEnv 用模板生成的变量覆盖同名变量
+func overrideEnv(envVar []v13.EnvVar, envs []template.Env) []v13.EnvVar {
+	if len(envs) == 0 {
+		return envVar
+	}
+	override := map[string]bool{}
+	for _, env := range envs {
+		override[env.Key] = true
+	}
+
+	var result []v13.EnvVar
+	for _, env := range envVar {
+		if !override[env.Name] {
+			result = append(result, env)
+		}
+	}
+	for _, env := range envs {
+		result = append(result, v13.EnvVar{Name: env.Key, Value: env.Value})
+	}
+	return result
+}
+
+// getProbe 根据模板设置探针，initialDelay 为首次检查前等待的秒数
+func (m *MiddlewareDataService) getProbe(probe template.Probe, initialDelay int32) *v13.Probe {
+	handler := v13.ProbeHandler{}
+	switch {
+	case len(probe.Command) > 0:
+		handler.Exec = &v13.ExecAction{Command: probe.Command}
+	case probe.TCPPort > 0:
+		handler.TCPSocket = &v13.TCPSocketAction{Port: intstr.FromInt(int(probe.TCPPort))}
+	default:
+		return nil
+	}
+
+	return &v13.Probe{
+		ProbeHandler:        handler,
+		InitialDelaySeconds: initialDelay,
+		PeriodSeconds:       10,
+		TimeoutSeconds:      5,
+		FailureThreshold:    6,
+	}
+}
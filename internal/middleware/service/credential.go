@@ -0,0 +1,281 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	v13 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	v12 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"math/big"
+	"tini-paas/internal/middleware/model"
+	"tini-paas/internal/middleware/proto/middleware"
+	"tini-paas/internal/middleware/template"
+	"tini-paas/pkg/common"
+)
+
+// passwordLength 自动生成的密码长度
+const passwordLength = 24
+
+// passwordChars 生成密码使用的字符，避免在连接串中需要转义的符号
+const passwordChars = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+
+// CredentialSecretName 保存中间件账号密码的Secret名称
+func CredentialSecretName(name string) string {
+	return name + "-credentials"
+}
+
+// applyCredentials 补全账号密码并保存到Secret
+// 密码为空时优先使用Secret中已有的密码，没有时自动生成，保证更新中间件不会改变密码
+func (m *MiddlewareDataService) applyCredentials(info *middleware.MiddlewareInfo) error {
+	if info.MiddleConfig == nil {
+		info.MiddleConfig = &middleware.MiddleConfig{}
+	}
+	config := info.MiddleConfig
+	config.MiddleConfigSecretName = CredentialSecretName(info.MiddleName)
+
+	// 读取已经存在的账号密码
+	secret, err := m.K8sClientSet.CoreV1().Secrets(info.MiddleNamespace).Get(context.TODO(), config.MiddleConfigSecretName, v12.GetOptions{})
+	if err != nil && !k8serrors.IsNotFound(err) {
+		common.Error(err)
+		return err
+	}
+	if err == nil {
+		fillFromSecret(config, secret.Data)
+	}
+
+	if config.MiddleConfigRootUser == "" {
+		if tpl, ok := template.Get(info.MiddleTypeName); ok {
+			config.MiddleConfigRootUser = tpl.RootUser
+		}
+	}
+	if config.MiddleConfigRootPwd == "" {
+		config.MiddleConfigRootPwd, err = generatePassword()
+		if err != nil {
+			common.Error(err)
+			return err
+		}
+	}
+	if config.MiddleConfigUser != "" && config.MiddleConfigPwd == "" {
+		config.MiddleConfigPwd, err = generatePassword()
+		if err != nil {
+			common.Error(err)
+			return err
+		}
+	}
+
+	data, err := common.ApplyData(m.setSecret(info))
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	opts, err := common.ApplyOptions(func() (v12.Object, error) {
+		return m.K8sClientSet.CoreV1().Secrets(info.MiddleNamespace).Get(context.TODO(), config.MiddleConfigSecretName, v12.GetOptions{})
+	})
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	_, err = m.K8sClientSet.CoreV1().Secrets(info.MiddleNamespace).Patch(context.TODO(), config.MiddleConfigSecretName, types.ApplyPatchType, data, opts)
+	if err != nil {
+		err = common.ApplyError("中间件密钥", config.MiddleConfigSecretName, err)
+		common.Error(err)
+		return err
+	}
+	return nil
+}
+
+// GetCredentials 从Secret中读取中间件的账号密码
+func (m *MiddlewareDataService) GetCredentials(namespace, name string) (*middleware.MiddleConfig, error) {
+	secretName := CredentialSecretName(name)
+	secret, err := m.K8sClientSet.CoreV1().Secrets(namespace).Get(context.TODO(), secretName, v12.GetOptions{})
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil, errors.New("中间件 " + name + " 没有保存账号密码")
+		}
+		return nil, err
+	}
+
+	config := &middleware.MiddleConfig{MiddleConfigSecretName: secretName}
+	fillFromSecret(config, secret.Data)
+	return config, nil
+}
+
+// MigrateCredentials 将旧版本以明文保存在数据库中的密码迁移到Secret，并清除数据库中的密码
+// Secret 已经存在时以Secret为准，不覆盖；可以在每次启动时重复执行
+func (m *MiddlewareDataService) MigrateCredentials() error {
+	configs, err := m.MiddlewareRepository.FindAllConfigWithPassword()
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+
+	for i := range configs {
+		err = m.migrateCredential(&configs[i])
+		if err != nil {
+			// 单个中间件失败不影响其它中间件，下次启动时重试
+			common.Error(err)
+		}
+	}
+	return nil
+}
+
+// migrateCredential 迁移一个中间件的密码
+func (m *MiddlewareDataService) migrateCredential(config *model.MiddleConfig) error {
+	middleModel, err := m.MiddlewareRepository.FindMiddlewareByID(config.MiddleID)
+	if err != nil {
+		return err
+	}
+	secretName := CredentialSecretName(middleModel.MiddleName)
+
+	_, err = m.K8sClientSet.CoreV1().Secrets(middleModel.MiddleNamespace).Get(context.TODO(), secretName, v12.GetOptions{})
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return err
+	}
+	if k8serrors.IsNotFound(err) {
+		// 按照数据库中的值创建Secret，不生成新密码，和运行中的中间件保持一致
+		info := &middleware.MiddlewareInfo{
+			MiddleName:      middleModel.MiddleName,
+			MiddleNamespace: middleModel.MiddleNamespace,
+			MiddleConfig: &middleware.MiddleConfig{
+				MiddleConfigRootUser:   config.MiddleConfigRootUser,
+				MiddleConfigRootPwd:    config.MiddleConfigRootPwd,
+				MiddleConfigUser:       config.MiddleConfigUser,
+				MiddleConfigPwd:        config.MiddleConfigPwd,
+				MiddleConfigDataBase:   config.MiddleConfigDataBase,
+				MiddleConfigSecretName: secretName,
+			},
+		}
+		data, err := common.ApplyData(m.setSecret(info))
+		if err != nil {
+			return err
+		}
+		opts, err := common.ApplyOptions(func() (v12.Object, error) {
+			return m.K8sClientSet.CoreV1().Secrets(middleModel.MiddleNamespace).Get(context.TODO(), secretName, v12.GetOptions{})
+		})
+		if err != nil {
+			return err
+		}
+		_, err = m.K8sClientSet.CoreV1().Secrets(middleModel.MiddleNamespace).Patch(context.TODO(), secretName, types.ApplyPatchType, data, opts)
+		if err != nil {
+			return common.ApplyError("中间件密钥", secretName, err)
+		}
+	}
+
+	err = m.MiddlewareRepository.ClearConfigPassword(config.MiddleID, secretName)
+	if err != nil {
+		return err
+	}
+	common.Info("中间件 " + middleModel.MiddleNamespace + "/" + middleModel.MiddleName + " 的密码已经迁移到 " + secretName)
+	return nil
+}
+
+// deleteCredentials 删除保存账号密码的Secret
+func (m *MiddlewareDataService) deleteCredentials(namespace, name string) error {
+	err := m.K8sClientSet.CoreV1().Secrets(namespace).Delete(context.TODO(), CredentialSecretName(name), v12.DeleteOptions{})
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// setSecret 设置保存账号密码的Secret
+func (m *MiddlewareDataService) setSecret(info *middleware.MiddlewareInfo) *v13.Secret {
+	config := info.MiddleConfig
+	secret := &v13.Secret{}
+
+	secret.TypeMeta = v12.TypeMeta{
+		Kind:       "Secret",
+		APIVersion: "v1",
+	}
+
+	secret.ObjectMeta = v12.ObjectMeta{
+		Name:      config.MiddleConfigSecretName,
+		Namespace: info.MiddleNamespace,
+		Labels: map[string]string{
+			"app-name": info.MiddleName,
+			"author":   "Paas",
+		},
+	}
+
+	secret.Type = v13.SecretTypeOpaque
+	secret.Data = map[string][]byte{}
+	for key, value := range map[string]string{
+		template.SecretRootUser: config.MiddleConfigRootUser,
+		template.SecretRootPwd:  config.MiddleConfigRootPwd,
+		template.SecretUser:     config.MiddleConfigUser,
+		template.SecretPwd:      config.MiddleConfigPwd,
+		template.SecretDataBase: config.MiddleConfigDataBase,
+	} {
+		if value != "" {
+			secret.Data[key] = []byte(value)
+		}
+	}
+	return secret
+}
+
+// getCredentialEnv 根据中间件类型从Secret中注入账号密码
+func (m *MiddlewareDataService) getCredentialEnv(info *middleware.MiddlewareInfo) []v13.EnvVar {
+	var envVar []v13.EnvVar
+	config := info.MiddleConfig
+	if config == nil || config.MiddleConfigSecretName == "" {
+		return envVar
+	}
+
+	values := map[string]string{
+		template.SecretRootUser: config.MiddleConfigRootUser,
+		template.SecretRootPwd:  config.MiddleConfigRootPwd,
+		template.SecretUser:     config.MiddleConfigUser,
+		template.SecretPwd:      config.MiddleConfigPwd,
+		template.SecretDataBase: config.MiddleConfigDataBase,
+	}
+	tpl, ok := template.Get(info.MiddleTypeName)
+	if !ok {
+		return envVar
+	}
+	for _, env := range tpl.Credentials {
+		// Secret 中没有的key不注入
+		if values[env.Key] == "" {
+			continue
+		}
+		envVar = append(envVar, v13.EnvVar{
+			Name: env.Env,
+			ValueFrom: &v13.EnvVarSource{
+				SecretKeyRef: &v13.SecretKeySelector{
+					LocalObjectReference: v13.LocalObjectReference{Name: config.MiddleConfigSecretName},
+					Key:                  env.Key,
+				},
+			},
+		})
+	}
+	return envVar
+}
+
+// fillFromSecret 用Secret中的值补全为空的账号密码
+func fillFromSecret(config *middleware.MiddleConfig, data map[string][]byte) {
+	fill := func(value *string, key string) {
+		if *value == "" {
+			*value = string(data[key])
+		}
+	}
+	fill(&config.MiddleConfigRootUser, template.SecretRootUser)
+	fill(&config.MiddleConfigRootPwd, template.SecretRootPwd)
+	fill(&config.MiddleConfigUser, template.SecretUser)
+	fill(&config.MiddleConfigPwd, template.SecretPwd)
+	fill(&config.MiddleConfigDataBase, template.SecretDataBase)
+}
+
+// generatePassword 使用安全随机数生成密码
+func generatePassword() (string, error) {
+	password := make([]byte, passwordLength)
+	max := big.NewInt(int64(len(passwordChars)))
+	for i := range password {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		password[i] = passwordChars[n.Int64()]
+	}
+	return string(password), nil
+}
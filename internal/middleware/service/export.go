@@ -0,0 +1,66 @@
+package service
+
+import (
+	"context"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	v12 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"tini-paas/internal/middleware/proto/middleware"
+	"tini-paas/internal/middleware/template"
+	"tini-paas/pkg/common"
+)
+
+// passwordPlaceholder 导出时代替密码，保证Secret和环境变量中保留对应的键
+const passwordPlaceholder = "-"
+
+// ExportYAML 导出应用到k8s的Secret、ConfigMap、服务和statefulSet，与 UpdateToK8s 发送的内容一致
+// 密码不导出，Secret中只保留键和空值，导入前需要填写
+func (m *MiddlewareDataService) ExportYAML(info *middleware.MiddlewareInfo) (string, error) {
+	config := &middleware.MiddleConfig{MiddleConfigSecretName: CredentialSecretName(info.MiddleName)}
+	if info.MiddleConfig != nil {
+		config.MiddleConfigRootUser = info.MiddleConfig.MiddleConfigRootUser
+		config.MiddleConfigUser = info.MiddleConfig.MiddleConfigUser
+		config.MiddleConfigDataBase = info.MiddleConfig.MiddleConfigDataBase
+	}
+	tpl, ok := template.Get(info.MiddleTypeName)
+	if config.MiddleConfigRootUser == "" && ok {
+		config.MiddleConfigRootUser = tpl.RootUser
+	}
+	config.MiddleConfigRootPwd = passwordPlaceholder
+	if config.MiddleConfigUser != "" {
+		config.MiddleConfigPwd = passwordPlaceholder
+	}
+	info.MiddleConfig = config
+
+	secret := m.setSecret(info)
+	for _, key := range []string{template.SecretRootPwd, template.SecretPwd} {
+		if _, ok := secret.Data[key]; ok {
+			secret.Data[key] = []byte{}
+		}
+	}
+	objs := []interface{}{secret}
+
+	if ok && tpl.ConfigPath != "" {
+		configMap, err := m.setConfigMap(info, tpl)
+		if err != nil {
+			return "", err
+		}
+		objs = append(objs, configMap)
+	}
+
+	objs = append(objs, m.setHeadlessService(info))
+	if len(info.MiddlePort) > 0 {
+		objs = append(objs, m.setClientService(info))
+	}
+
+	// 与 applyStatefulSet 一致，已经创建的statefulSet使用原来的存储模板和数据目录
+	statefulSet := m.setStatefulSet(info)
+	existing, err := m.K8sClientSet.AppsV1().StatefulSets(info.MiddleNamespace).Get(context.TODO(), info.MiddleName, v12.GetOptions{})
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return "", err
+	}
+	if err == nil {
+		statefulSet.Spec.VolumeClaimTemplates = existing.Spec.VolumeClaimTemplates
+		keepDataLayout(info, statefulSet, existing)
+	}
+	return common.ExportYAML(append(objs, statefulSet)...)
+}
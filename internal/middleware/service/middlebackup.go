@@ -0,0 +1,917 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/jinzhu/gorm"
+	"google.golang.org/protobuf/proto"
+	batchv1 "k8s.io/api/batch/v1"
+	v13 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	v12 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"os"
+	"strconv"
+	"time"
+	"tini-paas/internal/middleware/model"
+	"tini-paas/internal/middleware/proto/middleware"
+	"tini-paas/internal/middleware/repository"
+	"tini-paas/internal/middleware/template"
+	"tini-paas/pkg/common"
+)
+
+// 备份保存的位置
+const (
+	BackupTargetPVC = "pvc"
+	BackupTargetS3  = "s3"
+)
+
+// 备份状态
+const (
+	BackupRunning   = "Running"
+	BackupSucceeded = "Succeeded"
+	BackupFailed    = "Failed"
+)
+
+const (
+	// defaultRetention 未设置保留数量时保留最近7份
+	defaultRetention = 7
+
+	// backupDir 备份文件在任务容器中的目录
+	backupDir = "/backup"
+
+	// restoreDataDir 离线恢复时数据目录在任务容器中的路径
+	restoreDataDir = "/restore-data"
+
+	// backupPollInterval 检查恢复任务的间隔
+	backupPollInterval = 5 * time.Second
+
+	// restoreTimeout 恢复的超时时间
+	restoreTimeout = time.Hour
+)
+
+// 访问密钥在Secret中的key
+const (
+	s3AccessKey = "access-key"
+	s3SecretKey = "secret-key"
+)
+
+// backupImageEnv 清理PVC中过期备份使用的镜像
+const backupImageEnv = "PAAS_BACKUP_IMAGE"
+
+// s3ImageEnv 上传和下载S3备份使用的镜像，需要包含 mc 和 sh
+const s3ImageEnv = "PAAS_BACKUP_S3_IMAGE"
+
+// MiddleBackupService 中间件备份服务接口
+type MiddleBackupService interface {
+	// SetBackupPolicy 保存备份策略并创建定时备份任务
+	SetBackupPolicy(*middleware.MiddlewareInfo, *model.MiddleBackupPolicy) error
+
+	// DeleteBackupPolicy 删除备份策略和定时备份任务，已有的备份文件保留
+	DeleteBackupPolicy(*model.Middleware) error
+
+	// FindBackupPolicy 查找中间件的备份策略
+	FindBackupPolicy(int64) (*model.MiddleBackupPolicy, error)
+
+	// ListBackups 同步k8s中的备份任务并返回中间件的备份记录
+	ListBackups(*middleware.MiddlewareInfo) ([]model.MiddleBackup, error)
+
+	// FindBackupByID 查找备份记录
+	FindBackupByID(int64) (*model.MiddleBackup, error)
+
+	// RestoreMiddleware 将source的备份恢复到target，返回恢复任务的名称
+	RestoreMiddleware(source, target *middleware.MiddlewareInfo, backup *model.MiddleBackup) (string, error)
+
+	// ResumeRestores 服务启动时继续执行未完成的恢复
+	ResumeRestores(func(int64) (*middleware.MiddlewareInfo, error)) error
+
+	// RunBackup 按照备份策略立即备份一次，返回备份任务的名称
+	RunBackup(*middleware.MiddlewareInfo) (string, error)
+
+	// WaitBackup 等待备份任务完成
+	WaitBackup(namespace, jobName string) error
+}
+
+// ErrNoBackupPolicy 中间件没有设置备份策略
+var ErrNoBackupPolicy = errors.New("中间件没有设置备份策略")
+
+// NewMiddleBackupService 初始化中间件备份服务
+func NewMiddleBackupService(backupRepository repository.MiddleBackupRepository, middlewareService MiddlewareService, clientSet kubernetes.Interface) MiddleBackupService {
+	return &MiddleBackupDataService{
+		MiddleBackupRepository: backupRepository,
+		MiddlewareService:      middlewareService,
+		K8sClientSet:           clientSet,
+	}
+}
+
+// MiddleBackupDataService 中间件备份服务
+type MiddleBackupDataService struct {
+	// MiddleBackupRepository 操作数据库接口
+	MiddleBackupRepository repository.MiddleBackupRepository
+
+	// MiddlewareService 离线恢复时停止和启动中间件
+	MiddlewareService MiddlewareService
+
+	// K8sClientSet k8s客户端集合
+	K8sClientSet kubernetes.Interface
+}
+
+// BackupCronJobName 定时备份任务的名称
+func BackupCronJobName(name string) string {
+	return name + "-backup"
+}
+
+// backupS3SecretName 保存S3访问密钥的Secret名称
+func backupS3SecretName(name string) string {
+	return name + "-backup-s3"
+}
+
+// SetBackupPolicy 保存备份策略并创建定时备份任务
+func (m *MiddleBackupDataService) SetBackupPolicy(info *middleware.MiddlewareInfo, policy *model.MiddleBackupPolicy) error {
+	tpl, err := backupTemplate(info.MiddleTypeName)
+	if err != nil {
+		return err
+	}
+	if policy.BackupSchedule == "" {
+		return errors.New("备份周期不能为空")
+	}
+	if policy.BackupRetention < 1 {
+		policy.BackupRetention = defaultRetention
+	}
+
+	switch policy.BackupTarget {
+	case BackupTargetPVC:
+		if policy.BackupPvcName == "" {
+			return errors.New("备份保存到PVC时需要指定PVC名称")
+		}
+	case BackupTargetS3:
+		if policy.BackupS3Endpoint == "" || policy.BackupS3Bucket == "" {
+			return errors.New("备份保存到S3时需要指定地址和桶")
+		}
+		err = m.applyS3Secret(info, policy)
+		if err != nil {
+			return err
+		}
+	default:
+		return errors.New("不支持的备份位置：" + policy.BackupTarget)
+	}
+
+	data, err := common.ApplyData(m.setCronJob(info, policy, tpl))
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	name := BackupCronJobName(info.MiddleName)
+	opts, err := common.ApplyOptions(func() (v12.Object, error) {
+		return m.K8sClientSet.BatchV1().CronJobs(info.MiddleNamespace).Get(context.TODO(), name, v12.GetOptions{})
+	})
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	_, err = m.K8sClientSet.BatchV1().CronJobs(info.MiddleNamespace).Patch(context.TODO(), name, types.ApplyPatchType, data, opts)
+	if err != nil {
+		err = common.ApplyError("定时备份任务", name, err)
+		common.Error(err)
+		return err
+	}
+
+	// 密钥只保存在Secret中
+	policy.BackupS3AccessKey = ""
+	policy.BackupS3SecretKey = ""
+	return m.MiddleBackupRepository.SavePolicy(policy)
+}
+
+// DeleteBackupPolicy 删除备份策略和定时备份任务，已有的备份文件保留
+func (m *MiddleBackupDataService) DeleteBackupPolicy(middle *model.Middleware) error {
+	err := m.K8sClientSet.BatchV1().CronJobs(middle.MiddleNamespace).Delete(context.TODO(), BackupCronJobName(middle.MiddleName), v12.DeleteOptions{})
+	if err != nil && !k8serrors.IsNotFound(err) {
+		common.Error(err)
+		return err
+	}
+	err = m.K8sClientSet.CoreV1().Secrets(middle.MiddleNamespace).Delete(context.TODO(), backupS3SecretName(middle.MiddleName), v12.DeleteOptions{})
+	if err != nil && !k8serrors.IsNotFound(err) {
+		common.Error(err)
+		return err
+	}
+	return m.MiddleBackupRepository.DeletePolicyByMiddleID(middle.ID)
+}
+
+// FindBackupPolicy 查找中间件的备份策略
+func (m *MiddleBackupDataService) FindBackupPolicy(middleID int64) (*model.MiddleBackupPolicy, error) {
+	return m.MiddleBackupRepository.FindPolicyByMiddleID(middleID)
+}
+
+// FindBackupByID 查找备份记录
+func (m *MiddleBackupDataService) FindBackupByID(i int64) (*model.MiddleBackup, error) {
+	return m.MiddleBackupRepository.FindBackupByID(i)
+}
+
+// ListBackups 同步k8s中的备份任务并返回中间件的备份记录
+// 备份任务由CronJob创建，每次查询时把新的任务记录到数据库，超过保留数量的记录随备份文件一起删除
+func (m *MiddleBackupDataService) ListBackups(info *middleware.MiddlewareInfo) ([]model.MiddleBackup, error) {
+	policy, err := m.MiddleBackupRepository.FindPolicyByMiddleID(info.Id)
+	if err == nil {
+		err = m.syncBackups(info, policy)
+		if err != nil {
+			common.Error(err)
+			return nil, err
+		}
+	}
+	return m.MiddleBackupRepository.FindAllBackupByMiddleID(info.Id)
+}
+
+// syncBackups 把备份任务的状态同步到数据库
+func (m *MiddleBackupDataService) syncBackups(info *middleware.MiddlewareInfo, policy *model.MiddleBackupPolicy) error {
+	tpl, err := backupTemplate(info.MiddleTypeName)
+	if err != nil {
+		return err
+	}
+
+	jobs, err := m.K8sClientSet.BatchV1().Jobs(info.MiddleNamespace).List(context.TODO(), v12.ListOptions{
+		LabelSelector: "middle-backup=" + info.MiddleName,
+	})
+	if err != nil {
+		return err
+	}
+	backups, err := m.MiddleBackupRepository.FindAllBackupByMiddleID(info.Id)
+	if err != nil {
+		return err
+	}
+	exists := map[string]*model.MiddleBackup{}
+	for i := range backups {
+		exists[backups[i].BackupJobName] = &backups[i]
+	}
+
+	for i := range jobs.Items {
+		job := &jobs.Items[i]
+		status, msg := backupJobStatus(job)
+		var finishTime int64
+		if job.Status.CompletionTime != nil {
+			finishTime = job.Status.CompletionTime.Unix()
+		}
+
+		backup, ok := exists[job.Name]
+		if !ok {
+			fileName := job.Name + "." + tpl.Backup.Ext
+			_, err = m.MiddleBackupRepository.CreateBackup(&model.MiddleBackup{
+				MiddleID:         info.Id,
+				BackupName:       fileName,
+				BackupJobName:    job.Name,
+				BackupStatus:     status,
+				BackupTarget:     policy.BackupTarget,
+				BackupLocation:   backupLocation(info, policy, fileName),
+				BackupMsg:        msg,
+				BackupStartTime:  job.CreationTimestamp.Unix(),
+				BackupFinishTime: finishTime,
+			})
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		if backup.BackupStatus != status {
+			backup.BackupStatus = status
+			backup.BackupMsg = msg
+			backup.BackupFinishTime = finishTime
+			err = m.MiddleBackupRepository.UpdateBackup(backup)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	// 备份任务只保留最近的备份文件，删除已经被清理的记录
+	backups, err = m.MiddleBackupRepository.FindAllBackupByMiddleID(info.Id)
+	if err != nil {
+		return err
+	}
+	var succeeded int32
+	for _, backup := range backups {
+		if backup.BackupStatus != BackupSucceeded {
+			continue
+		}
+		succeeded++
+		if succeeded > policy.BackupRetention {
+			err = m.MiddleBackupRepository.DeleteBackupByID(backup.ID)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// RunBackup 使用定时备份任务的模板创建一次备份任务，备份记录和文件清理与定时备份相同
+func (m *MiddleBackupDataService) RunBackup(info *middleware.MiddlewareInfo) (string, error) {
+	_, err := m.MiddleBackupRepository.FindPolicyByMiddleID(info.Id)
+	if gorm.IsRecordNotFoundError(err) {
+		return "", ErrNoBackupPolicy
+	}
+	if err != nil {
+		return "", err
+	}
+
+	cronJob, err := m.K8sClientSet.BatchV1().CronJobs(info.MiddleNamespace).Get(context.TODO(), BackupCronJobName(info.MiddleName), v12.GetOptions{})
+	if err != nil {
+		common.Error(err)
+		return "", err
+	}
+
+	// 与定时任务的命名一致(调度时间的分钟数)，按名称排序清理旧备份时顺序不变
+	job := &batchv1.Job{
+		ObjectMeta: v12.ObjectMeta{
+			Name:        cronJob.Name + "-" + strconv.FormatInt(time.Now().Unix()/60, 10),
+			Namespace:   info.MiddleNamespace,
+			Labels:      cronJob.Spec.JobTemplate.Labels,
+			Annotations: map[string]string{"cronjob.kubernetes.io/instantiate": "manual"},
+		},
+		Spec: cronJob.Spec.JobTemplate.Spec,
+	}
+	_, err = m.K8sClientSet.BatchV1().Jobs(info.MiddleNamespace).Create(context.TODO(), job, v12.CreateOptions{})
+	if k8serrors.IsAlreadyExists(err) {
+		// 同一分钟内的定时备份已经创建了任务，等待它即可
+		return job.Name, nil
+	}
+	if err != nil {
+		common.Error(err)
+		return "", err
+	}
+	common.Info("备份任务 " + job.Name + " 已创建")
+	return job.Name, nil
+}
+
+// WaitBackup 等待备份任务完成，任务失败时返回失败原因
+func (m *MiddleBackupDataService) WaitBackup(namespace, jobName string) error {
+	return m.waitFor(func() (bool, error) {
+		job, err := m.K8sClientSet.BatchV1().Jobs(namespace).Get(context.TODO(), jobName, v12.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+		status, msg := backupJobStatus(job)
+		switch status {
+		case BackupSucceeded:
+			return true, nil
+		case BackupFailed:
+			return false, errors.New("备份任务 " + jobName + " " + msg)
+		}
+		return false, nil
+	})
+}
+
+// RestoreMiddleware 将source的备份恢复到target，返回恢复任务的名称
+// 在线恢复的任务失败后会重试，等待新创建的中间件就绪；离线恢复会先停止target，写入数据后再启动
+func (m *MiddleBackupDataService) RestoreMiddleware(source, target *middleware.MiddlewareInfo, backup *model.MiddleBackup) (string, error) {
+	if backup.BackupStatus != BackupSucceeded {
+		return "", errors.New("备份 " + backup.BackupName + " 没有成功，不能用于恢复")
+	}
+	tpl, err := backupTemplate(target.MiddleTypeName)
+	if err != nil {
+		return "", err
+	}
+	policy, err := m.MiddleBackupRepository.FindPolicyByMiddleID(source.Id)
+	if err != nil {
+		return "", errors.New("中间件 " + source.MiddleName + " 的备份策略已经删除，无法读取备份")
+	}
+	// 保存位置修改后，之前的备份需要手动恢复
+	if policy.BackupTarget != backup.BackupTarget {
+		return "", errors.New("备份保存的位置已经修改，无法读取备份 " + backup.BackupName)
+	}
+
+	job := m.setRestoreJob(source, target, policy, backup, tpl)
+	if tpl.Backup.Offline && dataStorageName(target, tpl) == "" {
+		return "", errors.New("中间件 " + target.MiddleName + " 没有挂载数据目录，无法恢复")
+	}
+
+	// 先保存恢复记录，服务重启后根据记录继续恢复
+	data, err := json.Marshal(job)
+	if err != nil {
+		return "", err
+	}
+	restore := &model.MiddleRestore{
+		MiddleID:         target.Id,
+		BackupID:         backup.ID,
+		RestoreJobName:   job.Name,
+		RestoreJob:       string(data),
+		RestoreOffline:   tpl.Backup.Offline,
+		RestoreStatus:    BackupRunning,
+		RestoreStartTime: time.Now().Unix(),
+	}
+	_, err = m.MiddleBackupRepository.CreateRestore(restore)
+	if err != nil {
+		common.Error(err)
+		return "", err
+	}
+
+	// 在线恢复直接创建任务，创建失败时返回给调用方
+	if !restore.RestoreOffline {
+		err = m.createRestoreJob(job)
+		if err != nil {
+			m.finishRestore(restore, BackupFailed, err.Error())
+			return "", err
+		}
+	}
+	go m.runRestore(target, job, restore)
+	return job.Name, nil
+}
+
+// ResumeRestores 服务启动时继续执行未完成的恢复，find 用于查找恢复到的中间件
+func (m *MiddleBackupDataService) ResumeRestores(find func(int64) (*middleware.MiddlewareInfo, error)) error {
+	restores, err := m.MiddleBackupRepository.FindAllRestoreByStatus(BackupRunning)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	for i := range restores {
+		restore := &restores[i]
+		job := &batchv1.Job{}
+		err = json.Unmarshal([]byte(restore.RestoreJob), job)
+		if err != nil {
+			m.finishRestore(restore, BackupFailed, "恢复任务定义无效："+err.Error())
+			continue
+		}
+		target, err := find(restore.MiddleID)
+		if err != nil {
+			m.finishRestore(restore, BackupFailed, "查找恢复的中间件失败："+err.Error())
+			continue
+		}
+		common.Info("继续执行恢复任务 " + job.Name)
+		go m.runRestore(target, job, restore)
+	}
+	return nil
+}
+
+// runRestore 执行恢复并记录结果
+// 离线恢复会重新停止中间件，任务已经创建时直接等待，可以在服务重启后重复执行
+func (m *MiddleBackupDataService) runRestore(target *middleware.MiddlewareInfo, job *batchv1.Job, restore *model.MiddleRestore) {
+	var msg string
+	var err error
+	if restore.RestoreOffline {
+		msg, err = m.restoreOffline(target, job)
+	} else {
+		err = m.createRestoreJob(job)
+		if err == nil {
+			msg, err = m.watchRestore(job)
+		}
+	}
+	if err != nil {
+		common.Error("恢复中间件 " + target.MiddleName + " 失败：" + err.Error())
+		m.finishRestore(restore, BackupFailed, err.Error())
+		return
+	}
+	common.Info("恢复任务 " + job.Name + " 结束：" + msg)
+	m.finishRestore(restore, BackupSucceeded, msg)
+}
+
+// finishRestore 记录恢复结果
+func (m *MiddleBackupDataService) finishRestore(restore *model.MiddleRestore, status, msg string) {
+	restore.RestoreStatus = status
+	restore.RestoreMsg = msg
+	restore.RestoreFinishTime = time.Now().Unix()
+	err := m.MiddleBackupRepository.UpdateRestore(restore)
+	if err != nil {
+		common.Error(err)
+	}
+}
+
+// restoreOffline 停止中间件，执行恢复任务后重新启动
+func (m *MiddleBackupDataService) restoreOffline(target *middleware.MiddlewareInfo, job *batchv1.Job) (msg string, err error) {
+	tpl, err := backupTemplate(target.MiddleTypeName)
+	if err != nil {
+		return "", err
+	}
+	pvcName := dataPVCName(target, tpl)
+
+	// 新创建的中间件需要等待pod创建出数据存储
+	err = m.waitFor(func() (bool, error) {
+		_, err := m.K8sClientSet.CoreV1().PersistentVolumeClaims(target.MiddleNamespace).Get(context.TODO(), pvcName, v12.GetOptions{})
+		if k8serrors.IsNotFound(err) {
+			return false, nil
+		}
+		return err == nil, err
+	})
+	if err != nil {
+		return "", errors.New("等待存储 " + pvcName + "：" + err.Error())
+	}
+
+	// 通过平台的应用方式修改副本数，避免与之后的更新产生字段冲突
+	stopped := proto.Clone(target).(*middleware.MiddlewareInfo)
+	stopped.MiddleReplicas = 0
+	err = m.MiddlewareService.UpdateToK8s(stopped)
+	if err != nil {
+		return "", errors.New("停止中间件：" + err.Error())
+	}
+	defer func() {
+		startErr := m.MiddlewareService.UpdateToK8s(target)
+		if startErr != nil && err == nil {
+			err = errors.New("恢复后启动中间件：" + startErr.Error())
+		}
+	}()
+
+	err = m.waitFor(func() (bool, error) {
+		statefulSet, err := m.K8sClientSet.AppsV1().StatefulSets(target.MiddleNamespace).Get(context.TODO(), target.MiddleName, v12.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+		return statefulSet.Status.Replicas == 0, nil
+	})
+	if err != nil {
+		return "", errors.New("等待停止：" + err.Error())
+	}
+
+	err = m.createRestoreJob(job)
+	if err != nil {
+		return "", err
+	}
+	return m.watchRestore(job)
+}
+
+// createRestoreJob 创建恢复任务，任务已经存在时不重复创建
+func (m *MiddleBackupDataService) createRestoreJob(job *batchv1.Job) error {
+	_, err := m.K8sClientSet.BatchV1().Jobs(job.Namespace).Create(context.TODO(), job, v12.CreateOptions{})
+	if k8serrors.IsAlreadyExists(err) {
+		return nil
+	}
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	common.Info("恢复任务 " + job.Name + " 已创建")
+	return nil
+}
+
+// watchRestore 等待恢复任务结束，任务失败时返回失败原因
+func (m *MiddleBackupDataService) watchRestore(job *batchv1.Job) (string, error) {
+	var status, msg string
+	err := m.waitFor(func() (bool, error) {
+		current, err := m.K8sClientSet.BatchV1().Jobs(job.Namespace).Get(context.TODO(), job.Name, v12.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+		status, msg = backupJobStatus(current)
+		return status != BackupRunning, nil
+	})
+	if err != nil {
+		return "", errors.New("恢复任务 " + job.Name + " " + err.Error())
+	}
+	if status == BackupFailed {
+		return "", errors.New("恢复任务 " + job.Name + " " + msg)
+	}
+	return msg, nil
+}
+
+// waitFor 定时检查直到条件满足、出错或者超时
+func (m *MiddleBackupDataService) waitFor(done func() (bool, error)) error {
+	ticker := time.NewTicker(backupPollInterval)
+	defer ticker.Stop()
+	timeout := time.After(restoreTimeout)
+
+	for {
+		ok, err := done()
+		if err != nil {
+			return err
+		}
+		if ok {
+			return nil
+		}
+		select {
+		case <-ticker.C:
+		case <-timeout:
+			return errors.New("等待超时")
+		}
+	}
+}
+
+// applyS3Secret 保存S3访问密钥，未填写时使用已经保存的密钥
+func (m *MiddleBackupDataService) applyS3Secret(info *middleware.MiddlewareInfo, policy *model.MiddleBackupPolicy) error {
+	name := backupS3SecretName(info.MiddleName)
+	if policy.BackupS3AccessKey == "" && policy.BackupS3SecretKey == "" {
+		_, err := m.K8sClientSet.CoreV1().Secrets(info.MiddleNamespace).Get(context.TODO(), name, v12.GetOptions{})
+		if k8serrors.IsNotFound(err) {
+			return errors.New("备份保存到S3时需要填写访问密钥")
+		}
+		return err
+	}
+
+	secret := &v13.Secret{
+		TypeMeta: v12.TypeMeta{
+			Kind:       "Secret",
+			APIVersion: "v1",
+		},
+		ObjectMeta: v12.ObjectMeta{
+			Name:      name,
+			Namespace: info.MiddleNamespace,
+			Labels: map[string]string{
+				"middle-backup": info.MiddleName,
+				"author":        "Paas",
+			},
+		},
+		Type: v13.SecretTypeOpaque,
+		Data: map[string][]byte{
+			s3AccessKey: []byte(policy.BackupS3AccessKey),
+			s3SecretKey: []byte(policy.BackupS3SecretKey),
+		},
+	}
+	data, err := common.ApplyData(secret)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	opts, err := common.ApplyOptions(func() (v12.Object, error) {
+		return m.K8sClientSet.CoreV1().Secrets(info.MiddleNamespace).Get(context.TODO(), name, v12.GetOptions{})
+	})
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	_, err = m.K8sClientSet.CoreV1().Secrets(info.MiddleNamespace).Patch(context.TODO(), name, types.ApplyPatchType, data, opts)
+	if err != nil {
+		err = common.ApplyError("备份访问密钥", name, err)
+		common.Error(err)
+		return err
+	}
+	return nil
+}
+
+// setCronJob 设置定时备份任务
+// 备份在init容器中执行，完成后由主容器保存到PVC或者上传到S3，并清理超过保留数量的备份
+func (m *MiddleBackupDataService) setCronJob(info *middleware.MiddlewareInfo, policy *model.MiddleBackupPolicy, tpl *template.Template) *batchv1.CronJob {
+	labels := map[string]string{
+		"middle-backup": info.MiddleName,
+		"author":        "Paas",
+	}
+	history := policy.BackupRetention
+	failedHistory := int32(3)
+	backoffLimit := int32(2)
+
+	env := append(backupEnv(info, tpl),
+		v13.EnvVar{Name: "JOB_NAME", ValueFrom: &v13.EnvVarSource{
+			FieldRef: &v13.ObjectFieldSelector{FieldPath: "metadata.labels['job-name']"},
+		}},
+		v13.EnvVar{Name: "BACKUP_FILE", Value: backupDir + "/$(JOB_NAME)." + tpl.Backup.Ext},
+		v13.EnvVar{Name: "BACKUP_RETENTION", Value: strconv.FormatInt(int64(policy.BackupRetention), 10)},
+	)
+
+	// 备份失败时删除不完整的文件
+	dump := v13.Container{
+		Name:         "dump",
+		Image:        info.MiddleDockerImageVersion,
+		Command:      []string{"sh", "-c", tpl.Backup.Dump + ` || { rm -f "$BACKUP_FILE"; exit 1; }`},
+		Env:          env,
+		VolumeMounts: []v13.VolumeMount{backupMount(info, policy)},
+	}
+
+	var store v13.Container
+	if policy.BackupTarget == BackupTargetS3 {
+		store = v13.Container{
+			Name:  "upload",
+			Image: envOrDefault(s3ImageEnv, "minio/mc"),
+			Command: []string{"sh", "-c", s3Alias + ` &&
+mc mb --ignore-existing "target/$S3_BUCKET" &&
+mc cp "$BACKUP_FILE" "target/$S3_BUCKET/$BACKUP_PREFIX/" &&
+mc ls "target/$S3_BUCKET/$BACKUP_PREFIX/" | awk '{print $NF}' | sort -r | tail -n +$((BACKUP_RETENTION+1)) | while read f; do mc rm "target/$S3_BUCKET/$BACKUP_PREFIX/$f"; done`},
+			Env: append(env, s3Env(info, policy)...),
+		}
+	} else {
+		// 文件名以任务名开头，任务名以调度时间结尾，按名称倒序即为从新到旧
+		store = v13.Container{
+			Name:    "prune",
+			Image:   envOrDefault(backupImageEnv, "alpine:3.18"),
+			Command: []string{"sh", "-c", `cd "` + backupDir + `" && ls -1 | sort -r | tail -n +$((BACKUP_RETENTION+1)) | xargs -r rm -f`},
+			Env:     env,
+		}
+	}
+	store.VolumeMounts = []v13.VolumeMount{backupMount(info, policy)}
+
+	return &batchv1.CronJob{
+		TypeMeta: v12.TypeMeta{
+			Kind:       "CronJob",
+			APIVersion: "batch/v1",
+		},
+		ObjectMeta: v12.ObjectMeta{
+			Name:      BackupCronJobName(info.MiddleName),
+			Namespace: info.MiddleNamespace,
+			Labels:    labels,
+		},
+		Spec: batchv1.CronJobSpec{
+			Schedule:                   policy.BackupSchedule,
+			ConcurrencyPolicy:          batchv1.ForbidConcurrent,
+			SuccessfulJobsHistoryLimit: &history,
+			FailedJobsHistoryLimit:     &failedHistory,
+			JobTemplate: batchv1.JobTemplateSpec{
+				ObjectMeta: v12.ObjectMeta{Labels: labels},
+				Spec: batchv1.JobSpec{
+					BackoffLimit: &backoffLimit,
+					Template: v13.PodTemplateSpec{
+						// 不能使用 app-name 标签，否则会被中间件的服务选中
+						ObjectMeta: v12.ObjectMeta{Labels: labels},
+						Spec: v13.PodSpec{
+							RestartPolicy:  v13.RestartPolicyNever,
+							InitContainers: []v13.Container{dump},
+							Containers:     []v13.Container{store},
+							Volumes:        []v13.Volume{backupVolume(policy)},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// setRestoreJob 设置恢复任务
+// S3中的备份先由init容器下载，再在中间件的镜像中执行恢复命令
+func (m *MiddleBackupDataService) setRestoreJob(source, target *middleware.MiddlewareInfo, policy *model.MiddleBackupPolicy, backup *model.MiddleBackup, tpl *template.Template) *batchv1.Job {
+	labels := map[string]string{
+		"middle-restore": target.MiddleName,
+		"author":         "Paas",
+	}
+	backoffLimit := int32(6)
+
+	env := append(backupEnv(target, tpl),
+		v13.EnvVar{Name: "BACKUP_FILE", Value: backupDir + "/" + backup.BackupName},
+		v13.EnvVar{Name: "DATA_DIR", Value: restoreDataDir},
+	)
+	restore := v13.Container{
+		Name:         "restore",
+		Image:        target.MiddleDockerImageVersion,
+		Command:      []string{"sh", "-c", tpl.Backup.Restore},
+		Env:          env,
+		VolumeMounts: []v13.VolumeMount{backupMount(source, policy)},
+	}
+	volumes := []v13.Volume{backupVolume(policy)}
+
+	// 离线恢复直接写入第一个实例的数据存储
+	if tpl.Backup.Offline {
+		restore.VolumeMounts = append(restore.VolumeMounts, v13.VolumeMount{
+			Name:      "data",
+			MountPath: restoreDataDir,
+			SubPath:   tpl.DataSubPath,
+		})
+		volumes = append(volumes, v13.Volume{
+			Name: "data",
+			VolumeSource: v13.VolumeSource{
+				PersistentVolumeClaim: &v13.PersistentVolumeClaimVolumeSource{
+					ClaimName: dataPVCName(target, tpl),
+				},
+			},
+		})
+	}
+
+	var initContainers []v13.Container
+	if policy.BackupTarget == BackupTargetS3 {
+		initContainers = append(initContainers, v13.Container{
+			Name:    "download",
+			Image:   envOrDefault(s3ImageEnv, "minio/mc"),
+			Command: []string{"sh", "-c", s3Alias + ` && mc cp "target/$S3_BUCKET/$BACKUP_PREFIX/` + backup.BackupName + `" "$BACKUP_FILE"`},
+			Env:     append(env, s3Env(source, policy)...),
+			VolumeMounts: []v13.VolumeMount{
+				backupMount(source, policy),
+			},
+		})
+	}
+
+	return &batchv1.Job{
+		TypeMeta: v12.TypeMeta{
+			Kind:       "Job",
+			APIVersion: "batch/v1",
+		},
+		ObjectMeta: v12.ObjectMeta{
+			Name:      target.MiddleName + "-restore-" + strconv.FormatInt(time.Now().Unix(), 10),
+			Namespace: target.MiddleNamespace,
+			Labels:    labels,
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit: &backoffLimit,
+			Template: v13.PodTemplateSpec{
+				ObjectMeta: v12.ObjectMeta{Labels: labels},
+				Spec: v13.PodSpec{
+					RestartPolicy:  v13.RestartPolicyNever,
+					InitContainers: initContainers,
+					Containers:     []v13.Container{restore},
+					Volumes:        volumes,
+				},
+			},
+		},
+	}
+}
+
+// s3Alias 配置 mc 访问S3兼容存储
+const s3Alias = `mc alias set target "$S3_ENDPOINT" "$S3_ACCESS_KEY" "$S3_SECRET_KEY" > /dev/null`
+
+// backupEnv 连接中间件需要的环境变量，账号密码从Secret中注入
+func backupEnv(info *middleware.MiddlewareInfo, tpl *template.Template) []v13.EnvVar {
+	env := []v13.EnvVar{
+		// 从第一个实例备份，通过headless服务访问
+		{Name: "BACKUP_HOST", Value: info.MiddleName + "-0." + info.MiddleName + "." + info.MiddleNamespace + ".svc"},
+	}
+	optional := true
+	for _, credential := range tpl.Credentials {
+		env = append(env, v13.EnvVar{
+			Name: credential.Env,
+			ValueFrom: &v13.EnvVarSource{
+				SecretKeyRef: &v13.SecretKeySelector{
+					LocalObjectReference: v13.LocalObjectReference{Name: CredentialSecretName(info.MiddleName)},
+					Key:                  credential.Key,
+					Optional:             &optional,
+				},
+			},
+		})
+	}
+	return env
+}
+
+// s3Env 访问S3需要的环境变量，备份按 命名空间/名称 分目录保存
+func s3Env(info *middleware.MiddlewareInfo, policy *model.MiddleBackupPolicy) []v13.EnvVar {
+	secretRef := func(key string) *v13.EnvVarSource {
+		return &v13.EnvVarSource{
+			SecretKeyRef: &v13.SecretKeySelector{
+				LocalObjectReference: v13.LocalObjectReference{Name: backupS3SecretName(info.MiddleName)},
+				Key:                  key,
+			},
+		}
+	}
+	return []v13.EnvVar{
+		{Name: "S3_ENDPOINT", Value: policy.BackupS3Endpoint},
+		{Name: "S3_BUCKET", Value: policy.BackupS3Bucket},
+		{Name: "S3_ACCESS_KEY", ValueFrom: secretRef(s3AccessKey)},
+		{Name: "S3_SECRET_KEY", ValueFrom: secretRef(s3SecretKey)},
+		{Name: "BACKUP_PREFIX", Value: info.MiddleNamespace + "/" + info.MiddleName},
+		// mc 的配置写入可写目录
+		{Name: "HOME", Value: "/tmp"},
+	}
+}
+
+// backupVolume 保存备份的卷，S3时使用临时目录
+func backupVolume(policy *model.MiddleBackupPolicy) v13.Volume {
+	volume := v13.Volume{Name: "backup"}
+	if policy.BackupTarget == BackupTargetPVC {
+		volume.PersistentVolumeClaim = &v13.PersistentVolumeClaimVolumeSource{ClaimName: policy.BackupPvcName}
+	} else {
+		volume.EmptyDir = &v13.EmptyDirVolumeSource{}
+	}
+	return volume
+}
+
+// backupMount 挂载备份目录，PVC中按中间件名称分目录
+func backupMount(info *middleware.MiddlewareInfo, policy *model.MiddleBackupPolicy) v13.VolumeMount {
+	mount := v13.VolumeMount{Name: "backup", MountPath: backupDir}
+	if policy.BackupTarget == BackupTargetPVC {
+		mount.SubPath = info.MiddleName
+	}
+	return mount
+}
+
+// backupLocation 备份文件的完整位置
+func backupLocation(info *middleware.MiddlewareInfo, policy *model.MiddleBackupPolicy, fileName string) string {
+	if policy.BackupTarget == BackupTargetS3 {
+		return "s3://" + policy.BackupS3Bucket + "/" + info.MiddleNamespace + "/" + info.MiddleName + "/" + fileName
+	}
+	return "pvc://" + policy.BackupPvcName + "/" + info.MiddleName + "/" + fileName
+}
+
+// backupJobStatus 获取备份任务的状态
+func backupJobStatus(job *batchv1.Job) (string, string) {
+	if job.Status.Succeeded > 0 {
+		return BackupSucceeded, "成功"
+	}
+	for _, condition := range job.Status.Conditions {
+		if condition.Type == batchv1.JobFailed && condition.Status == v13.ConditionTrue {
+			return BackupFailed, "失败：" + condition.Reason + " " + condition.Message
+		}
+	}
+	return BackupRunning, "执行中"
+}
+
+// backupTemplate 获取支持备份的模板
+func backupTemplate(typeName string) (*template.Template, error) {
+	tpl, ok := template.Get(typeName)
+	if !ok || tpl.Backup == nil {
+		return nil, errors.New("中间件类型 " + typeName + " 不支持备份")
+	}
+	return tpl, nil
+}
+
+// dataStorageName 挂载到模板数据目录的存储名称
+func dataStorageName(info *middleware.MiddlewareInfo, tpl *template.Template) string {
+	for _, storage := range info.MiddleStorage {
+		if storage.MiddleStoragePath == tpl.DataPath {
+			return storage.MiddleStorageName
+		}
+	}
+	return ""
+}
+
+// dataPVCName 第一个实例的数据存储，名称由StatefulSet生成：<存储名称>-<名称>-0
+func dataPVCName(info *middleware.MiddlewareInfo, tpl *template.Template) string {
+	return dataStorageName(info, tpl) + "-" + info.MiddleName + "-0"
+}
+
+// envOrDefault 读取环境变量，为空时使用默认值
+func envOrDefault(key, value string) string {
+	if env := os.Getenv(key); env != "" {
+		return env
+	}
+	return value
+}
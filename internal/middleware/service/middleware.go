@@ -2,11 +2,13 @@ package service
 
 import (
 	"context"
-	"errors"
 	v1 "k8s.io/api/apps/v1"
 	v13 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	v12 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/client-go/kubernetes"
 	"strconv"
 	"tini-paas/internal/middleware/model"
@@ -21,6 +23,10 @@ type MiddlewareService interface {
 	DeleteMiddleware(int64) error
 	UpdateMiddleware(*model.Middleware) error
 	FindMiddlewareByID(int64) (*model.Middleware, error)
+
+	// FindMiddlewareDetailByID 查找中间件及其端口、账号、环境变量和存储
+	FindMiddlewareDetailByID(int64) (*model.Middleware, error)
+	FindMiddlewareByNamespaceAndName(string, string) (*model.Middleware, error)
 	FindAllMiddleware() ([]model.Middleware, error)
 
 	// FindAllMiddlewareByTypeID 根据类型查找中间件
@@ -29,10 +35,33 @@ type MiddlewareService interface {
 	CreateToK8s(*middleware.MiddlewareInfo) error
 	DeleteFromK8s(*model.Middleware) error
 	UpdateToK8s(*middleware.MiddlewareInfo) error
+
+	// GetCredentials 读取中间件的账号密码
+	GetCredentials(string, string) (*middleware.MiddleConfig, error)
+
+	// MigrateCredentials 将旧版本保存在数据库中的密码迁移到Secret
+	MigrateCredentials() error
+
+	// ResizeStorage 在线扩容中间件的存储，需要包含存储信息的中间件
+	ResizeStorage(*model.Middleware, string, float32) error
+
+	// ResumeStorageResize 服务启动时继续跟踪未完成的存储扩容
+	ResumeStorageResize() error
+
+	// UpdateMiddlewareReplicas 只修改数据库中的副本数，允许为0
+	UpdateMiddlewareReplicas(int64, int32) error
+
+	// UpgradeToK8s 滚动升级中间件的镜像，升级前执行传入的备份
+	UpgradeToK8s(*middleware.MiddlewareInfo, string, int64, func() error) error
+
+	// CheckStorageClass 检查存储使用的存储类，未指定时使用集群的默认存储类
+	CheckStorageClass(*middleware.MiddlewareInfo) error
+	// ExportYAML 导出应用到k8s的全部对象，不包含密码
+	ExportYAML(*middleware.MiddlewareInfo) (string, error)
 }
 
 // NewMiddlewareService 初始化中间件服务
-func NewMiddlewareService(middlewareRepository repository.MiddlewareRepository, clientSet *kubernetes.Clientset) MiddlewareService {
+func NewMiddlewareService(middlewareRepository repository.MiddlewareRepository, clientSet kubernetes.Interface) MiddlewareService {
 	return &MiddlewareDataService{
 		MiddlewareRepository: middlewareRepository,
 		K8sClientSet:         clientSet,
@@ -42,7 +71,7 @@ func NewMiddlewareService(middlewareRepository repository.MiddlewareRepository,
 // MiddlewareDataService  中间件服务操作对象
 type MiddlewareDataService struct {
 	MiddlewareRepository repository.MiddlewareRepository
-	K8sClientSet         *kubernetes.Clientset
+	K8sClientSet         kubernetes.Interface
 }
 
 func (m *MiddlewareDataService) AddMiddleware(middle *model.Middleware) (int64, error) {
@@ -57,10 +86,24 @@ func (m *MiddlewareDataService) UpdateMiddleware(middle *model.Middleware) error
 	return m.MiddlewareRepository.UpdateMiddleware(middle)
 }
 
+// UpdateMiddlewareReplicas 只修改数据库中的副本数，允许为0
+func (m *MiddlewareDataService) UpdateMiddlewareReplicas(middleID int64, replicas int32) error {
+	return m.MiddlewareRepository.UpdateMiddlewareReplicas(middleID, replicas)
+}
+
 func (m *MiddlewareDataService) FindMiddlewareByID(i int64) (*model.Middleware, error) {
 	return m.MiddlewareRepository.FindMiddlewareByID(i)
 }
 
+// FindMiddlewareDetailByID 查找中间件及其端口、账号、环境变量和存储
+func (m *MiddlewareDataService) FindMiddlewareDetailByID(i int64) (*model.Middleware, error) {
+	return m.MiddlewareRepository.FindMiddlewareDetailByID(i)
+}
+
+func (m *MiddlewareDataService) FindMiddlewareByNamespaceAndName(namespace, name string) (*model.Middleware, error) {
+	return m.MiddlewareRepository.FindMiddlewareByNamespaceAndName(namespace, name)
+}
+
 func (m *MiddlewareDataService) FindAllMiddleware() ([]model.Middleware, error) {
 	return m.MiddlewareRepository.FindAll()
 }
@@ -69,29 +112,22 @@ func (m *MiddlewareDataService) FindAllMiddlewareByTypeID(i int64) ([]model.Midd
 	return m.MiddlewareRepository.FindAllByTypeID(i)
 }
 
+// CreateToK8s 创建中间件到k8s
+// 采用服务端应用(server-side apply)，平台创建过的同名对象按照重试处理，不是平台创建的同名对象返回错误
 func (m *MiddlewareDataService) CreateToK8s(info *middleware.MiddlewareInfo) error {
-	// 设置stateful信息
-	statefulSet := m.setStatefulSet(info)
-
-	// 先查询是否存在
-	_, err := m.K8sClientSet.AppsV1().StatefulSets(info.MiddleNamespace).Get(context.TODO(), info.MiddleName, v12.GetOptions{})
+	err := common.CheckCreate("中间件", info.MiddleName, func() (v12.Object, error) {
+		return m.K8sClientSet.AppsV1().StatefulSets(info.MiddleNamespace).Get(context.TODO(), info.MiddleName, v12.GetOptions{})
+	})
 	if err != nil {
-		// 之前不存在 -> 创建
-		_, err = m.K8sClientSet.AppsV1().StatefulSets(info.MiddleNamespace).Create(context.TODO(), statefulSet, v12.CreateOptions{})
-		if err != nil {
-			// 创建失败
-			common.Error(err)
-			return err
-		}
-
-		// 创建成功
-		common.Info("中间件：" + info.MiddleName + "创建成功")
-		return nil
+		common.Error(err)
+		return err
 	}
-
-	// 之前存在
-	common.Error("中间件：" + info.MiddleName + "已经存在")
-	return errors.New("中间件：" + info.MiddleName + "已经存在")
+	err = m.applyToK8s(info)
+	if err != nil {
+		return err
+	}
+	common.Info("中间件：" + info.MiddleName + "创建成功")
+	return nil
 }
 
 func (m *MiddlewareDataService) DeleteFromK8s(middle *model.Middleware) error {
@@ -103,6 +139,27 @@ func (m *MiddlewareDataService) DeleteFromK8s(middle *model.Middleware) error {
 		return err
 	}
 
+	// 删除对应的服务
+	err = m.deleteServices(middle.MiddleNamespace, middle.MiddleName)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+
+	// 删除账号密码
+	err = m.deleteCredentials(middle.MiddleNamespace, middle.MiddleName)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+
+	// 删除配置文件
+	err = m.deleteConfig(middle.MiddleNamespace, middle.MiddleName)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+
 	// 删除数据库信息
 	err = m.MiddlewareRepository.DeleteMiddleware(middle.ID)
 	if err != nil {
@@ -114,30 +171,191 @@ func (m *MiddlewareDataService) DeleteFromK8s(middle *model.Middleware) error {
 	return nil
 }
 
+// UpdateToK8s 更新中间件到k8s
 func (m *MiddlewareDataService) UpdateToK8s(info *middleware.MiddlewareInfo) error {
-	// 设置stateful信息
+	err := m.applyToK8s(info)
+	if err != nil {
+		return err
+	}
+	common.Info("中间件 " + info.MiddleName + " 更新成功！")
+	return nil
+}
+
+// applyToK8s 以平台字段管理者的身份将statefulSet应用到k8s
+// 先应用账号密码、配置文件和服务，保证pod启动时依赖的资源已经存在
+func (m *MiddlewareDataService) applyToK8s(info *middleware.MiddlewareInfo) error {
+	err := m.applyCredentials(info)
+	if err != nil {
+		return err
+	}
+
+	err = m.applyConfig(info)
+	if err != nil {
+		return err
+	}
+
+	err = m.applyServices(info)
+	if err != nil {
+		return err
+	}
+	return m.applyStatefulSet(info, nil)
+}
+
+// applyStatefulSet 应用statefulSet，partition 不为空时只更新序号不小于partition的实例
+func (m *MiddlewareDataService) applyStatefulSet(info *middleware.MiddlewareInfo, partition *int32) error {
 	statefulSet := m.setStatefulSet(info)
+	if partition != nil {
+		statefulSet.Spec.UpdateStrategy = v1.StatefulSetUpdateStrategy{
+			Type: v1.RollingUpdateStatefulSetStrategyType,
+			RollingUpdate: &v1.RollingUpdateStatefulSetStrategy{
+				Partition: partition,
+			},
+		}
+	}
 
-	// 先查询是否存在
-	_, err := m.K8sClientSet.AppsV1().StatefulSets(info.MiddleNamespace).Get(context.TODO(), info.MiddleName, v12.GetOptions{})
+	// VolumeClaimTemplates 创建后不能修改，存储大小通过 ResizeStorage 逐个扩容PVC
+	// 数据目录保持创建时的布局
+	existing, err := m.K8sClientSet.AppsV1().StatefulSets(info.MiddleNamespace).Get(context.TODO(), info.MiddleName, v12.GetOptions{})
+	if err != nil && !k8serrors.IsNotFound(err) {
+		common.Error(err)
+		return err
+	}
+	opts := common.ApplyOptionsFor(nil)
+	if err == nil {
+		statefulSet.Spec.VolumeClaimTemplates = existing.Spec.VolumeClaimTemplates
+		keepDataLayout(info, statefulSet, existing)
+		opts = common.ApplyOptionsFor(existing)
+	}
+
+	data, err := common.ApplyData(statefulSet)
 	if err != nil {
 		common.Error(err)
-		return errors.New("中间件 " + info.MiddleName + " 不存在请先创建")
+		return err
 	}
 
-	// 已经存在，可以更新
-	_, err = m.K8sClientSet.AppsV1().StatefulSets(info.MiddleNamespace).Update(context.TODO(), statefulSet, v12.UpdateOptions{})
+	// 字段冲突时不强制覆盖，返回冲突信息
+	_, err = m.K8sClientSet.AppsV1().StatefulSets(info.MiddleNamespace).Patch(context.TODO(), info.MiddleName, types.ApplyPatchType, data, opts)
 	if err != nil {
-		// 更新失败
+		err = common.ApplyError("中间件", info.MiddleName, err)
 		common.Error(err)
 		return err
 	}
+	return nil
+}
 
-	// 更新成功
-	common.Info("中间件 " + info.MiddleName + " 更新成功！")
+// applyServices 应用中间件的headless服务和客户端服务
+func (m *MiddlewareDataService) applyServices(info *middleware.MiddlewareInfo) error {
+	services := []*v13.Service{m.setHeadlessService(info)}
+	if len(info.MiddlePort) > 0 {
+		services = append(services, m.setClientService(info))
+	} else {
+		// 没有端口时不需要客户端服务，删除之前创建的
+		err := m.K8sClientSet.CoreV1().Services(info.MiddleNamespace).Delete(context.TODO(), clientServiceName(info.MiddleName), v12.DeleteOptions{})
+		if err != nil && !k8serrors.IsNotFound(err) {
+			common.Error(err)
+			return err
+		}
+	}
+
+	for _, service := range services {
+		data, err := common.ApplyData(service)
+		if err != nil {
+			common.Error(err)
+			return err
+		}
+
+		opts, err := common.ApplyOptions(func() (v12.Object, error) {
+			return m.K8sClientSet.CoreV1().Services(info.MiddleNamespace).Get(context.TODO(), service.Name, v12.GetOptions{})
+		})
+		if err != nil {
+			common.Error(err)
+			return err
+		}
+		_, err = m.K8sClientSet.CoreV1().Services(info.MiddleNamespace).Patch(context.TODO(), service.Name, types.ApplyPatchType, data, opts)
+		if err != nil {
+			err = common.ApplyError("中间件服务", service.Name, err)
+			common.Error(err)
+			return err
+		}
+	}
+	return nil
+}
+
+// deleteServices 删除中间件的headless服务和客户端服务
+func (m *MiddlewareDataService) deleteServices(namespace, name string) error {
+	for _, serviceName := range []string{name, clientServiceName(name)} {
+		err := m.K8sClientSet.CoreV1().Services(namespace).Delete(context.TODO(), serviceName, v12.DeleteOptions{})
+		if err != nil && !k8serrors.IsNotFound(err) {
+			return err
+		}
+	}
 	return nil
 }
 
+// clientServiceName 客户端服务名称
+func clientServiceName(name string) string {
+	return name + "-client"
+}
+
+// setHeadlessService 设置StatefulSet的headless服务
+// 名称与 StatefulSet.Spec.ServiceName 一致，每个pod获得稳定的DNS：<name>-<序号>.<name>.<namespace>.svc
+func (m *MiddlewareDataService) setHeadlessService(info *middleware.MiddlewareInfo) *v13.Service {
+	service := m.setService(info, info.MiddleName)
+	service.Spec.ClusterIP = v13.ClusterIPNone
+	// 未就绪的pod也需要解析，集群成员之间依赖它完成初始化
+	service.Spec.PublishNotReadyAddresses = true
+	return service
+}
+
+// setClientService 设置应用访问中间件的ClusterIP服务
+func (m *MiddlewareDataService) setClientService(info *middleware.MiddlewareInfo) *v13.Service {
+	service := m.setService(info, clientServiceName(info.MiddleName))
+	service.Spec.Type = v13.ServiceTypeClusterIP
+	return service
+}
+
+// setService 根据中间件端口设置服务
+func (m *MiddlewareDataService) setService(info *middleware.MiddlewareInfo, name string) *v13.Service {
+	service := &v13.Service{}
+
+	service.TypeMeta = v12.TypeMeta{
+		Kind:       "Service",
+		APIVersion: "v1",
+	}
+
+	service.ObjectMeta = v12.ObjectMeta{
+		Name:      name,
+		Namespace: info.MiddleNamespace,
+		Labels: map[string]string{
+			"app-name": info.MiddleName,
+			"author":   "Paas",
+		},
+	}
+
+	service.Spec = v13.ServiceSpec{
+		Selector: map[string]string{
+			"app-name": info.MiddleName,
+		},
+		Ports: m.getServicePort(info),
+	}
+	return service
+}
+
+// getServicePort 获取服务端口，与容器端口一一对应
+func (m *MiddlewareDataService) getServicePort(info *middleware.MiddlewareInfo) []v13.ServicePort {
+	var servicePort []v13.ServicePort
+
+	for _, port := range info.MiddlePort {
+		servicePort = append(servicePort, v13.ServicePort{
+			Name:       "middle-port-" + strconv.FormatInt(int64(port.MiddlePort), 10),
+			Port:       port.MiddlePort,
+			TargetPort: intstr.FromInt(int(port.MiddlePort)),
+			Protocol:   m.getProtocol(port.MiddleProtocol),
+		})
+	}
+	return servicePort
+}
+
 // setStatefulSet 根据info信息设置值
 func (m *MiddlewareDataService) setStatefulSet(info *middleware.MiddlewareInfo) *v1.StatefulSet {
 	statefulSet := &v1.StatefulSet{}
@@ -145,7 +363,7 @@ func (m *MiddlewareDataService) setStatefulSet(info *middleware.MiddlewareInfo)
 	// 设置接口类型
 	statefulSet.TypeMeta = v12.TypeMeta{
 		Kind:       "StatefulSet",
-		APIVersion: "v1",
+		APIVersion: "apps/v1",
 	}
 
 	// 设置详细信息
@@ -204,6 +422,8 @@ func (m *MiddlewareDataService) setStatefulSet(info *middleware.MiddlewareInfo)
 		ServiceName:          info.MiddleName,
 	}
 
+	// 根据类型模板设置启动命令、参数、探针和配置文件
+	m.setTemplate(info, &statefulSet.Spec.Template)
 	return statefulSet
 }
 
@@ -246,7 +466,9 @@ func (m *MiddlewareDataService) getEnv(info *middleware.MiddlewareInfo) []v13.En
 			ValueFrom: nil,
 		})
 	}
-	return envVar
+
+	// 账号密码从Secret中注入
+	return append(envVar, m.getCredentialEnv(info)...)
 }
 
 // getResources 获取容器的资源配额
@@ -283,17 +505,18 @@ func (m *MiddlewareDataService) getPVC(info *middleware.MiddlewareInfo) []v13.Pe
 				Name:      storage.MiddleStorageName,
 				Namespace: info.MiddleNamespace,
 				Annotations: map[string]string{
-					"pv.kubernetes.io/bound-by-controller":          "yes",
-					"volume.beta,kubernetes.io/storage-provisioner": "rbd.csi.ceph.com",
+					"pv.kubernetes.io/bound-by-controller": "yes",
 				},
 			},
+			// 每个实例的PVC由存储类动态创建，不绑定指定的PV
 			Spec: v13.PersistentVolumeClaimSpec{
-				AccessModes:      m.getAccessModes(storage.MiddleStorageAccessMode),
-				Resources:        m.getPVCResource(storage.MiddleStorageSize),
-				VolumeName:       storage.MiddleStorageName,
-				StorageClassName: &storage.MiddleStorageClass,
+				AccessModes: m.getAccessModes(storage.MiddleStorageAccessMode),
+				Resources:   m.getPVCResource(storage.MiddleStorageSize),
 			},
 		}
+		if storage.MiddleStorageClass != "" {
+			pvc.Spec.StorageClassName = &storage.MiddleStorageClass
+		}
 		pvcAll = append(pvcAll, *pvc)
 	}
 	return pvcAll
@@ -0,0 +1,203 @@
+package service
+
+import (
+	"encoding/json"
+	v1 "k8s.io/api/apps/v1"
+	v13 "k8s.io/api/core/v1"
+	v12 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+	"path/filepath"
+	"testing"
+	"tini-paas/internal/middleware/proto/middleware"
+	"tini-paas/internal/middleware/template"
+	"tini-paas/pkg/common"
+)
+
+// newTestService 假的客户端不支持服务端应用，应用的statefulSet保存在 applied 中，其它对象忽略
+// 日志写到测试的临时目录
+func newTestService(t *testing.T, applied map[string]*v1.StatefulSet, objects ...runtime.Object) *MiddlewareDataService {
+	common.SetLogFile(filepath.Join(t.TempDir(), "micro.log"))
+	clientSet := fake.NewSimpleClientset(objects...)
+	clientSet.PrependReactor("patch", "*", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		patch := action.(k8stesting.PatchAction)
+		if patch.GetResource().Resource != "statefulsets" {
+			return true, nil, nil
+		}
+		statefulSet := &v1.StatefulSet{}
+		err := json.Unmarshal(patch.GetPatch(), statefulSet)
+		applied[patch.GetName()] = statefulSet
+		return true, statefulSet, err
+	})
+	return &MiddlewareDataService{K8sClientSet: clientSet}
+}
+
+// newTestInfo 中间件信息，账号密码已经从Secret中读取
+func newTestInfo(typeName, dataPath string) *middleware.MiddlewareInfo {
+	return &middleware.MiddlewareInfo{
+		MiddleName:               "db",
+		MiddleNamespace:          "default",
+		MiddleTypeName:           typeName,
+		MiddleDockerImageVersion: typeName + ":latest",
+		MiddleReplicas:           1,
+		MiddleStorage: []*middleware.MiddleStorage{
+			{MiddleStorageName: "data", MiddleStoragePath: dataPath, MiddleStorageSize: 1, MiddleStorageAccessMode: "ReadWriteOnce"},
+		},
+		MiddleConfig: &middleware.MiddleConfig{
+			MiddleConfigRootUser:   "root",
+			MiddleConfigRootPwd:    "secret",
+			MiddleConfigSecretName: CredentialSecretName("db"),
+		},
+	}
+}
+
+// newLegacyStatefulSet 没有使用模板时创建的statefulSet，数据直接放在存储的根目录
+func newLegacyStatefulSet(dataPath string) *v1.StatefulSet {
+	return &v1.StatefulSet{
+		ObjectMeta: v12.ObjectMeta{Name: "db", Namespace: "default"},
+		Spec: v1.StatefulSetSpec{
+			Template: v13.PodTemplateSpec{
+				Spec: v13.PodSpec{
+					Containers: []v13.Container{{
+						Name:         "db",
+						Env:          []v13.EnvVar{{Name: "TZ", Value: "Asia/Shanghai"}},
+						VolumeMounts: []v13.VolumeMount{{Name: "data", MountPath: dataPath}},
+					}},
+				},
+			},
+		},
+	}
+}
+
+// dataMount 数据目录的挂载
+func dataMount(t *testing.T, statefulSet *v1.StatefulSet, dataPath string) v13.VolumeMount {
+	t.Helper()
+	for _, mount := range statefulSet.Spec.Template.Spec.Containers[0].VolumeMounts {
+		if mount.MountPath == dataPath {
+			return mount
+		}
+	}
+	t.Fatalf("data mount %s not found", dataPath)
+	return v13.VolumeMount{}
+}
+
+// findEnv 查找容器的环境变量
+func findEnv(statefulSet *v1.StatefulSet, name string) (v13.EnvVar, bool) {
+	for _, env := range statefulSet.Spec.Template.Spec.Containers[0].Env {
+		if env.Name == name {
+			return env, true
+		}
+	}
+	return v13.EnvVar{}, false
+}
+
+func TestApplyStatefulSetKeepsDataLayout(t *testing.T) {
+	tests := []struct {
+		name        string
+		typeName    string
+		dataPath    string
+		wantSubPath string
+		wantPGDATA  bool
+	}{
+		{name: "mysql", typeName: "mysql", dataPath: "/var/lib/mysql", wantSubPath: "mysql"},
+		{name: "postgres", typeName: "postgres", dataPath: "/var/lib/postgresql/data", wantPGDATA: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name+" existing", func(t *testing.T) {
+			applied := map[string]*v1.StatefulSet{}
+			m := newTestService(t, applied, newLegacyStatefulSet(tt.dataPath))
+
+			err := m.applyStatefulSet(newTestInfo(tt.typeName, tt.dataPath), nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			statefulSet := applied["db"]
+			if mount := dataMount(t, statefulSet, tt.dataPath); mount.SubPath != "" {
+				t.Errorf("data subPath = %q, want unchanged empty subPath", mount.SubPath)
+			}
+			if env, ok := findEnv(statefulSet, "PGDATA"); ok {
+				t.Errorf("PGDATA = %q added to an existing statefulSet", env.Value)
+			}
+		})
+
+		t.Run(tt.name+" new", func(t *testing.T) {
+			applied := map[string]*v1.StatefulSet{}
+			m := newTestService(t, applied)
+
+			err := m.applyStatefulSet(newTestInfo(tt.typeName, tt.dataPath), nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			statefulSet := applied["db"]
+			if mount := dataMount(t, statefulSet, tt.dataPath); mount.SubPath != tt.wantSubPath {
+				t.Errorf("data subPath = %q, want %q", mount.SubPath, tt.wantSubPath)
+			}
+			if _, ok := findEnv(statefulSet, "PGDATA"); ok != tt.wantPGDATA {
+				t.Errorf("PGDATA set = %v, want %v", ok, tt.wantPGDATA)
+			}
+		})
+	}
+}
+
+func TestApplyStatefulSetKeepsUserDataEnv(t *testing.T) {
+	applied := map[string]*v1.StatefulSet{}
+	m := newTestService(t, applied, newLegacyStatefulSet("/var/lib/postgresql/data"))
+	info := newTestInfo("postgres", "/var/lib/postgresql/data")
+	info.MiddleEnv = []*middleware.MiddleEnv{{EbvKey: "PGDATA", EnvValue: "/var/lib/postgresql/data/custom"}}
+
+	err := m.applyStatefulSet(info, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	env, ok := findEnv(applied["db"], "PGDATA")
+	if !ok || env.Value != "/var/lib/postgresql/data/custom" {
+		t.Errorf("PGDATA = %+v, want the value set by the user", env)
+	}
+}
+
+func TestUpgradeKeepsCredentialEnv(t *testing.T) {
+	secret := &v13.Secret{
+		ObjectMeta: v12.ObjectMeta{Name: CredentialSecretName("db"), Namespace: "default"},
+		Data: map[string][]byte{
+			template.SecretRootUser: []byte("postgres"),
+			template.SecretRootPwd:  []byte("secret"),
+		},
+	}
+	applied := map[string]*v1.StatefulSet{}
+	m := newTestService(t, applied, secret, newLegacyStatefulSet("/var/lib/postgresql/data"))
+
+	// 数据库中的密码已经清除
+	info := newTestInfo("postgres", "/var/lib/postgresql/data")
+	info.MiddleConfig = &middleware.MiddleConfig{
+		MiddleConfigRootUser:   "postgres",
+		MiddleConfigSecretName: CredentialSecretName("db"),
+	}
+	source, target, err := m.upgradeInfo(info, "postgres:16", 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, upgradeInfo := range map[string]*middleware.MiddlewareInfo{"target": target, "source": source} {
+		ordinal := int32(0)
+		err = m.applyStatefulSet(upgradeInfo, &ordinal)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, key := range []string{"POSTGRES_USER", "POSTGRES_PASSWORD"} {
+			env, ok := findEnv(applied["db"], key)
+			if !ok || env.ValueFrom == nil || env.ValueFrom.SecretKeyRef == nil {
+				t.Errorf("%s %s = %+v, want secretKeyRef", name, key, env)
+				continue
+			}
+			if env.ValueFrom.SecretKeyRef.Name != CredentialSecretName("db") {
+				t.Errorf("%s %s secret = %s, want %s", name, key, env.ValueFrom.SecretKeyRef.Name, CredentialSecretName("db"))
+			}
+		}
+	}
+	if target.MiddleDockerImageVersion != "postgres:16" || source.MiddleDockerImageVersion != info.MiddleDockerImageVersion {
+		t.Errorf("images = %s -> %s", source.MiddleDockerImageVersion, target.MiddleDockerImageVersion)
+	}
+}
@@ -0,0 +1,197 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	v13 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	v12 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"strconv"
+	"sync"
+	"tini-paas/internal/middleware/model"
+	"tini-paas/internal/middleware/proto/middleware"
+	"tini-paas/pkg/common"
+)
+
+// StoragePVCName StatefulSet为每个实例创建的PVC名称：<存储名称>-<名称>-<序号>
+func StoragePVCName(storageName, name string, ordinal int32) string {
+	return storageName + "-" + name + "-" + strconv.FormatInt(int64(ordinal), 10)
+}
+
+// ResizeStorage 在线扩容中间件的存储，大小单位为Gi
+// VolumeClaimTemplates 创建后不能修改，需要逐个扩容每个实例的PVC，扩容后新增的实例仍然使用创建时的大小
+func (m *MiddlewareDataService) ResizeStorage(middle *model.Middleware, storageName string, size float32) error {
+	var storage *model.MiddleStorage
+	for i := range middle.MiddleStorage {
+		if middle.MiddleStorage[i].MiddleStorageName == storageName {
+			storage = &middle.MiddleStorage[i]
+		}
+	}
+	if storage == nil {
+		return errors.New("中间件 " + middle.MiddleName + " 没有存储 " + storageName)
+	}
+	quantity := m.getPVCResource(size).Requests[v13.ResourceStorage]
+
+	// 先检查全部实例，避免只扩容了一部分
+	var pvcNames []string
+	for ordinal := int32(0); ordinal < middle.MiddleReplicas; ordinal++ {
+		pvcName := StoragePVCName(storageName, middle.MiddleName, ordinal)
+		pvc, err := m.K8sClientSet.CoreV1().PersistentVolumeClaims(middle.MiddleNamespace).Get(context.TODO(), pvcName, v12.GetOptions{})
+		if k8serrors.IsNotFound(err) {
+			// 实例还没有创建
+			continue
+		}
+		if err != nil {
+			common.Error(err)
+			return err
+		}
+		err = common.CheckPVCResize(m.K8sClientSet, pvc, quantity)
+		if err != nil {
+			common.Error(err)
+			return err
+		}
+		pvcNames = append(pvcNames, pvcName)
+	}
+	if len(pvcNames) == 0 {
+		return errors.New("中间件 " + middle.MiddleName + " 的存储 " + storageName + " 还没有创建")
+	}
+
+	// PVC由StatefulSet创建，只修改请求的大小
+	data, err := json.Marshal(map[string]interface{}{
+		"spec": map[string]interface{}{
+			"resources": map[string]interface{}{
+				"requests": map[string]string{
+					string(v13.ResourceStorage): quantity.String(),
+				},
+			},
+		},
+	})
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	for _, pvcName := range pvcNames {
+		_, err = m.K8sClientSet.CoreV1().PersistentVolumeClaims(middle.MiddleNamespace).Patch(context.TODO(), pvcName, types.MergePatchType, data, v12.PatchOptions{FieldManager: common.FieldManager})
+		if err != nil {
+			common.Error(err)
+			return err
+		}
+	}
+
+	storage.MiddleStorageSize = size
+	storage.MiddleStorageResizeStatus = common.ResizeResizing
+	err = m.MiddlewareRepository.UpdateMiddleStorage(storage)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+
+	go m.watchStorageResize(middle.MiddleNamespace, storage, pvcNames)
+	return nil
+}
+
+// ResumeStorageResize 扩容进度只在后台跟踪，服务启动时为未完成的扩容重新开始跟踪
+func (m *MiddlewareDataService) ResumeStorageResize() error {
+	storages, err := m.MiddlewareRepository.FindAllStorageByResizeStatus(common.ResizeResizing, common.ResizeFileSystemResizePending)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	for i := range storages {
+		storage := &storages[i]
+		middle, err := m.MiddlewareRepository.FindMiddlewareByID(storage.MiddleID)
+		if err != nil {
+			common.Error(err)
+			continue
+		}
+
+		var pvcNames []string
+		for ordinal := int32(0); ordinal < middle.MiddleReplicas; ordinal++ {
+			pvcName := StoragePVCName(storage.MiddleStorageName, middle.MiddleName, ordinal)
+			_, err = m.K8sClientSet.CoreV1().PersistentVolumeClaims(middle.MiddleNamespace).Get(context.TODO(), pvcName, v12.GetOptions{})
+			if err == nil {
+				pvcNames = append(pvcNames, pvcName)
+			}
+		}
+		if len(pvcNames) == 0 {
+			storage.MiddleStorageResizeStatus = common.ResizeFailed
+			err = m.MiddlewareRepository.UpdateMiddleStorage(storage)
+			if err != nil {
+				common.Error(err)
+			}
+			continue
+		}
+		common.Info("继续跟踪中间件 " + middle.MiddleName + " 存储 " + storage.MiddleStorageName + " 的扩容")
+		go m.watchStorageResize(middle.MiddleNamespace, storage, pvcNames)
+	}
+	return nil
+}
+
+// watchStorageResize 跟踪每个PVC的扩容状态，汇总后写回数据库
+func (m *MiddlewareDataService) watchStorageResize(namespace string, storage *model.MiddleStorage, pvcNames []string) {
+	var lock sync.Mutex
+	statuses := map[string]string{}
+
+	var wg sync.WaitGroup
+	for _, pvcName := range pvcNames {
+		wg.Add(1)
+		go func(pvcName string) {
+			defer wg.Done()
+			common.WatchPVCResize(m.K8sClientSet, namespace, pvcName, func(status, msg string) {
+				common.Info("存储 " + pvcName + " 扩容状态：" + status + " " + msg)
+
+				lock.Lock()
+				defer lock.Unlock()
+				statuses[pvcName] = status
+				status = resizeSummary(statuses, len(pvcNames))
+				if status == storage.MiddleStorageResizeStatus {
+					return
+				}
+				storage.MiddleStorageResizeStatus = status
+				err := m.MiddlewareRepository.UpdateMiddleStorage(storage)
+				if err != nil {
+					common.Error(err)
+				}
+			})
+		}(pvcName)
+	}
+	wg.Wait()
+}
+
+// resizeSummary 汇总扩容状态：有失败时为失败，否则为进度最慢的状态，还没有上报的PVC按扩容中处理
+func resizeSummary(statuses map[string]string, total int) string {
+	completed, pending := 0, 0
+	for _, status := range statuses {
+		switch status {
+		case common.ResizeFailed:
+			return common.ResizeFailed
+		case common.ResizeCompleted:
+			completed++
+		case common.ResizeFileSystemResizePending:
+			pending++
+		}
+	}
+	switch {
+	case completed == total:
+		return common.ResizeCompleted
+	case completed+pending == total:
+		return common.ResizeFileSystemResizePending
+	default:
+		return common.ResizeResizing
+	}
+}
+
+// CheckStorageClass 检查存储使用的存储类，未指定时使用集群的默认存储类
+func (m *MiddlewareDataService) CheckStorageClass(info *middleware.MiddlewareInfo) error {
+	for _, storage := range info.MiddleStorage {
+		name, err := common.ResolveStorageClass(m.K8sClientSet, storage.MiddleStorageClass)
+		if err != nil {
+			common.Error(err)
+			return errors.New("存储 " + storage.MiddleStorageName + "：" + err.Error())
+		}
+		storage.MiddleStorageClass = name
+	}
+	return nil
+}
@@ -0,0 +1,207 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"google.golang.org/protobuf/proto"
+	v1 "k8s.io/api/apps/v1"
+	v13 "k8s.io/api/core/v1"
+	v12 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"strconv"
+	"time"
+	"tini-paas/internal/middleware/model"
+	"tini-paas/internal/middleware/proto/middleware"
+	"tini-paas/pkg/common"
+)
+
+// 升级状态
+// 升级失败时回滚到原版本，回滚也失败时为 RollbackFailed，此时部分实例已经使用新版本，只能重新升级
+const (
+	UpgradeBackingUp      = "BackingUp"
+	UpgradeUpgrading      = "Upgrading"
+	UpgradeSucceeded      = "Succeeded"
+	UpgradeFailed         = "Failed"
+	UpgradeRollbackFailed = "RollbackFailed"
+)
+
+const (
+	// upgradePollInterval 检查实例状态的间隔
+	upgradePollInterval = 5 * time.Second
+
+	// upgradeReadyTimeout 每个实例升级后等待就绪的时间
+	upgradeReadyTimeout = 10 * time.Minute
+)
+
+// upgradeFailedReasons 容器处于这些状态时不再等待，直接停止升级
+var upgradeFailedReasons = map[string]bool{
+	"ErrImagePull":               true,
+	"ImagePullBackOff":           true,
+	"InvalidImageName":           true,
+	"CrashLoopBackOff":           true,
+	"CreateContainerConfigError": true,
+}
+
+// IsUpgrading 中间件是否正在升级
+func IsUpgrading(status string) bool {
+	return status == UpgradeBackingUp || status == UpgradeUpgrading
+}
+
+// CheckUpdatable 检查中间件是否可以直接更新
+// 升级过程中更新会去掉滚动更新的分区；回滚失败时数据库中仍是原版本，更新会把已经升级的实例降级
+func CheckUpdatable(name, status string) error {
+	if IsUpgrading(status) {
+		return errors.New("中间件 " + name + " 正在升级，请等待升级完成")
+	}
+	if status == UpgradeRollbackFailed {
+		return errors.New("中间件 " + name + " 升级失败并且没有回滚到原版本，请重新升级")
+	}
+	return nil
+}
+
+// UpgradeToK8s 将中间件升级到image，升级在后台执行，进度写入升级状态
+// 先执行preUpgrade(升级前备份)，再通过分区滚动更新从序号最大的实例开始逐个升级，
+// 实例没有就绪时停止升级，并将已经升级的实例回滚到原版本，与数据库中的版本保持一致
+func (m *MiddlewareDataService) UpgradeToK8s(info *middleware.MiddlewareInfo, image string, versionID int64, preUpgrade func() error) error {
+	_, err := m.K8sClientSet.AppsV1().StatefulSets(info.MiddleNamespace).Get(context.TODO(), info.MiddleName, v12.GetOptions{})
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+
+	source, target, err := m.upgradeInfo(info, image, versionID)
+	if err != nil {
+		return err
+	}
+
+	middle := &model.Middleware{
+		ID:                  info.Id,
+		MiddleVersionID:     info.MiddleVersionId,
+		MiddleUpgradeStatus: UpgradeBackingUp,
+		MiddleUpgradeMsg:    "升级前备份",
+	}
+	started, err := m.MiddlewareRepository.StartMiddlewareUpgrade(middle, UpgradeBackingUp, UpgradeUpgrading)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	if !started {
+		return errors.New("中间件 " + info.MiddleName + " 正在升级")
+	}
+
+	go m.upgrade(source, target, middle, preUpgrade)
+	return nil
+}
+
+// upgradeInfo 生成升级前后的中间件信息，回滚时使用升级前的信息
+// 数据库中不保存密码，与 UpdateToK8s 一样先从Secret补全账号密码，否则应用时会去掉密码的环境变量
+func (m *MiddlewareDataService) upgradeInfo(info *middleware.MiddlewareInfo, image string, versionID int64) (*middleware.MiddlewareInfo, *middleware.MiddlewareInfo, error) {
+	source := proto.Clone(info).(*middleware.MiddlewareInfo)
+	err := m.applyCredentials(source)
+	if err != nil {
+		return nil, nil, err
+	}
+	target := proto.Clone(source).(*middleware.MiddlewareInfo)
+	target.MiddleDockerImageVersion = image
+	target.MiddleVersionId = versionID
+	return source, target, nil
+}
+
+// upgrade 执行升级，结果写入升级状态
+func (m *MiddlewareDataService) upgrade(source, target *middleware.MiddlewareInfo, middle *model.Middleware, preUpgrade func() error) {
+	err := preUpgrade()
+	if err != nil {
+		common.Error(err)
+		_ = m.setUpgradeStatus(middle, UpgradeFailed, "升级前备份失败，没有开始升级："+err.Error())
+		return
+	}
+
+	err = m.rollingUpgrade(target, middle)
+	if err != nil {
+		common.Error(err)
+		// 去掉分区并使用原版本，已经升级的实例重建为原版本
+		rollbackErr := m.applyStatefulSet(source, nil)
+		if rollbackErr != nil {
+			common.Error(rollbackErr)
+			_ = m.setUpgradeStatus(middle, UpgradeRollbackFailed, err.Error()+"；回滚到原版本失败："+rollbackErr.Error())
+			return
+		}
+		_ = m.setUpgradeStatus(middle, UpgradeFailed, err.Error()+"；已回滚到原版本")
+		return
+	}
+
+	middle.MiddleVersionID = target.MiddleVersionId
+	_ = m.setUpgradeStatus(middle, UpgradeSucceeded, "已升级到 "+target.MiddleDockerImageVersion)
+	common.Info("中间件 " + target.MiddleName + " 升级到 " + target.MiddleDockerImageVersion + " 成功！")
+}
+
+// rollingUpgrade 逐个降低分区，每次只升级一个实例，等待它使用新版本就绪后再继续
+func (m *MiddlewareDataService) rollingUpgrade(target *middleware.MiddlewareInfo, middle *model.Middleware) error {
+	// 分区等于副本数时更新模板不会重建任何实例
+	for partition := target.MiddleReplicas; partition > 0; partition-- {
+		ordinal := partition - 1
+		err := m.applyStatefulSet(target, &ordinal)
+		if err != nil {
+			return err
+		}
+
+		podName := target.MiddleName + "-" + strconv.FormatInt(int64(ordinal), 10)
+		_ = m.setUpgradeStatus(middle, UpgradeUpgrading, "正在升级实例 "+podName)
+		err = m.waitPodUpgraded(target.MiddleNamespace, target.MiddleName, podName)
+		if err != nil {
+			return errors.New("实例 " + podName + " 升级后没有就绪：" + err.Error())
+		}
+	}
+
+	// 全部实例已升级，去掉分区
+	return m.applyStatefulSet(target, nil)
+}
+
+// waitPodUpgraded 等待pod使用StatefulSet的最新版本并就绪
+func (m *MiddlewareDataService) waitPodUpgraded(namespace, name, podName string) error {
+	ticker := time.NewTicker(upgradePollInterval)
+	defer ticker.Stop()
+	timeout := time.After(upgradeReadyTimeout)
+
+	for {
+		select {
+		case <-ticker.C:
+		case <-timeout:
+			return errors.New("等待就绪超时")
+		}
+
+		statefulSet, err := m.K8sClientSet.AppsV1().StatefulSets(namespace).Get(context.TODO(), name, v12.GetOptions{})
+		if err != nil {
+			return err
+		}
+		pod, err := m.K8sClientSet.CoreV1().Pods(namespace).Get(context.TODO(), podName, v12.GetOptions{})
+		if err != nil {
+			// 实例重建过程中短暂不存在
+			continue
+		}
+		if pod.Labels[v1.ControllerRevisionHashLabelKey] != statefulSet.Status.UpdateRevision {
+			continue
+		}
+
+		for _, status := range pod.Status.ContainerStatuses {
+			if status.State.Waiting != nil && upgradeFailedReasons[status.State.Waiting.Reason] {
+				return errors.New(status.State.Waiting.Reason + " " + status.State.Waiting.Message)
+			}
+		}
+		for _, condition := range pod.Status.Conditions {
+			if condition.Type == v13.PodReady && condition.Status == v13.ConditionTrue {
+				return nil
+			}
+		}
+	}
+}
+
+// setUpgradeStatus 更新升级状态
+func (m *MiddlewareDataService) setUpgradeStatus(middle *model.Middleware, status, msg string) error {
+	middle.MiddleUpgradeStatus = status
+	middle.MiddleUpgradeMsg = msg
+	err := m.MiddlewareRepository.UpdateMiddlewareUpgrade(middle)
+	if err != nil {
+		common.Error(err)
+	}
+	return err
+}
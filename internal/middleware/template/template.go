@@ -0,0 +1,329 @@
+package template
+
+import (
+	"bytes"
+	"crypto/md5"
+	"encoding/base64"
+	"strconv"
+	"strings"
+	"text/template"
+	"tini-paas/internal/middleware/proto/middleware"
+)
+
+// Secret 中保存账号密码的key
+const (
+	SecretRootUser = "root-user"
+	SecretRootPwd  = "root-password"
+	SecretUser     = "user"
+	SecretPwd      = "password"
+	SecretDataBase = "database"
+)
+
+// 规格，AddMiddleware 只需要指定规格，资源和存储由规格决定
+const (
+	SizeSmall  = "small"
+	SizeMedium = "medium"
+	SizeLarge  = "large"
+)
+
+// Size 规格对应的资源
+type Size struct {
+	// CPU 核数
+	CPU float32
+
+	// Memory 内存(字节)，与 MiddleMemory 的单位一致
+	Memory float32
+
+	// Storage 存储大小(Gi)
+	Storage float32
+}
+
+// sizes 规格列表
+var sizes = map[string]Size{
+	SizeSmall:  {CPU: 0.5, Memory: 512 * 1024 * 1024, Storage: 10},
+	SizeMedium: {CPU: 1, Memory: 2 * 1024 * 1024 * 1024, Storage: 50},
+	SizeLarge:  {CPU: 4, Memory: 8 * 1024 * 1024 * 1024, Storage: 200},
+}
+
+// Port 默认端口
+type Port struct {
+	Port     int32
+	Protocol string
+}
+
+// Env 环境变量，值可以使用模板参数，如 {{.Name}}
+type Env struct {
+	Key   string
+	Value string
+}
+
+// CredentialEnv 环境变量和Secret中key的对应关系
+type CredentialEnv struct {
+	Env string
+	Key string
+}
+
+// Probe 探针，Command 不为空时执行命令，否则检查 TCPPort
+type Probe struct {
+	Command []string
+	TCPPort int32
+}
+
+// Backup 备份和恢复命令，在中间件自己的镜像中执行，保证客户端工具与服务端版本一致
+// 命令中可以使用的环境变量：
+// BACKUP_HOST 中间件的地址，BACKUP_FILE 备份文件，DATA_DIR 数据目录(只有离线恢复时挂载)
+// 以及 Credentials 中注入的账号密码
+type Backup struct {
+	// Ext 备份文件的扩展名
+	Ext string
+
+	// Dump 备份命令，连接 BACKUP_HOST 写入 BACKUP_FILE
+	Dump string
+
+	// Restore 恢复命令，从 BACKUP_FILE 恢复
+	Restore string
+
+	// Offline 恢复时需要停止中间件，直接把备份写入 DATA_DIR
+	Offline bool
+}
+
+// Template 中间件模板
+type Template struct {
+	// Type 类型名称
+	Type string
+
+	// Ports 默认端口
+	Ports []Port
+
+	// Env 必需的环境变量，用户设置的同名变量优先
+	Env []Env
+
+	// RuntimeEnv 随副本数等参数变化的环境变量，不保存到中间件中
+	// 每次应用到k8s时按照当前参数重新渲染，覆盖同名的变量
+	RuntimeEnv []Env
+
+	// Command 容器启动命令，可以使用模板参数，为空时使用镜像的默认命令
+	Command []string
+
+	// Credentials 注入账号密码使用的环境变量
+	Credentials []CredentialEnv
+
+	// RootUser 默认的管理员账号
+	RootUser string
+
+	// DataPath 数据目录
+	DataPath string
+
+	// DataSubPath 数据目录使用存储中的子目录，为空时使用存储的根目录
+	// 只用于新建的中间件，已经创建的中间件保持原来的数据目录
+	DataSubPath string
+
+	// DataEnv 指定数据目录布局的环境变量，用户设置的同名变量优先
+	// 与 DataSubPath 一样只用于新建的中间件，不保存到中间件中
+	DataEnv []Env
+
+	// ConfigPath 配置文件的挂载路径，为空时不生成配置文件
+	ConfigPath string
+
+	// Config 配置文件模板，渲染后写入ConfigMap
+	Config string
+
+	// Args 容器启动参数，可以使用 $(ENV) 引用环境变量
+	Args []string
+
+	// Readiness 就绪探针
+	Readiness Probe
+
+	// Liveness 存活探针
+	Liveness Probe
+
+	// Backup 备份命令，为空时不支持备份
+	Backup *Backup
+
+	// Upgrades 允许的升级路径，为空时不支持升级
+	Upgrades []UpgradePath
+}
+
+// Params 渲染模板使用的参数
+type Params struct {
+	Name      string
+	Namespace string
+	Replicas  int32
+
+	// MemoryMB 内存限制(MB)
+	MemoryMB int64
+}
+
+// Normalize 统一中间件类型名称
+func Normalize(typeName string) string {
+	switch strings.ToLower(typeName) {
+	case "mysql", "mariadb":
+		return "mysql"
+	case "postgres", "postgresql", "pgsql":
+		return "postgres"
+	case "mongo", "mongodb":
+		return "mongodb"
+	default:
+		return strings.ToLower(typeName)
+	}
+}
+
+// Get 根据类型名称获取模板
+func Get(typeName string) (*Template, bool) {
+	t, ok := templates[Normalize(typeName)]
+	return t, ok
+}
+
+// GetSize 获取规格，未知规格使用 small
+func GetSize(size string) Size {
+	if s, ok := sizes[strings.ToLower(size)]; ok {
+		return s
+	}
+	return sizes[SizeSmall]
+}
+
+// ConfigFile 配置文件名称
+func (t *Template) ConfigFile() string {
+	return t.ConfigPath[strings.LastIndex(t.ConfigPath, "/")+1:]
+}
+
+// Apply 用模板和规格补全中间件信息，用户已经设置的值保持不变
+func (t *Template) Apply(info *middleware.MiddlewareInfo) error {
+	size := GetSize(info.MiddleSize)
+	if info.MiddleReplicas == 0 {
+		info.MiddleReplicas = 1
+	}
+	if info.MiddleCpu == 0 {
+		info.MiddleCpu = size.CPU
+	}
+	if info.MiddleMemory == 0 {
+		info.MiddleMemory = size.Memory
+	}
+
+	// 默认端口
+	if len(info.MiddlePort) == 0 {
+		for _, port := range t.Ports {
+			info.MiddlePort = append(info.MiddlePort, &middleware.MiddlePort{
+				MiddlePort:     port.Port,
+				MiddleProtocol: port.Protocol,
+			})
+		}
+	}
+
+	// 必需的环境变量
+	params := t.params(info)
+	exists := map[string]bool{}
+	for _, env := range info.MiddleEnv {
+		exists[env.EbvKey] = true
+	}
+	for _, env := range t.Env {
+		if exists[env.Key] {
+			continue
+		}
+		value, err := render(env.Value, params)
+		if err != nil {
+			return err
+		}
+		info.MiddleEnv = append(info.MiddleEnv, &middleware.MiddleEnv{
+			EbvKey:   env.Key,
+			EnvValue: value,
+		})
+	}
+
+	// 数据目录
+	if len(info.MiddleStorage) == 0 && t.DataPath != "" {
+		info.MiddleStorage = append(info.MiddleStorage, &middleware.MiddleStorage{
+			MiddleStorageName:       "data",
+			MiddleStorageSize:       size.Storage,
+			MiddleStoragePath:       t.DataPath,
+			MiddleStorageAccessMode: "ReadWriteOnce",
+		})
+	}
+	return nil
+}
+
+// RenderConfig 渲染配置文件
+func (t *Template) RenderConfig(info *middleware.MiddlewareInfo) (string, error) {
+	return render(t.Config, t.params(info))
+}
+
+// RenderRuntimeEnv 按照当前参数渲染 RuntimeEnv
+func (t *Template) RenderRuntimeEnv(info *middleware.MiddlewareInfo) ([]Env, error) {
+	params := t.params(info)
+	var envs []Env
+	for _, env := range t.RuntimeEnv {
+		value, err := render(env.Value, params)
+		if err != nil {
+			return nil, err
+		}
+		envs = append(envs, Env{Key: env.Key, Value: value})
+	}
+	return envs, nil
+}
+
+// RenderCommand 渲染容器启动命令
+func (t *Template) RenderCommand(info *middleware.MiddlewareInfo) ([]string, error) {
+	params := t.params(info)
+	var command []string
+	for _, arg := range t.Command {
+		value, err := render(arg, params)
+		if err != nil {
+			return nil, err
+		}
+		command = append(command, value)
+	}
+	return command, nil
+}
+
+// params 模板参数
+func (t *Template) params(info *middleware.MiddlewareInfo) Params {
+	return Params{
+		Name:      info.MiddleName,
+		Namespace: info.MiddleNamespace,
+		Replicas:  info.MiddleReplicas,
+		MemoryMB:  int64(info.MiddleMemory) / 1024 / 1024,
+	}
+}
+
+// render 渲染模板
+func render(text string, params Params) (string, error) {
+	if !strings.Contains(text, "{{") {
+		return text, nil
+	}
+	tpl, err := template.New("middleware").Funcs(template.FuncMap{
+		"percent": func(value int64, percent int64) int64 {
+			return value * percent / 100
+		},
+		// seq 生成 0 到 n-1 的序号，用于按照副本数生成成员列表
+		"seq": func(n int32) []int32 {
+			result := make([]int32, n)
+			for i := range result {
+				result[i] = int32(i)
+			}
+			return result
+		},
+		// clusterID 根据命名空间和名称生成固定的集群ID(16字节，base64url编码)，同一集群的成员一致
+		"clusterID": func(namespace, name string) string {
+			sum := md5.Sum([]byte(namespace + "/" + name))
+			return base64.RawURLEncoding.EncodeToString(sum[:])
+		},
+		// gb 内存的百分比换算为GB，最少0.25
+		"gb": func(value int64, percent int64) string {
+			gb := float64(value*percent/100) / 1024
+			if gb < 0.25 {
+				gb = 0.25
+			}
+			return strconv.FormatFloat(gb, 'f', 2, 64)
+		},
+	}).Parse(text)
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	err = tpl.Execute(&buf, params)
+	if err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
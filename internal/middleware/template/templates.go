@@ -0,0 +1,208 @@
+package template
+
+// templates 内置的中间件模板，key 为 Normalize 之后的类型名称
+var templates = map[string]*Template{
+	"mysql":    mysql,
+	"postgres": postgres,
+	"redis":    redis,
+	"mongodb":  mongodb,
+	"kafka":    kafka,
+	"rabbitmq": rabbitmq,
+}
+
+// mysql 官方镜像 mysql
+var mysql = &Template{
+	Type:  "mysql",
+	Ports: []Port{{Port: 3306, Protocol: "TCP"}},
+	Credentials: []CredentialEnv{
+		{Env: "MYSQL_ROOT_PASSWORD", Key: SecretRootPwd},
+		{Env: "MYSQL_USER", Key: SecretUser},
+		{Env: "MYSQL_PASSWORD", Key: SecretPwd},
+		{Env: "MYSQL_DATABASE", Key: SecretDataBase},
+	},
+	RootUser: "root",
+	DataPath: "/var/lib/mysql",
+	// 挂载点的 lost+found 会导致初始化失败，使用子目录
+	DataSubPath: "mysql",
+	ConfigPath:  "/etc/mysql/conf.d/paas.cnf",
+	Config: `[mysqld]
+character-set-server=utf8mb4
+collation-server=utf8mb4_unicode_ci
+max_connections=500
+innodb_buffer_pool_size={{percent .MemoryMB 50}}M
+`,
+	Readiness: Probe{
+		Command: []string{"sh", "-c", `mysqladmin ping -h 127.0.0.1 -uroot -p"$MYSQL_ROOT_PASSWORD"`},
+	},
+	Liveness: Probe{TCPPort: 3306},
+	Backup: &Backup{
+		Ext:     "sql",
+		Dump:    `mysqldump -h "$BACKUP_HOST" -uroot -p"$MYSQL_ROOT_PASSWORD" --all-databases --single-transaction --routines --events --triggers --result-file="$BACKUP_FILE"`,
+		Restore: `mysql -h "$BACKUP_HOST" -uroot -p"$MYSQL_ROOT_PASSWORD" < "$BACKUP_FILE"`,
+	},
+	Upgrades: []UpgradePath{
+		// 5.7 只能升级到 8.0，之后由服务端自动升级数据字典
+		{From: ">=5.7.0, <5.8.0", To: ">=5.7.0, <8.1.0"},
+		{From: ">=8.0.0", To: ">=8.0.0"},
+	},
+}
+
+// postgres 官方镜像 postgres
+var postgres = &Template{
+	Type:  "postgres",
+	Ports: []Port{{Port: 5432, Protocol: "TCP"}},
+	DataEnv: []Env{
+		// 数据放在子目录，避免挂载点的 lost+found
+		{Key: "PGDATA", Value: "/var/lib/postgresql/data/pgdata"},
+	},
+	Credentials: []CredentialEnv{
+		{Env: "POSTGRES_USER", Key: SecretRootUser},
+		{Env: "POSTGRES_PASSWORD", Key: SecretRootPwd},
+		{Env: "POSTGRES_DB", Key: SecretDataBase},
+	},
+	RootUser:   "postgres",
+	DataPath:   "/var/lib/postgresql/data",
+	ConfigPath: "/etc/postgresql/postgresql.conf",
+	Config: `listen_addresses = '*'
+max_connections = 200
+shared_buffers = {{percent .MemoryMB 25}}MB
+effective_cache_size = {{percent .MemoryMB 75}}MB
+`,
+	Args: []string{"-c", "config_file=/etc/postgresql/postgresql.conf"},
+	Readiness: Probe{
+		Command: []string{"sh", "-c", `pg_isready -h 127.0.0.1 -U "$POSTGRES_USER"`},
+	},
+	Liveness: Probe{TCPPort: 5432},
+	Backup: &Backup{
+		Ext: "sql",
+		// pg_dumpall 同时备份所有数据库和角色
+		Dump:    `PGPASSWORD="$POSTGRES_PASSWORD" pg_dumpall -h "$BACKUP_HOST" -U "$POSTGRES_USER" -f "$BACKUP_FILE"`,
+		Restore: `PGPASSWORD="$POSTGRES_PASSWORD" psql -h "$BACKUP_HOST" -U "$POSTGRES_USER" -d postgres -f "$BACKUP_FILE"`,
+	},
+	// 跨大版本需要 pg_upgrade 迁移数据文件，只能通过备份恢复到新实例
+	Upgrades: []UpgradePath{{From: ">=9.6.0", To: ">=9.6.0", SameMajor: true}},
+}
+
+// redis 官方镜像 redis
+var redis = &Template{
+	Type:  "redis",
+	Ports: []Port{{Port: 6379, Protocol: "TCP"}},
+	Credentials: []CredentialEnv{
+		{Env: "REDIS_PASSWORD", Key: SecretRootPwd},
+	},
+	DataPath:   "/data",
+	ConfigPath: "/usr/local/etc/redis/redis.conf",
+	Config: `dir /data
+appendonly yes
+maxmemory {{percent .MemoryMB 75}}mb
+maxmemory-policy noeviction
+`,
+	Args: []string{"redis-server", "/usr/local/etc/redis/redis.conf", "--requirepass", "$(REDIS_PASSWORD)"},
+	Readiness: Probe{
+		Command: []string{"sh", "-c", `REDISCLI_AUTH="$REDIS_PASSWORD" redis-cli ping | grep PONG`},
+	},
+	Liveness: Probe{TCPPort: 6379},
+	Backup: &Backup{
+		Ext: "rdb",
+		// --rdb 让服务端执行 BGSAVE 并把生成的RDB文件传输过来
+		Dump: `REDISCLI_AUTH="$REDIS_PASSWORD" redis-cli -h "$BACKUP_HOST" --rdb "$BACKUP_FILE"`,
+		// 没有AOF文件时启动会加载RDB并重新生成AOF
+		Restore: `rm -rf "$DATA_DIR/appendonlydir" "$DATA_DIR/appendonly.aof" && cp "$BACKUP_FILE" "$DATA_DIR/dump.rdb"`,
+		Offline: true,
+	},
+	// RDB和AOF文件向后兼容
+	Upgrades: []UpgradePath{{From: ">=5.0.0", To: ">=5.0.0"}},
+}
+
+// mongodb 官方镜像 mongo
+var mongodb = &Template{
+	Type:  "mongodb",
+	Ports: []Port{{Port: 27017, Protocol: "TCP"}},
+	Credentials: []CredentialEnv{
+		{Env: "MONGO_INITDB_ROOT_USERNAME", Key: SecretRootUser},
+		{Env: "MONGO_INITDB_ROOT_PASSWORD", Key: SecretRootPwd},
+		{Env: "MONGO_INITDB_DATABASE", Key: SecretDataBase},
+	},
+	RootUser:   "root",
+	DataPath:   "/data/db",
+	ConfigPath: "/etc/mongo/mongod.conf",
+	Config: `net:
+  port: 27017
+  bindIpAll: true
+storage:
+  dbPath: /data/db
+  wiredTiger:
+    engineConfig:
+      cacheSizeGB: {{gb .MemoryMB 50}}
+`,
+	Args: []string{"--config", "/etc/mongo/mongod.conf"},
+	Readiness: Probe{
+		// 新版本只有 mongosh，旧版本只有 mongo
+		Command: []string{"sh", "-c", `mongosh --quiet --eval "db.adminCommand('ping')" || mongo --quiet --eval "db.adminCommand('ping')"`},
+	},
+	Liveness: Probe{TCPPort: 27017},
+	Backup: &Backup{
+		Ext:     "archive.gz",
+		Dump:    `mongodump --host "$BACKUP_HOST" -u "$MONGO_INITDB_ROOT_USERNAME" -p "$MONGO_INITDB_ROOT_PASSWORD" --authenticationDatabase admin --gzip --archive="$BACKUP_FILE"`,
+		Restore: `mongorestore --host "$BACKUP_HOST" -u "$MONGO_INITDB_ROOT_USERNAME" -p "$MONGO_INITDB_ROOT_PASSWORD" --authenticationDatabase admin --drop --gzip --archive="$BACKUP_FILE"`,
+	},
+	// 大版本只能逐个升级，featureCompatibilityVersion 需要在升级后手动调整
+	Upgrades: []UpgradePath{
+		{From: "*", To: "*", SameMajor: true},
+		{From: ">=4.4.0, <5.0.0", To: ">=5.0.0, <5.1.0"},
+		{From: ">=5.0.0, <6.0.0", To: ">=6.0.0, <6.1.0"},
+		{From: ">=6.0.0, <7.0.0", To: ">=7.0.0, <7.1.0"},
+		{From: ">=7.0.0, <8.0.0", To: ">=8.0.0, <8.1.0"},
+	},
+}
+
+// kafka bitnami/kafka 镜像，KRaft 模式，不依赖zookeeper
+// 每个pod既是controller也是broker，节点ID取pod序号，通过headless服务互相访问
+var kafka = &Template{
+	Type: "kafka",
+	Ports: []Port{
+		{Port: 9092, Protocol: "TCP"},
+		{Port: 9093, Protocol: "TCP"},
+	},
+	Env: []Env{
+		{Key: "KAFKA_CFG_PROCESS_ROLES", Value: "controller,broker"},
+		{Key: "KAFKA_CFG_LISTENERS", Value: "PLAINTEXT://:9092,CONTROLLER://:9093"},
+		{Key: "KAFKA_CFG_LISTENER_SECURITY_PROTOCOL_MAP", Value: "CONTROLLER:PLAINTEXT,PLAINTEXT:PLAINTEXT"},
+		{Key: "KAFKA_CFG_CONTROLLER_LISTENER_NAMES", Value: "CONTROLLER"},
+		{Key: "KAFKA_HEAP_OPTS", Value: "-Xmx{{percent .MemoryMB 50}}m -Xms{{percent .MemoryMB 50}}m"},
+	},
+	RuntimeEnv: []Env{
+		// 全部副本都是投票成员，副本数变化时重新生成
+		{Key: "KAFKA_CFG_CONTROLLER_QUORUM_VOTERS", Value: `{{range $i := seq .Replicas}}{{if $i}},{{end}}{{$i}}@{{$.Name}}-{{$i}}.{{$.Name}}.{{$.Namespace}}.svc:9093{{end}}`},
+		// 同一集群的成员需要使用相同的集群ID格式化存储
+		{Key: "KAFKA_KRAFT_CLUSTER_ID", Value: "{{clusterID .Namespace .Name}}"},
+	},
+	// 节点ID和对外地址与pod序号相关，启动时根据主机名(<name>-<序号>)设置，覆盖旧版本保存的同名变量
+	Command:   []string{"sh", "-c", `export KAFKA_CFG_NODE_ID="${HOSTNAME##*-}" KAFKA_CFG_ADVERTISED_LISTENERS="PLAINTEXT://${HOSTNAME}.{{.Name}}.{{.Namespace}}.svc:9092" && exec /opt/bitnami/scripts/kafka/entrypoint.sh /opt/bitnami/scripts/kafka/run.sh`},
+	DataPath:  "/bitnami/kafka",
+	Readiness: Probe{TCPPort: 9092},
+	Liveness:  Probe{TCPPort: 9092},
+	// KRaft 模式从 3.3 开始可用于生产
+	Upgrades: []UpgradePath{{From: ">=3.3.0", To: ">=3.3.0"}},
+}
+
+// rabbitmq 官方镜像 rabbitmq
+var rabbitmq = &Template{
+	Type: "rabbitmq",
+	Ports: []Port{
+		{Port: 5672, Protocol: "TCP"},
+		{Port: 15672, Protocol: "TCP"},
+	},
+	Credentials: []CredentialEnv{
+		{Env: "RABBITMQ_DEFAULT_USER", Key: SecretRootUser},
+		{Env: "RABBITMQ_DEFAULT_PASS", Key: SecretRootPwd},
+	},
+	RootUser: "admin",
+	DataPath: "/var/lib/rabbitmq",
+	Readiness: Probe{
+		Command: []string{"rabbitmq-diagnostics", "-q", "ping"},
+	},
+	Liveness: Probe{TCPPort: 5672},
+	// 升级到 4.x 前需要开启全部 feature flags，只支持大版本内升级
+	Upgrades: []UpgradePath{{From: "*", To: "*", SameMajor: true}},
+}
@@ -0,0 +1,64 @@
+package template
+
+import (
+	"errors"
+	"github.com/Masterminds/semver"
+	"regexp"
+)
+
+// UpgradePath 允许的升级路径，From 和 To 为 semver 约束，如 ">=5.7.0, <5.8.0"
+type UpgradePath struct {
+	// From 当前版本的范围
+	From string
+
+	// To 可以升级到的版本范围
+	To string
+
+	// SameMajor 只能在同一个大版本内升级，跨大版本需要迁移数据文件
+	SameMajor bool
+}
+
+// versionPattern 从镜像版本中取出数字部分，如 8.0.33-debian 取 8.0.33
+var versionPattern = regexp.MustCompile(`^v?(\d+(\.\d+){0,2})`)
+
+// parseVersion 解析中间件版本，忽略镜像tag中的发行版等后缀
+func parseVersion(version string) (*semver.Version, error) {
+	match := versionPattern.FindStringSubmatch(version)
+	if match == nil {
+		return nil, errors.New("无法识别的版本：" + version)
+	}
+	return semver.NewVersion(match[1])
+}
+
+// CheckUpgrade 检查是否可以从from升级到to，不允许降级，模板没有声明升级路径时不支持升级
+func (t *Template) CheckUpgrade(from, to string) error {
+	fromVersion, err := parseVersion(from)
+	if err != nil {
+		return err
+	}
+	toVersion, err := parseVersion(to)
+	if err != nil {
+		return err
+	}
+	if !toVersion.GreaterThan(fromVersion) {
+		return errors.New("目标版本 " + to + " 不高于当前版本 " + from + "，不支持降级")
+	}
+
+	for _, path := range t.Upgrades {
+		if path.SameMajor && fromVersion.Major() != toVersion.Major() {
+			continue
+		}
+		fromConstraint, err := semver.NewConstraint(path.From)
+		if err != nil {
+			return err
+		}
+		toConstraint, err := semver.NewConstraint(path.To)
+		if err != nil {
+			return err
+		}
+		if fromConstraint.Check(fromVersion) && toConstraint.Check(toVersion) {
+			return nil
+		}
+	}
+	return errors.New(t.Type + " 不支持从 " + from + " 升级到 " + to)
+}
@@ -2,16 +2,23 @@ package handler
 
 import (
 	"context"
+	"errors"
+	microErrors "github.com/asim/go-micro/v3/errors"
+	"github.com/jinzhu/gorm"
 	"strconv"
 	"tini-paas/internal/pod/model"
 	"tini-paas/internal/pod/proto/pod"
 	"tini-paas/internal/pod/service"
+	"tini-paas/internal/svc/proto/svc"
 	"tini-paas/pkg/common"
 )
 
 // PodHandler pod处理
 type PodHandler struct {
 	PodService service.PodService
+
+	// SvcService svc微服务客户端，pod更新后同步关联服务的端口
+	SvcService svc.SvcService
 }
 
 // AddPod 添加pod
@@ -27,6 +34,17 @@ func (p *PodHandler) AddPod(ctx context.Context, info *pod.PodInfo, rsp *pod.Res
 		return err
 	}
 
+	// 同一命名空间内名称唯一，避免覆盖k8s中已经存在的资源
+	// 查询出错时不能当作不存在，否则数据库异常时会绕过检查
+	_, err = p.PodService.FindPodByNamespaceAndName(info.PodNamespace, info.PodName)
+	if err == nil {
+		err = errors.New("Pod " + info.PodNamespace + "/" + info.PodName + " 已经存在")
+	}
+	if !gorm.IsRecordNotFoundError(err) {
+		common.Error(err)
+		return err
+	}
+
 	// 没有错误，执行创建逻辑
 	err = p.PodService.CreateToK8s(info)
 	if err != nil {
@@ -105,7 +123,27 @@ func (p *PodHandler) UpdatePod(ctx context.Context, info *pod.PodInfo, rsp *pod.
 	}
 
 	// 将新组装好的podModel更新到数据库
-	return p.PodService.UpdatePod(podModel)
+	err = p.PodService.UpdatePod(podModel)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	// 为0的副本数不会通过json和 Update 写入，单独保存，与k8s中的副本数一致
+	err = p.PodService.UpdatePodReplicas(info.Id, info.PodReplicas)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+
+	// 同步由pod端口生成的服务，失败时pod已经更新，只记录错误
+	if p.SvcService != nil {
+		_, err = p.SvcService.SyncPodSvc(ctx, &svc.SvcPodID{PodId: info.Id})
+		if err != nil {
+			common.Error(err)
+			rsp.Msg = "Pod 更新成功，同步服务端口失败：" + err.Error()
+		}
+	}
+	return nil
 }
 
 // FindAllPod 查找全部pod
@@ -132,3 +170,91 @@ func (p *PodHandler) FindAllPod(ctx context.Context, findAll *pod.FindAll, allPo
 	}
 	return nil
 }
+
+// ImagePushed 镜像仓库推送了新镜像，按tag策略自动发布
+func (p *PodHandler) ImagePushed(ctx context.Context, push *pod.ImagePush, rsp *pod.Response) error {
+	deployed, err := p.PodService.ImagePushed(push)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	rsp.Msg = "镜像 " + push.Repository + ":" + push.Tag + " 自动发布 " + strconv.Itoa(deployed) + " 个pod"
+	return nil
+}
+
+// FindDeployAuditByPodID 查找pod的自动发布记录
+func (p *PodHandler) FindDeployAuditByPodID(ctx context.Context, podID *pod.PodID, allAudit *pod.AllDeployAudit) error {
+	audits, err := p.PodService.FindDeployAuditByPodID(podID.Id)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+
+	for _, v := range audits {
+		audit := &pod.DeployAudit{}
+		err = common.SwapTo(v, audit)
+		if err != nil {
+			common.Error(err)
+			return err
+		}
+		allAudit.DeployAudit = append(allAudit.DeployAudit, audit)
+	}
+	return nil
+}
+
+// FindPodByNamespaceAndName 根据命名空间和名称查找
+func (p *PodHandler) FindPodByNamespaceAndName(ctx context.Context, req *pod.PodNamespaceName, info *pod.PodInfo) error {
+	podModel, err := p.PodService.FindPodByNamespaceAndName(req.Namespace, req.Name)
+	if err != nil {
+		common.Error(err)
+		if gorm.IsRecordNotFoundError(err) {
+			return microErrors.NotFound("go.micro.service.pod", "pod %s/%s 不存在", req.Namespace, req.Name)
+		}
+		return err
+	}
+
+	// 数据转换
+	err = common.SwapTo(podModel, info)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	return nil
+}
+
+// ExportPod 导出pod应用到k8s的deployment
+func (p *PodHandler) ExportPod(ctx context.Context, podID *pod.PodID, rsp *pod.ExportResult) error {
+	info := &pod.PodInfo{}
+	err := p.FindPodByID(ctx, podID, info)
+	if err != nil {
+		return err
+	}
+	rsp.Yaml, err = p.PodService.ExportYAML(info)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	return nil
+}
+
+// ScalePod 调整pod的副本数，为0时停止pod
+func (p *PodHandler) ScalePod(ctx context.Context, req *pod.PodScale, rsp *pod.Response) error {
+	if req.PodReplicas < 0 {
+		err := errors.New("副本数不能小于0")
+		common.Error(err)
+		return err
+	}
+	podModel, err := p.PodService.FindPodByID(req.PodId)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	err = p.PodService.ScalePod(podModel, req.PodReplicas)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	rsp.Msg = "Pod " + podModel.PodName + " 的副本数调整为 " + strconv.Itoa(int(req.PodReplicas))
+	common.Info(rsp.Msg)
+	return nil
+}
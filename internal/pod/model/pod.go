@@ -22,14 +22,14 @@ type Pod struct {
 	// ID podApi id
 	ID int64 `gorm:"primary_key;not_null;auto_increment" json:"id"`
 
-	// PodName pod名称
-	PodName string `gorm:"unique_index;not_null" json:"pod_name"`
+	// PodName pod名称，同一命名空间内唯一
+	PodName string `gorm:"unique_index:idx_pod_namespace_name;not_null" json:"pod_name"`
 
 	// PodNamespace pod命名空间
-	PodNamespace string `json:"pod_namespace"`
+	PodNamespace string `gorm:"unique_index:idx_pod_namespace_name;not_null" json:"pod_namespace"`
 
-	// PodTeamID pod所属团队
-	PodTeamID int64 `json:"pod_team_id"`
+	// PodTeamID pod所属团队，proto中为字符串，JSON按字符串转换
+	PodTeamID int64 `json:"pod_team_id,string"`
 
 	// PodCpuMin pod使用cpu的最小值
 	PodCpuMin float32 `json:"pod_cpu_min"`
@@ -77,4 +77,16 @@ type Pod struct {
 
 	// PodImage 使用的镜像名称
 	PodImage string `json:"pod_image"`
+
+	// PodTagPolicy 镜像仓库推送新镜像时的自动发布策略，为空时不自动发布
+	// semver: 新tag满足语义化版本范围并且高于当前版本，如 ">=1.2.0 <2.0.0"
+	// regex: 新tag匹配正则表达式，如 "^release-.*$"
+	// digest: 跟踪指定tag(为空时使用当前tag)，摘要变化时发布
+	PodTagPolicy string `json:"pod_tag_policy"`
+
+	// PodTagPolicyValue 策略的参数：版本范围、正则表达式或者跟踪的tag
+	PodTagPolicyValue string `json:"pod_tag_policy_value"`
+
+	// PodImageDigest 当前发布镜像的摘要
+	PodImageDigest string `json:"pod_image_digest"`
 }
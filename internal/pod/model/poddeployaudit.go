@@ -0,0 +1,43 @@
+package model
+
+// PodDeployAudit 镜像推送触发的自动发布记录
+type PodDeployAudit struct {
+	// ID 主键
+	ID int64 `gorm:"primary_key;not_null;auto_increment" json:"id"`
+
+	// PodID podApi id
+	PodID int64 `gorm:"index" json:"pod_id"`
+
+	// PodName pod名称
+	PodName string `json:"pod_name"`
+
+	// PodNamespace pod命名空间
+	PodNamespace string `json:"pod_namespace"`
+
+	// OldImage 发布前的镜像
+	OldImage string `json:"old_image"`
+
+	// NewImage 发布后的镜像
+	NewImage string `json:"new_image"`
+
+	// Digest 推送镜像的摘要
+	Digest string `json:"digest"`
+
+	// Policy 命中的tag策略
+	Policy string `json:"policy"`
+
+	// Source 推送来源：registry, harbor
+	Source string `json:"source"`
+
+	// Operator 推送镜像的用户
+	Operator string `json:"operator"`
+
+	// Status 发布结果：Succeeded, Failed
+	Status string `json:"status"`
+
+	// Msg 发布结果说明
+	Msg string `json:"msg"`
+
+	// CreateTime 发布时间
+	CreateTime int64 `json:"create_time"`
+}
@@ -26,19 +26,22 @@ type PodInfo struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id            int64      `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	PodNamespace  string     `protobuf:"bytes,2,opt,name=pod_namespace,json=podNamespace,proto3" json:"pod_namespace,omitempty"`
-	PodName       string     `protobuf:"bytes,3,opt,name=pod_name,json=podName,proto3" json:"pod_name,omitempty"`
-	PodTeamId     string     `protobuf:"bytes,4,opt,name=pod_team_id,json=podTeamId,proto3" json:"pod_team_id,omitempty"`
-	PodCpuMax     float32    `protobuf:"fixed32,5,opt,name=pod_cpu_max,json=podCpuMax,proto3" json:"pod_cpu_max,omitempty"`
-	PodReplicas   int32      `protobuf:"varint,6,opt,name=pod_replicas,json=podReplicas,proto3" json:"pod_replicas,omitempty"`
-	PodMemoryMax  float32    `protobuf:"fixed32,7,opt,name=pod_memory_max,json=podMemoryMax,proto3" json:"pod_memory_max,omitempty"`
-	PodPort       []*PodPort `protobuf:"bytes,8,rep,name=pod_port,json=podPort,proto3" json:"pod_port,omitempty"`
-	PodEnv        []*PodEnv  `protobuf:"bytes,9,rep,name=pod_env,json=podEnv,proto3" json:"pod_env,omitempty"`
-	PodPullPolicy string     `protobuf:"bytes,10,opt,name=pod_pull_policy,json=podPullPolicy,proto3" json:"pod_pull_policy,omitempty"`
-	PodRestart    string     `protobuf:"bytes,11,opt,name=pod_restart,json=podRestart,proto3" json:"pod_restart,omitempty"`
-	PodType       string     `protobuf:"bytes,12,opt,name=pod_type,json=podType,proto3" json:"pod_type,omitempty"`
-	PodImage      string     `protobuf:"bytes,13,opt,name=pod_image,json=podImage,proto3" json:"pod_image,omitempty"`
+	Id                int64      `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	PodNamespace      string     `protobuf:"bytes,2,opt,name=pod_namespace,json=podNamespace,proto3" json:"pod_namespace,omitempty"`
+	PodName           string     `protobuf:"bytes,3,opt,name=pod_name,json=podName,proto3" json:"pod_name,omitempty"`
+	PodTeamId         string     `protobuf:"bytes,4,opt,name=pod_team_id,json=podTeamId,proto3" json:"pod_team_id,omitempty"`
+	PodCpuMax         float32    `protobuf:"fixed32,5,opt,name=pod_cpu_max,json=podCpuMax,proto3" json:"pod_cpu_max,omitempty"`
+	PodReplicas       int32      `protobuf:"varint,6,opt,name=pod_replicas,json=podReplicas,proto3" json:"pod_replicas,omitempty"`
+	PodMemoryMax      float32    `protobuf:"fixed32,7,opt,name=pod_memory_max,json=podMemoryMax,proto3" json:"pod_memory_max,omitempty"`
+	PodPort           []*PodPort `protobuf:"bytes,8,rep,name=pod_port,json=podPort,proto3" json:"pod_port,omitempty"`
+	PodEnv            []*PodEnv  `protobuf:"bytes,9,rep,name=pod_env,json=podEnv,proto3" json:"pod_env,omitempty"`
+	PodPullPolicy     string     `protobuf:"bytes,10,opt,name=pod_pull_policy,json=podPullPolicy,proto3" json:"pod_pull_policy,omitempty"`
+	PodRestart        string     `protobuf:"bytes,11,opt,name=pod_restart,json=podRestart,proto3" json:"pod_restart,omitempty"`
+	PodType           string     `protobuf:"bytes,12,opt,name=pod_type,json=podType,proto3" json:"pod_type,omitempty"`
+	PodImage          string     `protobuf:"bytes,13,opt,name=pod_image,json=podImage,proto3" json:"pod_image,omitempty"`
+	PodTagPolicy      string     `protobuf:"bytes,14,opt,name=pod_tag_policy,json=podTagPolicy,proto3" json:"pod_tag_policy,omitempty"`
+	PodTagPolicyValue string     `protobuf:"bytes,15,opt,name=pod_tag_policy_value,json=podTagPolicyValue,proto3" json:"pod_tag_policy_value,omitempty"`
+	PodImageDigest    string     `protobuf:"bytes,16,opt,name=pod_image_digest,json=podImageDigest,proto3" json:"pod_image_digest,omitempty"`
 }
 
 func (x *PodInfo) Reset() {
@@ -164,6 +167,27 @@ func (x *PodInfo) GetPodImage() string {
 	return ""
 }
 
+func (x *PodInfo) GetPodTagPolicy() string {
+	if x != nil {
+		return x.PodTagPolicy
+	}
+	return ""
+}
+
+func (x *PodInfo) GetPodTagPolicyValue() string {
+	if x != nil {
+		return x.PodTagPolicyValue
+	}
+	return ""
+}
+
+func (x *PodInfo) GetPodImageDigest() string {
+	if x != nil {
+		return x.PodImageDigest
+	}
+	return ""
+}
+
 // pod端口信息
 type PodPort struct {
 	state         protoimpl.MessageState
@@ -388,6 +412,118 @@ func (x *PodID) GetId() int64 {
 	return 0
 }
 
+// 根据命名空间和名称查找
+type PodNamespaceName struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name      string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *PodNamespaceName) Reset() {
+	*x = PodNamespaceName{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pod_pod_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PodNamespaceName) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PodNamespaceName) ProtoMessage() {}
+
+func (x *PodNamespaceName) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pod_pod_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PodNamespaceName.ProtoReflect.Descriptor instead.
+func (*PodNamespaceName) Descriptor() ([]byte, []int) {
+	return file_proto_pod_pod_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PodNamespaceName) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *PodNamespaceName) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// 调整副本数
+type PodScale struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PodId       int64 `protobuf:"varint,1,opt,name=pod_id,json=podId,proto3" json:"pod_id,omitempty"`
+	PodReplicas int32 `protobuf:"varint,2,opt,name=pod_replicas,json=podReplicas,proto3" json:"pod_replicas,omitempty"`
+}
+
+func (x *PodScale) Reset() {
+	*x = PodScale{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pod_pod_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PodScale) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PodScale) ProtoMessage() {}
+
+func (x *PodScale) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pod_pod_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PodScale.ProtoReflect.Descriptor instead.
+func (*PodScale) Descriptor() ([]byte, []int) {
+	return file_proto_pod_pod_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PodScale) GetPodId() int64 {
+	if x != nil {
+		return x.PodId
+	}
+	return 0
+}
+
+func (x *PodScale) GetPodReplicas() int32 {
+	if x != nil {
+		return x.PodReplicas
+	}
+	return 0
+}
+
 type FindAll struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -397,7 +533,7 @@ type FindAll struct {
 func (x *FindAll) Reset() {
 	*x = FindAll{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_pod_pod_proto_msgTypes[5]
+		mi := &file_proto_pod_pod_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -410,7 +546,7 @@ func (x *FindAll) String() string {
 func (*FindAll) ProtoMessage() {}
 
 func (x *FindAll) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_pod_pod_proto_msgTypes[5]
+	mi := &file_proto_pod_pod_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -423,7 +559,7 @@ func (x *FindAll) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FindAll.ProtoReflect.Descriptor instead.
 func (*FindAll) Descriptor() ([]byte, []int) {
-	return file_proto_pod_pod_proto_rawDescGZIP(), []int{5}
+	return file_proto_pod_pod_proto_rawDescGZIP(), []int{7}
 }
 
 type AllPod struct {
@@ -437,7 +573,7 @@ type AllPod struct {
 func (x *AllPod) Reset() {
 	*x = AllPod{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_pod_pod_proto_msgTypes[6]
+		mi := &file_proto_pod_pod_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -450,7 +586,7 @@ func (x *AllPod) String() string {
 func (*AllPod) ProtoMessage() {}
 
 func (x *AllPod) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_pod_pod_proto_msgTypes[6]
+	mi := &file_proto_pod_pod_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -463,7 +599,7 @@ func (x *AllPod) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AllPod.ProtoReflect.Descriptor instead.
 func (*AllPod) Descriptor() ([]byte, []int) {
-	return file_proto_pod_pod_proto_rawDescGZIP(), []int{6}
+	return file_proto_pod_pod_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *AllPod) GetPodInfo() []*PodInfo {
@@ -473,11 +609,338 @@ func (x *AllPod) GetPodInfo() []*PodInfo {
 	return nil
 }
 
+// 镜像推送事件
+type ImagePush struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Host       string `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	Repository string `protobuf:"bytes,2,opt,name=repository,proto3" json:"repository,omitempty"`
+	Tag        string `protobuf:"bytes,3,opt,name=tag,proto3" json:"tag,omitempty"`
+	Digest     string `protobuf:"bytes,4,opt,name=digest,proto3" json:"digest,omitempty"`
+	Source     string `protobuf:"bytes,5,opt,name=source,proto3" json:"source,omitempty"`
+	Operator   string `protobuf:"bytes,6,opt,name=operator,proto3" json:"operator,omitempty"`
+}
+
+func (x *ImagePush) Reset() {
+	*x = ImagePush{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pod_pod_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImagePush) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImagePush) ProtoMessage() {}
+
+func (x *ImagePush) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pod_pod_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImagePush.ProtoReflect.Descriptor instead.
+func (*ImagePush) Descriptor() ([]byte, []int) {
+	return file_proto_pod_pod_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ImagePush) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *ImagePush) GetRepository() string {
+	if x != nil {
+		return x.Repository
+	}
+	return ""
+}
+
+func (x *ImagePush) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *ImagePush) GetDigest() string {
+	if x != nil {
+		return x.Digest
+	}
+	return ""
+}
+
+func (x *ImagePush) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *ImagePush) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+// 自动发布记录
+type DeployAudit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	PodId        int64  `protobuf:"varint,2,opt,name=pod_id,json=podId,proto3" json:"pod_id,omitempty"`
+	PodName      string `protobuf:"bytes,3,opt,name=pod_name,json=podName,proto3" json:"pod_name,omitempty"`
+	PodNamespace string `protobuf:"bytes,4,opt,name=pod_namespace,json=podNamespace,proto3" json:"pod_namespace,omitempty"`
+	OldImage     string `protobuf:"bytes,5,opt,name=old_image,json=oldImage,proto3" json:"old_image,omitempty"`
+	NewImage     string `protobuf:"bytes,6,opt,name=new_image,json=newImage,proto3" json:"new_image,omitempty"`
+	Digest       string `protobuf:"bytes,7,opt,name=digest,proto3" json:"digest,omitempty"`
+	Policy       string `protobuf:"bytes,8,opt,name=policy,proto3" json:"policy,omitempty"`
+	Source       string `protobuf:"bytes,9,opt,name=source,proto3" json:"source,omitempty"`
+	Operator     string `protobuf:"bytes,10,opt,name=operator,proto3" json:"operator,omitempty"`
+	Status       string `protobuf:"bytes,11,opt,name=status,proto3" json:"status,omitempty"`
+	Msg          string `protobuf:"bytes,12,opt,name=msg,proto3" json:"msg,omitempty"`
+	CreateTime   int64  `protobuf:"varint,13,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+}
+
+func (x *DeployAudit) Reset() {
+	*x = DeployAudit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pod_pod_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeployAudit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeployAudit) ProtoMessage() {}
+
+func (x *DeployAudit) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pod_pod_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeployAudit.ProtoReflect.Descriptor instead.
+func (*DeployAudit) Descriptor() ([]byte, []int) {
+	return file_proto_pod_pod_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DeployAudit) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DeployAudit) GetPodId() int64 {
+	if x != nil {
+		return x.PodId
+	}
+	return 0
+}
+
+func (x *DeployAudit) GetPodName() string {
+	if x != nil {
+		return x.PodName
+	}
+	return ""
+}
+
+func (x *DeployAudit) GetPodNamespace() string {
+	if x != nil {
+		return x.PodNamespace
+	}
+	return ""
+}
+
+func (x *DeployAudit) GetOldImage() string {
+	if x != nil {
+		return x.OldImage
+	}
+	return ""
+}
+
+func (x *DeployAudit) GetNewImage() string {
+	if x != nil {
+		return x.NewImage
+	}
+	return ""
+}
+
+func (x *DeployAudit) GetDigest() string {
+	if x != nil {
+		return x.Digest
+	}
+	return ""
+}
+
+func (x *DeployAudit) GetPolicy() string {
+	if x != nil {
+		return x.Policy
+	}
+	return ""
+}
+
+func (x *DeployAudit) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *DeployAudit) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *DeployAudit) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DeployAudit) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *DeployAudit) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+type AllDeployAudit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DeployAudit []*DeployAudit `protobuf:"bytes,1,rep,name=deploy_audit,json=deployAudit,proto3" json:"deploy_audit,omitempty"`
+}
+
+func (x *AllDeployAudit) Reset() {
+	*x = AllDeployAudit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pod_pod_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AllDeployAudit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllDeployAudit) ProtoMessage() {}
+
+func (x *AllDeployAudit) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pod_pod_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllDeployAudit.ProtoReflect.Descriptor instead.
+func (*AllDeployAudit) Descriptor() ([]byte, []int) {
+	return file_proto_pod_pod_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *AllDeployAudit) GetDeployAudit() []*DeployAudit {
+	if x != nil {
+		return x.DeployAudit
+	}
+	return nil
+}
+
+// 导出的k8s对象
+type ExportResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Yaml string `protobuf:"bytes,1,opt,name=yaml,proto3" json:"yaml,omitempty"`
+}
+
+func (x *ExportResult) Reset() {
+	*x = ExportResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pod_pod_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportResult) ProtoMessage() {}
+
+func (x *ExportResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pod_pod_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportResult.ProtoReflect.Descriptor instead.
+func (*ExportResult) Descriptor() ([]byte, []int) {
+	return file_proto_pod_pod_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ExportResult) GetYaml() string {
+	if x != nil {
+		return x.Yaml
+	}
+	return ""
+}
+
 var File_proto_pod_pod_proto protoreflect.FileDescriptor
 
 var file_proto_pod_pod_proto_rawDesc = []byte{
 	0x0a, 0x13, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x70, 0x6f, 0x64, 0x2f, 0x70, 0x6f, 0x64, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x03, 0x70, 0x6f, 0x64, 0x22, 0xb2, 0x03, 0x0a, 0x07, 0x50,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x03, 0x70, 0x6f, 0x64, 0x22, 0xb3, 0x04, 0x0a, 0x07, 0x50,
 	0x6f, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x6f, 0x64, 0x5f, 0x6e, 0x61,
 	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70,
@@ -504,42 +967,114 @@ var file_proto_pod_pod_proto_rawDesc = []byte{
 	0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x6f, 0x64, 0x5f, 0x74,
 	0x79, 0x70, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x6f, 0x64, 0x54, 0x79,
 	0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6f, 0x64, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18,
-	0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6f, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x22,
-	0x63, 0x0a, 0x07, 0x50, 0x6f, 0x64, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x70, 0x6f,
-	0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x6f, 0x64, 0x49,
-	0x64, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x70,
-	0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x61,
-	0x69, 0x6e, 0x65, 0x72, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x63, 0x6f, 0x6c, 0x22, 0x55, 0x0a, 0x06, 0x50, 0x6f, 0x64, 0x45, 0x6e, 0x76, 0x12, 0x15,
-	0x0a, 0x06, 0x70, 0x6f, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
-	0x70, 0x6f, 0x64, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x65, 0x6e, 0x76, 0x5f, 0x6b, 0x65, 0x79,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x6e, 0x76, 0x4b, 0x65, 0x79, 0x12, 0x1b,
-	0x0a, 0x09, 0x65, 0x6e, 0x76, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x65, 0x6e, 0x76, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x1c, 0x0a, 0x08, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x22, 0x17, 0x0a, 0x05, 0x50, 0x6f, 0x64,
-	0x49, 0x44, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02,
-	0x69, 0x64, 0x22, 0x09, 0x0a, 0x07, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x22, 0x31, 0x0a,
-	0x06, 0x41, 0x6c, 0x6c, 0x50, 0x6f, 0x64, 0x12, 0x27, 0x0a, 0x08, 0x70, 0x6f, 0x64, 0x5f, 0x69,
-	0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x6f, 0x64, 0x2e,
-	0x50, 0x6f, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x70, 0x6f, 0x64, 0x49, 0x6e, 0x66, 0x6f,
-	0x32, 0xda, 0x01, 0x0a, 0x03, 0x50, 0x6f, 0x64, 0x12, 0x27, 0x0a, 0x06, 0x41, 0x64, 0x64, 0x50,
-	0x6f, 0x64, 0x12, 0x0c, 0x2e, 0x70, 0x6f, 0x64, 0x2e, 0x50, 0x6f, 0x64, 0x49, 0x6e, 0x66, 0x6f,
-	0x1a, 0x0d, 0x2e, 0x70, 0x6f, 0x64, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x28, 0x0a, 0x09, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x64, 0x12, 0x0a,
-	0x2e, 0x70, 0x6f, 0x64, 0x2e, 0x50, 0x6f, 0x64, 0x49, 0x44, 0x1a, 0x0d, 0x2e, 0x70, 0x6f, 0x64,
-	0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x29, 0x0a, 0x0b, 0x46,
-	0x69, 0x6e, 0x64, 0x50, 0x6f, 0x64, 0x42, 0x79, 0x49, 0x44, 0x12, 0x0a, 0x2e, 0x70, 0x6f, 0x64,
-	0x2e, 0x50, 0x6f, 0x64, 0x49, 0x44, 0x1a, 0x0c, 0x2e, 0x70, 0x6f, 0x64, 0x2e, 0x50, 0x6f, 0x64,
-	0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x12, 0x2a, 0x0a, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x50, 0x6f, 0x64, 0x12, 0x0c, 0x2e, 0x70, 0x6f, 0x64, 0x2e, 0x50, 0x6f, 0x64, 0x49, 0x6e, 0x66,
-	0x6f, 0x1a, 0x0d, 0x2e, 0x70, 0x6f, 0x64, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x29, 0x0a, 0x0a, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x50, 0x6f, 0x64,
-	0x12, 0x0c, 0x2e, 0x70, 0x6f, 0x64, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x1a, 0x0b,
-	0x2e, 0x70, 0x6f, 0x64, 0x2e, 0x41, 0x6c, 0x6c, 0x50, 0x6f, 0x64, 0x22, 0x00, 0x42, 0x11, 0x5a,
-	0x0f, 0x2e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x70, 0x6f, 0x64, 0x3b, 0x70, 0x6f, 0x64,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6f, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12,
+	0x24, 0x0a, 0x0e, 0x70, 0x6f, 0x64, 0x5f, 0x74, 0x61, 0x67, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x6f, 0x64, 0x54, 0x61, 0x67, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x2f, 0x0a, 0x14, 0x70, 0x6f, 0x64, 0x5f, 0x74, 0x61, 0x67,
+	0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x0f, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x11, 0x70, 0x6f, 0x64, 0x54, 0x61, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x70, 0x6f, 0x64, 0x5f, 0x69, 0x6d,
+	0x61, 0x67, 0x65, 0x5f, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x70, 0x6f, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74,
+	0x22, 0x63, 0x0a, 0x07, 0x50, 0x6f, 0x64, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x70,
+	0x6f, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x6f, 0x64,
+	0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f,
+	0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x74,
+	0x61, 0x69, 0x6e, 0x65, 0x72, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x22, 0x55, 0x0a, 0x06, 0x50, 0x6f, 0x64, 0x45, 0x6e, 0x76, 0x12,
+	0x15, 0x0a, 0x06, 0x70, 0x6f, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x70, 0x6f, 0x64, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x65, 0x6e, 0x76, 0x5f, 0x6b, 0x65,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x6e, 0x76, 0x4b, 0x65, 0x79, 0x12,
+	0x1b, 0x0a, 0x09, 0x65, 0x6e, 0x76, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x76, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x1c, 0x0a, 0x08,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x22, 0x17, 0x0a, 0x05, 0x50, 0x6f,
+	0x64, 0x49, 0x44, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x02, 0x69, 0x64, 0x22, 0x44, 0x0a, 0x10, 0x50, 0x6f, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x44, 0x0a, 0x08, 0x50, 0x6f, 0x64,
+	0x53, 0x63, 0x61, 0x6c, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x70, 0x6f, 0x64, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x6f, 0x64, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c,
+	0x70, 0x6f, 0x64, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0b, 0x70, 0x6f, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x22,
+	0x09, 0x0a, 0x07, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x22, 0x31, 0x0a, 0x06, 0x41, 0x6c,
+	0x6c, 0x50, 0x6f, 0x64, 0x12, 0x27, 0x0a, 0x08, 0x70, 0x6f, 0x64, 0x5f, 0x69, 0x6e, 0x66, 0x6f,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x6f, 0x64, 0x2e, 0x50, 0x6f, 0x64,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x70, 0x6f, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x9d, 0x01,
+	0x0a, 0x09, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x50, 0x75, 0x73, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x68,
+	0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x12,
+	0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61,
+	0x67, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x22, 0xdd, 0x02,
+	0x0a, 0x0b, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x41, 0x75, 0x64, 0x69, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x15, 0x0a,
+	0x06, 0x70, 0x6f, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70,
+	0x6f, 0x64, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x6f, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x6f, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x23, 0x0a, 0x0d, 0x70, 0x6f, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x6f, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x6c, 0x64, 0x5f, 0x69, 0x6d, 0x61, 0x67,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x6c, 0x64, 0x49, 0x6d, 0x61, 0x67,
+	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x65, 0x77, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x65, 0x77, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73,
+	0x67, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x1f, 0x0a, 0x0b,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x45, 0x0a,
+	0x0e, 0x41, 0x6c, 0x6c, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x41, 0x75, 0x64, 0x69, 0x74, 0x12,
+	0x33, 0x0a, 0x0c, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x5f, 0x61, 0x75, 0x64, 0x69, 0x74, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x6f, 0x64, 0x2e, 0x44, 0x65, 0x70, 0x6c,
+	0x6f, 0x79, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x0b, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x41,
+	0x75, 0x64, 0x69, 0x74, 0x22, 0x22, 0x0a, 0x0c, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x79, 0x61, 0x6d, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x79, 0x61, 0x6d, 0x6c, 0x32, 0xe5, 0x03, 0x0a, 0x03, 0x50, 0x6f, 0x64,
+	0x12, 0x27, 0x0a, 0x06, 0x41, 0x64, 0x64, 0x50, 0x6f, 0x64, 0x12, 0x0c, 0x2e, 0x70, 0x6f, 0x64,
+	0x2e, 0x50, 0x6f, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x0d, 0x2e, 0x70, 0x6f, 0x64, 0x2e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x28, 0x0a, 0x09, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x50, 0x6f, 0x64, 0x12, 0x0a, 0x2e, 0x70, 0x6f, 0x64, 0x2e, 0x50, 0x6f, 0x64,
+	0x49, 0x44, 0x1a, 0x0d, 0x2e, 0x70, 0x6f, 0x64, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x29, 0x0a, 0x0b, 0x46, 0x69, 0x6e, 0x64, 0x50, 0x6f, 0x64, 0x42, 0x79,
+	0x49, 0x44, 0x12, 0x0a, 0x2e, 0x70, 0x6f, 0x64, 0x2e, 0x50, 0x6f, 0x64, 0x49, 0x44, 0x1a, 0x0c,
+	0x2e, 0x70, 0x6f, 0x64, 0x2e, 0x50, 0x6f, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x12, 0x42,
+	0x0a, 0x19, 0x46, 0x69, 0x6e, 0x64, 0x50, 0x6f, 0x64, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x41, 0x6e, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x15, 0x2e, 0x70, 0x6f,
+	0x64, 0x2e, 0x50, 0x6f, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4e, 0x61,
+	0x6d, 0x65, 0x1a, 0x0c, 0x2e, 0x70, 0x6f, 0x64, 0x2e, 0x50, 0x6f, 0x64, 0x49, 0x6e, 0x66, 0x6f,
+	0x22, 0x00, 0x12, 0x2a, 0x0a, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x64, 0x12,
+	0x0c, 0x2e, 0x70, 0x6f, 0x64, 0x2e, 0x50, 0x6f, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x0d, 0x2e,
+	0x70, 0x6f, 0x64, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x29,
+	0x0a, 0x0a, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x50, 0x6f, 0x64, 0x12, 0x0c, 0x2e, 0x70,
+	0x6f, 0x64, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x1a, 0x0b, 0x2e, 0x70, 0x6f, 0x64,
+	0x2e, 0x41, 0x6c, 0x6c, 0x50, 0x6f, 0x64, 0x22, 0x00, 0x12, 0x2e, 0x0a, 0x0b, 0x49, 0x6d, 0x61,
+	0x67, 0x65, 0x50, 0x75, 0x73, 0x68, 0x65, 0x64, 0x12, 0x0e, 0x2e, 0x70, 0x6f, 0x64, 0x2e, 0x49,
+	0x6d, 0x61, 0x67, 0x65, 0x50, 0x75, 0x73, 0x68, 0x1a, 0x0d, 0x2e, 0x70, 0x6f, 0x64, 0x2e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x16, 0x46, 0x69, 0x6e,
+	0x64, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x41, 0x75, 0x64, 0x69, 0x74, 0x42, 0x79, 0x50, 0x6f,
+	0x64, 0x49, 0x44, 0x12, 0x0a, 0x2e, 0x70, 0x6f, 0x64, 0x2e, 0x50, 0x6f, 0x64, 0x49, 0x44, 0x1a,
+	0x13, 0x2e, 0x70, 0x6f, 0x64, 0x2e, 0x41, 0x6c, 0x6c, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x41,
+	0x75, 0x64, 0x69, 0x74, 0x22, 0x00, 0x12, 0x2c, 0x0a, 0x09, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x50, 0x6f, 0x64, 0x12, 0x0a, 0x2e, 0x70, 0x6f, 0x64, 0x2e, 0x50, 0x6f, 0x64, 0x49, 0x44, 0x1a,
+	0x11, 0x2e, 0x70, 0x6f, 0x64, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x22, 0x00, 0x12, 0x2a, 0x0a, 0x08, 0x53, 0x63, 0x61, 0x6c, 0x65, 0x50, 0x6f, 0x64,
+	0x12, 0x0d, 0x2e, 0x70, 0x6f, 0x64, 0x2e, 0x50, 0x6f, 0x64, 0x53, 0x63, 0x61, 0x6c, 0x65, 0x1a,
+	0x0d, 0x2e, 0x70, 0x6f, 0x64, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x42, 0x11, 0x5a, 0x0f, 0x2e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x70, 0x6f, 0x64, 0x3b,
+	0x70, 0x6f, 0x64, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -554,35 +1089,52 @@ func file_proto_pod_pod_proto_rawDescGZIP() []byte {
 	return file_proto_pod_pod_proto_rawDescData
 }
 
-var file_proto_pod_pod_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_proto_pod_pod_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
 var file_proto_pod_pod_proto_goTypes = []interface{}{
-	(*PodInfo)(nil),  // 0: podApi.PodInfo
-	(*PodPort)(nil),  // 1: podApi.PodPort
-	(*PodEnv)(nil),   // 2: podApi.PodEnv
-	(*Response)(nil), // 3: podApi.Response
-	(*PodID)(nil),    // 4: podApi.PodID
-	(*FindAll)(nil),  // 5: podApi.FindAll
-	(*AllPod)(nil),   // 6: podApi.AllPod
+	(*PodInfo)(nil),          // 0: pod.PodInfo
+	(*PodPort)(nil),          // 1: pod.PodPort
+	(*PodEnv)(nil),           // 2: pod.PodEnv
+	(*Response)(nil),         // 3: pod.Response
+	(*PodID)(nil),            // 4: pod.PodID
+	(*PodNamespaceName)(nil), // 5: pod.PodNamespaceName
+	(*PodScale)(nil),         // 6: pod.PodScale
+	(*FindAll)(nil),          // 7: pod.FindAll
+	(*AllPod)(nil),           // 8: pod.AllPod
+	(*ImagePush)(nil),        // 9: pod.ImagePush
+	(*DeployAudit)(nil),      // 10: pod.DeployAudit
+	(*AllDeployAudit)(nil),   // 11: pod.AllDeployAudit
+	(*ExportResult)(nil),     // 12: pod.ExportResult
 }
 var file_proto_pod_pod_proto_depIdxs = []int32{
-	1, // 0: podApi.PodInfo.pod_port:type_name -> podApi.PodPort
-	2, // 1: podApi.PodInfo.pod_env:type_name -> podApi.PodEnv
-	0, // 2: podApi.AllPod.pod_info:type_name -> podApi.PodInfo
-	0, // 3: podApi.Pod.AddPod:input_type -> podApi.PodInfo
-	4, // 4: podApi.Pod.DeletePod:input_type -> podApi.PodID
-	4, // 5: podApi.Pod.FindPodByID:input_type -> podApi.PodID
-	0, // 6: podApi.Pod.UpdatePod:input_type -> podApi.PodInfo
-	5, // 7: podApi.Pod.FindAllPod:input_type -> podApi.FindAll
-	3, // 8: podApi.Pod.AddPod:output_type -> podApi.Response
-	3, // 9: podApi.Pod.DeletePod:output_type -> podApi.Response
-	0, // 10: podApi.Pod.FindPodByID:output_type -> podApi.PodInfo
-	3, // 11: podApi.Pod.UpdatePod:output_type -> podApi.Response
-	6, // 12: podApi.Pod.FindAllPod:output_type -> podApi.AllPod
-	8, // [8:13] is the sub-list for method output_type
-	3, // [3:8] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	1,  // 0: pod.PodInfo.pod_port:type_name -> pod.PodPort
+	2,  // 1: pod.PodInfo.pod_env:type_name -> pod.PodEnv
+	0,  // 2: pod.AllPod.pod_info:type_name -> pod.PodInfo
+	10, // 3: pod.AllDeployAudit.deploy_audit:type_name -> pod.DeployAudit
+	0,  // 4: pod.Pod.AddPod:input_type -> pod.PodInfo
+	4,  // 5: pod.Pod.DeletePod:input_type -> pod.PodID
+	4,  // 6: pod.Pod.FindPodByID:input_type -> pod.PodID
+	5,  // 7: pod.Pod.FindPodByNamespaceAndName:input_type -> pod.PodNamespaceName
+	0,  // 8: pod.Pod.UpdatePod:input_type -> pod.PodInfo
+	7,  // 9: pod.Pod.FindAllPod:input_type -> pod.FindAll
+	9,  // 10: pod.Pod.ImagePushed:input_type -> pod.ImagePush
+	4,  // 11: pod.Pod.FindDeployAuditByPodID:input_type -> pod.PodID
+	4,  // 12: pod.Pod.ExportPod:input_type -> pod.PodID
+	6,  // 13: pod.Pod.ScalePod:input_type -> pod.PodScale
+	3,  // 14: pod.Pod.AddPod:output_type -> pod.Response
+	3,  // 15: pod.Pod.DeletePod:output_type -> pod.Response
+	0,  // 16: pod.Pod.FindPodByID:output_type -> pod.PodInfo
+	0,  // 17: pod.Pod.FindPodByNamespaceAndName:output_type -> pod.PodInfo
+	3,  // 18: pod.Pod.UpdatePod:output_type -> pod.Response
+	8,  // 19: pod.Pod.FindAllPod:output_type -> pod.AllPod
+	3,  // 20: pod.Pod.ImagePushed:output_type -> pod.Response
+	11, // 21: pod.Pod.FindDeployAuditByPodID:output_type -> pod.AllDeployAudit
+	12, // 22: pod.Pod.ExportPod:output_type -> pod.ExportResult
+	3,  // 23: pod.Pod.ScalePod:output_type -> pod.Response
+	14, // [14:24] is the sub-list for method output_type
+	4,  // [4:14] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_proto_pod_pod_proto_init() }
@@ -652,7 +1204,7 @@ func file_proto_pod_pod_proto_init() {
 			}
 		}
 		file_proto_pod_pod_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FindAll); i {
+			switch v := v.(*PodNamespaceName); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -664,6 +1216,30 @@ func file_proto_pod_pod_proto_init() {
 			}
 		}
 		file_proto_pod_pod_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PodScale); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pod_pod_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindAll); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pod_pod_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AllPod); i {
 			case 0:
 				return &v.state
@@ -675,6 +1251,54 @@ func file_proto_pod_pod_proto_init() {
 				return nil
 			}
 		}
+		file_proto_pod_pod_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImagePush); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pod_pod_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeployAudit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pod_pod_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AllDeployAudit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pod_pod_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -682,7 +1306,7 @@ func file_proto_pod_pod_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_pod_pod_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -39,8 +39,16 @@ type PodService interface {
 	AddPod(ctx context.Context, in *PodInfo, opts ...client.CallOption) (*Response, error)
 	DeletePod(ctx context.Context, in *PodID, opts ...client.CallOption) (*Response, error)
 	FindPodByID(ctx context.Context, in *PodID, opts ...client.CallOption) (*PodInfo, error)
+	FindPodByNamespaceAndName(ctx context.Context, in *PodNamespaceName, opts ...client.CallOption) (*PodInfo, error)
 	UpdatePod(ctx context.Context, in *PodInfo, opts ...client.CallOption) (*Response, error)
 	FindAllPod(ctx context.Context, in *FindAll, opts ...client.CallOption) (*AllPod, error)
+	// 镜像仓库推送了新镜像，按pod的tag策略自动发布
+	ImagePushed(ctx context.Context, in *ImagePush, opts ...client.CallOption) (*Response, error)
+	FindDeployAuditByPodID(ctx context.Context, in *PodID, opts ...client.CallOption) (*AllDeployAudit, error)
+	// 导出应用到k8s的对象，多文档YAML
+	ExportPod(ctx context.Context, in *PodID, opts ...client.CallOption) (*ExportResult, error)
+	// 调整副本数，为0时停止pod，数据库中保存调整后的副本数
+	ScalePod(ctx context.Context, in *PodScale, opts ...client.CallOption) (*Response, error)
 }
 
 type podService struct {
@@ -85,6 +93,16 @@ func (c *podService) FindPodByID(ctx context.Context, in *PodID, opts ...client.
 	return out, nil
 }
 
+func (c *podService) FindPodByNamespaceAndName(ctx context.Context, in *PodNamespaceName, opts ...client.CallOption) (*PodInfo, error) {
+	req := c.c.NewRequest(c.name, "Pod.FindPodByNamespaceAndName", in)
+	out := new(PodInfo)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *podService) UpdatePod(ctx context.Context, in *PodInfo, opts ...client.CallOption) (*Response, error) {
 	req := c.c.NewRequest(c.name, "Pod.UpdatePod", in)
 	out := new(Response)
@@ -105,14 +123,62 @@ func (c *podService) FindAllPod(ctx context.Context, in *FindAll, opts ...client
 	return out, nil
 }
 
+func (c *podService) ImagePushed(ctx context.Context, in *ImagePush, opts ...client.CallOption) (*Response, error) {
+	req := c.c.NewRequest(c.name, "Pod.ImagePushed", in)
+	out := new(Response)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *podService) FindDeployAuditByPodID(ctx context.Context, in *PodID, opts ...client.CallOption) (*AllDeployAudit, error) {
+	req := c.c.NewRequest(c.name, "Pod.FindDeployAuditByPodID", in)
+	out := new(AllDeployAudit)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *podService) ExportPod(ctx context.Context, in *PodID, opts ...client.CallOption) (*ExportResult, error) {
+	req := c.c.NewRequest(c.name, "Pod.ExportPod", in)
+	out := new(ExportResult)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *podService) ScalePod(ctx context.Context, in *PodScale, opts ...client.CallOption) (*Response, error) {
+	req := c.c.NewRequest(c.name, "Pod.ScalePod", in)
+	out := new(Response)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for Pod service
 
 type PodHandler interface {
 	AddPod(context.Context, *PodInfo, *Response) error
 	DeletePod(context.Context, *PodID, *Response) error
 	FindPodByID(context.Context, *PodID, *PodInfo) error
+	FindPodByNamespaceAndName(context.Context, *PodNamespaceName, *PodInfo) error
 	UpdatePod(context.Context, *PodInfo, *Response) error
 	FindAllPod(context.Context, *FindAll, *AllPod) error
+	// 镜像仓库推送了新镜像，按pod的tag策略自动发布
+	ImagePushed(context.Context, *ImagePush, *Response) error
+	FindDeployAuditByPodID(context.Context, *PodID, *AllDeployAudit) error
+	// 导出应用到k8s的对象，多文档YAML
+	ExportPod(context.Context, *PodID, *ExportResult) error
+	// 调整副本数，为0时停止pod，数据库中保存调整后的副本数
+	ScalePod(context.Context, *PodScale, *Response) error
 }
 
 func RegisterPodHandler(s server.Server, hdlr PodHandler, opts ...server.HandlerOption) error {
@@ -120,8 +186,13 @@ func RegisterPodHandler(s server.Server, hdlr PodHandler, opts ...server.Handler
 		AddPod(ctx context.Context, in *PodInfo, out *Response) error
 		DeletePod(ctx context.Context, in *PodID, out *Response) error
 		FindPodByID(ctx context.Context, in *PodID, out *PodInfo) error
+		FindPodByNamespaceAndName(ctx context.Context, in *PodNamespaceName, out *PodInfo) error
 		UpdatePod(ctx context.Context, in *PodInfo, out *Response) error
 		FindAllPod(ctx context.Context, in *FindAll, out *AllPod) error
+		ImagePushed(ctx context.Context, in *ImagePush, out *Response) error
+		FindDeployAuditByPodID(ctx context.Context, in *PodID, out *AllDeployAudit) error
+		ExportPod(ctx context.Context, in *PodID, out *ExportResult) error
+		ScalePod(ctx context.Context, in *PodScale, out *Response) error
 	}
 	type Pod struct {
 		pod
@@ -146,6 +217,10 @@ func (h *podHandler) FindPodByID(ctx context.Context, in *PodID, out *PodInfo) e
 	return h.PodHandler.FindPodByID(ctx, in, out)
 }
 
+func (h *podHandler) FindPodByNamespaceAndName(ctx context.Context, in *PodNamespaceName, out *PodInfo) error {
+	return h.PodHandler.FindPodByNamespaceAndName(ctx, in, out)
+}
+
 func (h *podHandler) UpdatePod(ctx context.Context, in *PodInfo, out *Response) error {
 	return h.PodHandler.UpdatePod(ctx, in, out)
 }
@@ -153,3 +228,19 @@ func (h *podHandler) UpdatePod(ctx context.Context, in *PodInfo, out *Response)
 func (h *podHandler) FindAllPod(ctx context.Context, in *FindAll, out *AllPod) error {
 	return h.PodHandler.FindAllPod(ctx, in, out)
 }
+
+func (h *podHandler) ImagePushed(ctx context.Context, in *ImagePush, out *Response) error {
+	return h.PodHandler.ImagePushed(ctx, in, out)
+}
+
+func (h *podHandler) FindDeployAuditByPodID(ctx context.Context, in *PodID, out *AllDeployAudit) error {
+	return h.PodHandler.FindDeployAuditByPodID(ctx, in, out)
+}
+
+func (h *podHandler) ExportPod(ctx context.Context, in *PodID, out *ExportResult) error {
+	return h.PodHandler.ExportPod(ctx, in, out)
+}
+
+func (h *podHandler) ScalePod(ctx context.Context, in *PodScale, out *Response) error {
+	return h.PodHandler.ScalePod(ctx, in, out)
+}
@@ -3,6 +3,7 @@ package repository
 import (
 	"github.com/jinzhu/gorm"
 	"tini-paas/internal/pod/model"
+	"tini-paas/pkg/common"
 )
 
 // PodRepository pod操作
@@ -10,9 +11,15 @@ type PodRepository interface {
 	// InitTable 初始化表
 	InitTable() error
 
+	// MigrateTable 迁移已有数据表
+	MigrateTable() error
+
 	// FindPodByID 查找pod
 	FindPodByID(int64) (*model.Pod, error)
 
+	// FindPodByNamespaceAndName 根据命名空间和名称查找pod
+	FindPodByNamespaceAndName(string, string) (*model.Pod, error)
+
 	// CreatePod 创建pod
 	CreatePod(*model.Pod) (int64, error)
 
@@ -22,8 +29,20 @@ type PodRepository interface {
 	// UpdatePod 修改pod
 	UpdatePod(*model.Pod) error
 
+	// UpdatePodReplicas 只修改副本数，允许为0
+	UpdatePodReplicas(int64, int32) error
+
 	// FindAll 查找所有pod
 	FindAll() ([]model.Pod, error)
+
+	// FindAllWithTagPolicy 查找开启自动发布的pod
+	FindAllWithTagPolicy() ([]model.Pod, error)
+
+	// CreateDeployAudit 添加自动发布记录
+	CreateDeployAudit(*model.PodDeployAudit) (int64, error)
+
+	// FindDeployAuditByPodID 查找pod的自动发布记录
+	FindDeployAuditByPodID(int64) ([]model.PodDeployAudit, error)
 }
 
 // Pod podApi repository
@@ -40,17 +59,32 @@ func NewPodRepository(db *gorm.DB) PodRepository {
 
 // InitTable 初始化表
 func (p *Pod) InitTable() error {
-	// 创建三个表
-	return p.db.CreateTable(&model.Pod{}, &model.PodEnv{}, &model.PodPort{}).Error
+	// 创建四个表
+	return p.db.CreateTable(&model.Pod{}, &model.PodEnv{}, &model.PodPort{}, &model.PodDeployAudit{}).Error
 	//return p.db.CreateTable(&model.Pod{}, &model.PodPort{}, &model.PodEnv{}).Error
 }
 
+// MigrateTable 迁移已有数据表，名称唯一改为同一命名空间内唯一，并补充新增的字段和发布记录表
+func (p *Pod) MigrateTable() error {
+	err := common.MigrateUniqueIndex(p.db, &model.Pod{}, "uix_pods_pod_name", "idx_pod_namespace_name", "pod_namespace", "pod_name")
+	if err != nil {
+		return err
+	}
+	return common.MigrateTables(p.db, &model.Pod{}, &model.PodEnv{}, &model.PodPort{}, &model.PodDeployAudit{})
+}
+
 // FindPodByID 查找pod
 func (p *Pod) FindPodByID(i int64) (*model.Pod, error) {
 	pod := &model.Pod{}
 	return pod, p.db.Preload("PodEnv").Preload("PodPort").First(pod, i).Error
 }
 
+// FindPodByNamespaceAndName 根据命名空间和名称查找pod
+func (p *Pod) FindPodByNamespaceAndName(namespace, name string) (*model.Pod, error) {
+	pod := &model.Pod{}
+	return pod, p.db.Preload("PodEnv").Preload("PodPort").Where("pod_namespace = ? AND pod_name = ?", namespace, name).First(pod).Error
+}
+
 // CreatePod 创建pod
 func (p *Pod) CreatePod(pod *model.Pod) (int64, error) {
 	return pod.ID, p.db.Create(pod).Error
@@ -99,8 +133,31 @@ func (p *Pod) UpdatePod(pod *model.Pod) error {
 	return p.db.Model(pod).Update(pod).Error
 }
 
+// UpdatePodReplicas 只修改副本数，Update 会忽略为0的字段
+func (p *Pod) UpdatePodReplicas(podID int64, replicas int32) error {
+	return p.db.Model(&model.Pod{}).Where("id = ?", podID).UpdateColumn("pod_replicas", replicas).Error
+}
+
 // FindAll 获取结果集合
 func (p *Pod) FindAll() ([]model.Pod, error) {
 	var podAll []model.Pod
 	return podAll, p.db.Find(&podAll).Error
 }
+
+// FindAllWithTagPolicy 查找开启自动发布的pod
+func (p *Pod) FindAllWithTagPolicy() ([]model.Pod, error) {
+	var podAll []model.Pod
+	return podAll, p.db.Where("pod_tag_policy <> ?", "").Find(&podAll).Error
+}
+
+// CreateDeployAudit 添加自动发布记录
+func (p *Pod) CreateDeployAudit(audit *model.PodDeployAudit) (int64, error) {
+	err := p.db.Create(audit).Error
+	return audit.ID, err
+}
+
+// FindDeployAuditByPodID 查找pod的自动发布记录，最新的在前
+func (p *Pod) FindDeployAuditByPodID(podID int64) ([]model.PodDeployAudit, error) {
+	var audits []model.PodDeployAudit
+	return audits, p.db.Where("pod_id = ?", podID).Order("id desc").Find(&audits).Error
+}
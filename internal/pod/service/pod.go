@@ -2,13 +2,14 @@ package service
 
 import (
 	"context"
-	"errors"
 	v1 "k8s.io/api/apps/v1"
 	v13 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	v12 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes"
 	"strconv"
+	"time"
 	"tini-paas/internal/pod/model"
 	"tini-paas/internal/pod/proto/pod"
 	"tini-paas/internal/pod/repository"
@@ -21,10 +22,22 @@ type PodService interface {
 	DeletedPod(int64) error
 	UpdatePod(*model.Pod) error
 	FindPodByID(int64) (*model.Pod, error)
+	FindPodByNamespaceAndName(string, string) (*model.Pod, error)
 	FindAllPod() ([]model.Pod, error)
 	CreateToK8s(*pod.PodInfo) error
 	UpdateToK8s(*pod.PodInfo) error
 	DeletedFromK8s(*model.Pod) error
+	ImagePushed(*pod.ImagePush) (int, error)
+	FindDeployAuditByPodID(int64) ([]model.PodDeployAudit, error)
+
+	// ExportYAML 导出应用到k8s的deployment
+	ExportYAML(*pod.PodInfo) (string, error)
+
+	// ScalePod 调整副本数并写入数据库，为0时停止pod
+	ScalePod(*model.Pod, int32) error
+
+	// UpdatePodReplicas 只修改数据库中的副本数，允许为0
+	UpdatePodReplicas(int64, int32) error
 }
 
 // PodDataService pod数据服务
@@ -34,9 +47,6 @@ type PodDataService struct {
 
 	// K8sClientSet k8s客户端集合
 	K8sClientSet *kubernetes.Clientset
-
-	// deployment 发布控制器
-	deployment *v1.Deployment
 }
 
 // NewPodService 初始化pod服务
@@ -44,7 +54,6 @@ func NewPodService(podRepository repository.PodRepository, clientSet *kubernetes
 	return &PodDataService{
 		PodRepository: podRepository,
 		K8sClientSet:  clientSet,
-		deployment:    &v1.Deployment{},
 	}
 }
 
@@ -68,55 +77,74 @@ func (p *PodDataService) FindPodByID(podID int64) (*model.Pod, error) {
 	return p.PodRepository.FindPodByID(podID)
 }
 
+// FindPodByNamespaceAndName 根据命名空间和名称查找pod
+func (p *PodDataService) FindPodByNamespaceAndName(namespace, name string) (*model.Pod, error) {
+	return p.PodRepository.FindPodByNamespaceAndName(namespace, name)
+}
+
 // FindAllPod 查找全部pod
 func (p *PodDataService) FindAllPod() ([]model.Pod, error) {
 	return p.PodRepository.FindAll()
 }
 
 // CreateToK8s 创建pod到k8s
+// 采用服务端应用(server-side apply)，平台创建过的同名对象按照重试处理，不是平台创建的同名对象返回错误
 func (p *PodDataService) CreateToK8s(info *pod.PodInfo) error {
-	// 根据podInfo设置发布控制器Deployment
-	p.SetDeployment(info)
-
-	_, err := p.K8sClientSet.AppsV1().Deployments(info.PodNamespace).Get(context.TODO(), info.PodName, v12.GetOptions{})
+	err := common.CheckCreate("Pod", info.PodName, func() (v12.Object, error) {
+		return p.K8sClientSet.AppsV1().Deployments(info.PodNamespace).Get(context.TODO(), info.PodName, v12.GetOptions{})
+	})
 	if err != nil {
-		// 之前不存在此名称的pod -> 创建pod
-		_, err = p.K8sClientSet.AppsV1().Deployments(info.PodNamespace).Create(context.TODO(), p.deployment, v12.CreateOptions{})
-		if err != nil {
-			// 创建失败
-			//common1.Error(err)
-			return err
-		}
-		common.Info("创建成功")
-		return nil
+		common.Error(err)
+		return err
 	}
-
-	// 没报错说明已经存在
-	// TODO 业务逻辑
-	common.Error("Pod " + info.PodName + "已经存在")
-	return errors.New("Pod " + info.PodName + " 已经存在")
+	err = p.applyToK8s(info)
+	if err != nil {
+		return err
+	}
+	common.Info("Pod " + info.PodName + " 创建成功")
+	return nil
 }
 
 // UpdateToK8s 更新pod到k8s
+// 只更新平台管理的字段，HPA副本数、注入的sidecar等其它控制器设置的字段不会被覆盖
 func (p *PodDataService) UpdateToK8s(info *pod.PodInfo) error {
-	// 根据podInfo设置发布控制器Deployment
-	p.SetDeployment(info)
+	err := p.applyToK8s(info)
+	if err != nil {
+		return err
+	}
+	common.Info("Pod " + info.PodName + " 更新成功")
+	return nil
+}
+
+// applyToK8s 以平台字段管理者的身份将deployment应用到k8s
+// pod配置了HPA时不发送副本数，spec.replicas 交给HPA管理，避免字段冲突
+func (p *PodDataService) applyToK8s(info *pod.PodInfo) error {
+	// 根据podInfo设置发布控制器Deployment，每次调用生成新的对象，并发请求之间互不影响
+	deployment := p.SetDeployment(info)
+	if p.hasHPA(info.PodNamespace, info.PodName) {
+		deployment.Spec.Replicas = nil
+	}
 
-	_, err := p.K8sClientSet.AppsV1().Deployments(info.PodNamespace).Get(context.TODO(), info.PodName, v12.GetOptions{})
+	data, err := common.ApplyData(deployment)
 	if err != nil {
-		// 之前不存在的pod -> 不更新
 		common.Error(err)
-		return errors.New("Pod " + info.PodName + " 不存在请先创建")
+		return err
 	}
 
-	// 之前存在，可以更新
-	_, err = p.K8sClientSet.AppsV1().Deployments(info.PodNamespace).Update(context.TODO(), p.deployment, v12.UpdateOptions{})
+	opts, err := common.ApplyOptions(func() (v12.Object, error) {
+		return p.K8sClientSet.AppsV1().Deployments(info.PodNamespace).Get(context.TODO(), info.PodName, v12.GetOptions{})
+	})
 	if err != nil {
-		// 更新失败
-		//common.Error(err)
+		common.Error(err)
+		return err
+	}
+	// 字段冲突时不强制覆盖，返回冲突信息
+	_, err = p.K8sClientSet.AppsV1().Deployments(info.PodNamespace).Patch(context.TODO(), info.PodName, types.ApplyPatchType, data, opts)
+	if err != nil {
+		err = common.ApplyError("Pod", info.PodName, err)
+		common.Error(err)
 		return err
 	}
-	common.Info(info.PodName + " 更新成功")
 	return nil
 }
 
@@ -140,14 +168,170 @@ func (p *PodDataService) DeletedFromK8s(pod *model.Pod) error {
 	return nil
 }
 
-// SetDeployment 设置发布控制器
-func (p *PodDataService) SetDeployment(info *pod.PodInfo) {
+// ImagePushed 镜像仓库推送了新镜像，对命中tag策略的pod执行滚动更新
+// 每次自动发布都会记录一条发布记录，返回发布的pod数量
+func (p *PodDataService) ImagePushed(push *pod.ImagePush) (int, error) {
+	pods, err := p.PodRepository.FindAllWithTagPolicy()
+	if err != nil {
+		common.Error(err)
+		return 0, err
+	}
+
+	deployed := 0
+	for i := range pods {
+		image, ok, err := matchTagPolicy(&pods[i], push)
+		if err != nil {
+			common.Error(err)
+			continue
+		}
+		if !ok {
+			continue
+		}
+
+		if p.deployImage(pods[i].ID, image, push) {
+			deployed++
+		}
+	}
+	return deployed, nil
+}
+
+// deployImage 将pod更新为新镜像并记录发布结果
+func (p *PodDataService) deployImage(podID int64, image string, push *pod.ImagePush) bool {
+	podModel, err := p.PodRepository.FindPodByID(podID)
+	if err != nil {
+		common.Error(err)
+		return false
+	}
+
+	audit := &model.PodDeployAudit{
+		PodID:        podModel.ID,
+		PodName:      podModel.PodName,
+		PodNamespace: podModel.PodNamespace,
+		OldImage:     podModel.PodImage,
+		NewImage:     image,
+		Digest:       push.Digest,
+		Policy:       podModel.PodTagPolicy + " " + podModel.PodTagPolicyValue,
+		Source:       push.Source,
+		Operator:     push.Operator,
+		CreateTime:   time.Now().Unix(),
+	}
+
+	// 其它配置保持不变，只替换镜像
+	err = p.rollImage(podModel, image, push.Digest)
+	if err != nil {
+		audit.Status = "Failed"
+		audit.Msg = err.Error()
+	} else {
+		audit.Status = "Succeeded"
+		audit.Msg = "镜像 " + audit.OldImage + " 更新为 " + image
+	}
+
+	_, auditErr := p.PodRepository.CreateDeployAudit(audit)
+	if auditErr != nil {
+		common.Error(auditErr)
+	}
+	common.Info("Pod " + podModel.PodName + " 自动发布：" + audit.Msg)
+	return err == nil
+}
+
+// rollImage 滚动更新pod镜像并写入数据库
+func (p *PodDataService) rollImage(podModel *model.Pod, image, digest string) error {
+	info := &pod.PodInfo{}
+	err := common.SwapTo(podModel, info)
+	if err != nil {
+		return err
+	}
+	info.PodImage = image
+	info.PodImageDigest = digest
+
+	err = p.UpdateToK8s(info)
+	if err != nil {
+		return err
+	}
+
+	podModel.PodImage = image
+	podModel.PodImageDigest = digest
+	return p.PodRepository.UpdatePod(podModel)
+}
+
+// FindDeployAuditByPodID 查找pod的自动发布记录
+func (p *PodDataService) FindDeployAuditByPodID(podID int64) ([]model.PodDeployAudit, error) {
+	return p.PodRepository.FindDeployAuditByPodID(podID)
+}
+
+// ExportYAML 导出应用到k8s的deployment，与 UpdateToK8s 发送的内容一致
+// 配置了HPA时副本数由HPA控制，和应用时一样不导出 replicas
+func (p *PodDataService) ExportYAML(info *pod.PodInfo) (string, error) {
+	deployment := p.SetDeployment(info)
+	if p.hasHPA(info.PodNamespace, info.PodName) {
+		deployment.Spec.Replicas = nil
+	}
+	return common.ExportYAML(deployment)
+}
+
+// UpdatePodReplicas 只修改数据库中的副本数，允许为0
+func (p *PodDataService) UpdatePodReplicas(podID int64, replicas int32) error {
+	return p.PodRepository.UpdatePodReplicas(podID, replicas)
+}
+
+// ScalePod 调整副本数并写入数据库，为0时停止pod
+// 配置了HPA时平台不应用副本数，通过scale子资源修改；缩为0时HPA暂停，恢复副本数后HPA继续调整
+func (p *PodDataService) ScalePod(podModel *model.Pod, replicas int32) error {
+	if p.hasHPA(podModel.PodNamespace, podModel.PodName) {
+		deployments := p.K8sClientSet.AppsV1().Deployments(podModel.PodNamespace)
+		scale, err := deployments.GetScale(context.TODO(), podModel.PodName, v12.GetOptions{})
+		if err != nil {
+			common.Error(err)
+			return err
+		}
+		scale.Spec.Replicas = replicas
+		_, err = deployments.UpdateScale(context.TODO(), podModel.PodName, scale, v12.UpdateOptions{FieldManager: common.FieldManager})
+		if err != nil {
+			common.Error(err)
+			return err
+		}
+	} else {
+		info := &pod.PodInfo{}
+		err := common.SwapTo(podModel, info)
+		if err != nil {
+			common.Error(err)
+			return err
+		}
+		info.Id = podModel.ID
+		info.PodReplicas = replicas
+		err = p.applyToK8s(info)
+		if err != nil {
+			return err
+		}
+	}
+	return p.PodRepository.UpdatePodReplicas(podModel.ID, replicas)
+}
+
+// hasHPA pod的deployment是否由HPA控制副本数
+// 查询失败时按照没有HPA处理，由服务端应用的冲突检查兜底
+func (p *PodDataService) hasHPA(namespace, name string) bool {
+	list, err := p.K8sClientSet.AutoscalingV2().HorizontalPodAutoscalers(namespace).List(context.TODO(), v12.ListOptions{})
+	if err != nil {
+		common.Error(err)
+		return false
+	}
+	for _, hpa := range list.Items {
+		target := hpa.Spec.ScaleTargetRef
+		if target.Kind == "Deployment" && target.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// SetDeployment 根据podInfo生成发布控制器
+func (p *PodDataService) SetDeployment(info *pod.PodInfo) *v1.Deployment {
 	deployment := &v1.Deployment{}
 
 	// deployment元数据类型
 	deployment.TypeMeta = v12.TypeMeta{
-		Kind:       "deployment",
-		APIVersion: "v1",
+		Kind:       "Deployment",
+		APIVersion: "apps/v1",
 	}
 
 	// deployment持久化目标元数据
@@ -206,8 +390,7 @@ func (p *PodDataService) SetDeployment(info *pod.PodInfo) {
 		ProgressDeadlineSeconds: nil,
 	}
 
-	// 将配置信息赋值
-	p.deployment = deployment
+	return deployment
 }
 
 // getContainerPort 生成容器端口
@@ -0,0 +1,146 @@
+package service
+
+import (
+	"errors"
+	"github.com/Masterminds/semver"
+	"regexp"
+	"strings"
+	"tini-paas/internal/pod/model"
+	"tini-paas/internal/pod/proto/pod"
+)
+
+// 自动发布的tag策略
+const (
+	TagPolicySemver = "semver"
+	TagPolicyRegex  = "regex"
+	TagPolicyDigest = "digest"
+)
+
+// imageRef 解析后的镜像地址
+type imageRef struct {
+	// name 镜像名称，保留用户填写的原始格式，不含tag和摘要
+	name string
+
+	// host 仓库地址，docker hub 统一为 docker.io
+	host string
+
+	// repository 仓库中的镜像路径，如 library/nginx
+	repository string
+
+	// tag 镜像tag
+	tag string
+
+	// digest 镜像摘要
+	digest string
+}
+
+// parseImage 解析镜像地址，如 harbor.example.com/library/nginx:1.2.3@sha256:...
+func parseImage(image string) imageRef {
+	ref := imageRef{}
+	if i := strings.Index(image, "@"); i >= 0 {
+		ref.digest = image[i+1:]
+		image = image[:i]
+	}
+
+	// tag 在最后一个 / 之后，避免把仓库端口当作tag
+	if i := strings.LastIndex(image, ":"); i > strings.LastIndex(image, "/") {
+		ref.tag = image[i+1:]
+		image = image[:i]
+	}
+	ref.name = image
+
+	// 第一段包含 . 或 : 或者为 localhost 时是仓库地址
+	parts := strings.SplitN(image, "/", 2)
+	if len(parts) == 2 && (strings.ContainsAny(parts[0], ".:") || parts[0] == "localhost") {
+		ref.host = parts[0]
+		ref.repository = parts[1]
+	} else {
+		ref.repository = image
+	}
+	ref.host, ref.repository = normalizeRepository(ref.host, ref.repository)
+	return ref
+}
+
+// normalizeRepository 统一docker hub的写法
+func normalizeRepository(host, repository string) (string, string) {
+	switch host {
+	case "", "index.docker.io", "registry-1.docker.io":
+		host = "docker.io"
+	}
+	if host == "docker.io" && !strings.Contains(repository, "/") {
+		repository = "library/" + repository
+	}
+	return host, repository
+}
+
+// matchRepository 推送的镜像是否为pod使用的镜像
+// 推送事件中没有仓库地址时只比较镜像路径
+func matchRepository(ref imageRef, push *pod.ImagePush) bool {
+	host, repository := push.Host, push.Repository
+	if host != "" {
+		host, repository = normalizeRepository(host, repository)
+	} else {
+		_, repository = normalizeRepository(ref.host, repository)
+	}
+	if repository != ref.repository {
+		return false
+	}
+	return push.Host == "" || host == ref.host
+}
+
+// matchTagPolicy 根据pod的tag策略判断是否需要发布，返回发布的新镜像
+func matchTagPolicy(podModel *model.Pod, push *pod.ImagePush) (string, bool, error) {
+	ref := parseImage(podModel.PodImage)
+	if !matchRepository(ref, push) || push.Tag == "" {
+		return "", false, nil
+	}
+
+	switch podModel.PodTagPolicy {
+	case TagPolicySemver:
+		constraint, err := semver.NewConstraint(podModel.PodTagPolicyValue)
+		if err != nil {
+			return "", false, errors.New("版本范围 " + podModel.PodTagPolicyValue + " 格式错误：" + err.Error())
+		}
+		version, err := semver.NewVersion(push.Tag)
+		if err != nil || !constraint.Check(version) {
+			return "", false, nil
+		}
+		// 只升级不降级
+		current, err := semver.NewVersion(ref.tag)
+		if err == nil && !version.GreaterThan(current) {
+			return "", false, nil
+		}
+	case TagPolicyRegex:
+		re, err := regexp.Compile(podModel.PodTagPolicyValue)
+		if err != nil {
+			return "", false, errors.New("正则表达式 " + podModel.PodTagPolicyValue + " 格式错误：" + err.Error())
+		}
+		if !re.MatchString(push.Tag) {
+			return "", false, nil
+		}
+		// 同一个tag重新推送时根据摘要判断
+		if push.Tag == ref.tag && (push.Digest == "" || push.Digest == podModel.PodImageDigest) {
+			return "", false, nil
+		}
+	case TagPolicyDigest:
+		tracked := podModel.PodTagPolicyValue
+		if tracked == "" {
+			tracked = ref.tag
+		}
+		if tracked == "" {
+			tracked = "latest"
+		}
+		if push.Tag != tracked || push.Digest == "" || push.Digest == podModel.PodImageDigest {
+			return "", false, nil
+		}
+	default:
+		return "", false, nil
+	}
+
+	// 带上摘要，保证相同tag也能触发滚动更新
+	image := ref.name + ":" + push.Tag
+	if push.Digest != "" {
+		image += "@" + push.Digest
+	}
+	return image, true, nil
+}
@@ -2,17 +2,29 @@ package handler
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	microErrors "github.com/asim/go-micro/v3/errors"
+	"github.com/jinzhu/gorm"
+	"strconv"
 	"tini-paas/internal/route/model"
 	"tini-paas/internal/route/proto/route"
 	"tini-paas/internal/route/service"
+	"tini-paas/internal/user/proto/user"
 	"tini-paas/pkg/common"
 )
 
 // RouteHandler 调用底层, 实现服务层接口
 type RouteHandler struct {
 	RouteService service.RouteService
+
+	// UserService 用户服务，校验设置原始注解的权限
+	UserService user.UserService
 }
 
+// rawAnnotationsAction 设置路由原始注解需要的权限
+const rawAnnotationsAction = "route:raw-annotations"
+
 func (r *RouteHandler) AddRoute(ctx context.Context, info *route.RouteInfo, response *route.Response) error {
 	routeModel := &model.Route{}
 
@@ -23,6 +35,34 @@ func (r *RouteHandler) AddRoute(ctx context.Context, info *route.RouteInfo, resp
 		return err
 	}
 
+	// 同一命名空间内名称唯一，避免覆盖k8s中已经存在的资源
+	// 查询出错时不能当作不存在，否则数据库异常时会绕过检查
+	_, err = r.RouteService.FindRouteByNamespaceAndName(info.RouteNamespace, info.RouteName)
+	if err == nil {
+		err = errors.New("Route " + info.RouteNamespace + "/" + info.RouteName + " 已经存在")
+	}
+	if !gorm.IsRecordNotFoundError(err) {
+		common.Error(err)
+		return err
+	}
+
+	if info.RouteRawAnnotations != "" {
+		err = r.checkRawAnnotationsRight(ctx)
+		if err != nil {
+			common.Error(err)
+			response.Msg = err.Error()
+			return err
+		}
+	}
+
+	// 引用的服务不存在时ingress只会返回503，创建前检查
+	err = r.checkBackends(info, response)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	routeModel.RouteBackendStatus = service.BackendOk
+
 	// 创建route到k8s
 	err = r.RouteService.CreateRouteToK8s(info)
 	if err != nil {
@@ -61,15 +101,31 @@ func (r *RouteHandler) DeleteRoute(ctx context.Context, id *route.RouteID, respo
 }
 
 func (r *RouteHandler) UpdateRoute(ctx context.Context, info *route.RouteInfo, response *route.Response) error {
-	// 先更新k8s
-	err := r.RouteService.UpdateRouteToK8s(info)
+	// 查询数据库信息
+	routeModel, err := r.RouteService.FindRouteByID(info.Id)
 	if err != nil {
 		common.Error(err)
 		return err
 	}
 
-	// 查询数据库信息
-	routeModel, err := r.RouteService.FindRouteByID(info.Id)
+	// 修改原始注解需要管理员权限，原样提交时不校验
+	if info.RouteRawAnnotations != routeModel.RouteRawAnnotations {
+		err = r.checkRawAnnotationsRight(ctx)
+		if err != nil {
+			common.Error(err)
+			response.Msg = err.Error()
+			return err
+		}
+	}
+
+	err = r.checkBackends(info, response)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+
+	// 先更新k8s
+	err = r.RouteService.UpdateRouteToK8s(info, routeModel)
 	if err != nil {
 		common.Error(err)
 		return err
@@ -81,6 +137,11 @@ func (r *RouteHandler) UpdateRoute(ctx context.Context, info *route.RouteInfo, r
 		common.Error(err)
 		return err
 	}
+	// 空值不会通过json覆盖，生成方式相关的字段需要与k8s中的对象一致，下次更新时据此删除不再使用的对象
+	routeModel.RouteRenderer = info.RouteRenderer
+	routeModel.RouteGateway = info.RouteGateway
+	routeModel.RouteGatewayClass = info.RouteGatewayClass
+	routeModel.RouteBackendStatus = service.BackendOk
 	return r.RouteService.UpdateRoute(routeModel)
 }
 
@@ -92,7 +153,7 @@ func (r *RouteHandler) FindRouteByID(ctx context.Context, id *route.RouteID, inf
 	}
 
 	// 数据转换
-	err = common.SwapTo(routeModel, info)
+	err = r.toRouteInfo(routeModel, info)
 	if err != nil {
 		common.Error(err)
 		return err
@@ -113,7 +174,7 @@ func (r *RouteHandler) FindAllRoute(ctx context.Context, all *route.FindAll, rsp
 		routeInfo := &route.RouteInfo{}
 
 		// 将查询结果转换
-		err = common.SwapTo(v, routeInfo)
+		err = r.toRouteInfo(&v, routeInfo)
 		if err != nil {
 			common.Error(err)
 			return err
@@ -123,3 +184,113 @@ func (r *RouteHandler) FindAllRoute(ctx context.Context, all *route.FindAll, rsp
 	}
 	return nil
 }
+
+// FindRouteByNamespaceAndName 根据命名空间和名称查找
+func (r *RouteHandler) FindRouteByNamespaceAndName(ctx context.Context, req *route.RouteNamespaceName, info *route.RouteInfo) error {
+	routeModel, err := r.RouteService.FindRouteByNamespaceAndName(req.Namespace, req.Name)
+	if err != nil {
+		common.Error(err)
+		if gorm.IsRecordNotFoundError(err) {
+			return microErrors.NotFound("go.micro.service.route", "route %s/%s 不存在", req.Namespace, req.Name)
+		}
+		return err
+	}
+
+	// 数据转换
+	err = r.toRouteInfo(routeModel, info)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	return nil
+}
+
+// FindExpiringRoutes 查找证书即将到期、已经过期或者还没有签发的路由
+func (r *RouteHandler) FindExpiringRoutes(ctx context.Context, all *route.FindAll, rsp *route.AllRoute) error {
+	allRoute, err := r.RouteService.FindAllRoute()
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+
+	for i := range allRoute {
+		if allRoute[i].RouteTLSMode == "" {
+			continue
+		}
+		routeInfo := &route.RouteInfo{}
+		err = r.toRouteInfo(&allRoute[i], routeInfo)
+		if err != nil {
+			common.Error(err)
+			return err
+		}
+		if routeInfo.RouteTlsWarning != "" {
+			rsp.RouteInfo = append(rsp.RouteInfo, routeInfo)
+		}
+	}
+	return nil
+}
+
+// toRouteInfo 转换路由信息，并读取证书状态
+func (r *RouteHandler) toRouteInfo(routeModel *model.Route, info *route.RouteInfo) error {
+	err := common.SwapTo(routeModel, info)
+	if err != nil {
+		return err
+	}
+	info.Id = routeModel.ID
+	return r.RouteService.SetTLSStatus(info)
+}
+
+// checkRawAnnotationsRight 校验登录的用户是否有设置原始注解的权限
+// 用户ID从登录令牌中读取，不能信任请求参数中的用户ID
+func (r *RouteHandler) checkRawAnnotationsRight(ctx context.Context) error {
+	if r.UserService == nil {
+		return errors.New("未配置用户服务，无法鉴权")
+	}
+	userID, err := common.UserIDFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	right, err := r.UserService.IsRight(ctx, &user.UserRight{
+		UserId: userID,
+		Action: rawAnnotationsAction,
+	})
+	if err != nil {
+		return err
+	}
+	if !right.Access {
+		return errors.New("用户 " + strconv.FormatInt(userID, 10) + " 没有设置路由原始注解的权限")
+	}
+	return nil
+}
+
+// checkBackends 检查路由引用的服务，不可用时返回 BadRequest，Detail 为 BackendError 列表的JSON
+func (r *RouteHandler) checkBackends(info *route.RouteInfo, response *route.Response) error {
+	problems, err := r.RouteService.CheckBackends(info)
+	if err != nil {
+		return err
+	}
+	if len(problems) == 0 {
+		return nil
+	}
+	detail, err := json.Marshal(problems)
+	if err != nil {
+		return err
+	}
+	response.Msg = "路由引用的服务不可用"
+	return microErrors.BadRequest("go.micro.service.route", "%s", detail)
+}
+
+// ExportRoute 导出路由应用到k8s的Ingress或者Gateway和HTTPRoute
+func (r *RouteHandler) ExportRoute(ctx context.Context, id *route.RouteID, rsp *route.ExportResult) error {
+	info := &route.RouteInfo{}
+	err := r.FindRouteByID(ctx, id, info)
+	if err != nil {
+		return err
+	}
+	rsp.Yaml, err = r.RouteService.ExportYAML(info)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	return nil
+}
@@ -4,15 +4,90 @@ package model
 type Route struct {
 	ID int64 `gorm:"primary_key;not_null;auto_increment"`
 
-	// RouteName 路由名称
-	RouteName string `json:"route_name"`
+	// RouteName 路由名称，同一命名空间内唯一
+	RouteName string `gorm:"unique_index:idx_route_namespace_name;not_null" json:"route_name"`
 
 	// RouteNamespace 路由命名空间
-	RouteNamespace string `json:"route_namespace"`
+	RouteNamespace string `gorm:"unique_index:idx_route_namespace_name;not_null" json:"route_namespace"`
 
-	// RouteHost 路由域名
+	// RouteHost 路由域名，多个域名用逗号分隔
 	RouteHost string `json:"route_host"`
 
 	// RoutePath 关联路径
 	RoutePath []RoutePath `gorm:"ForeignKey:RouteID" json:"route_path"`
+
+	// RouteTLSMode 证书来源，为空时不启用https
+	// upload: 上传证书和私钥，保存为 kubernetes.io/tls 类型的secret
+	// secret: 使用命名空间中已有的证书secret
+	// cert-manager: 通过 ClusterIssuer 注解由cert-manager签发
+	RouteTLSMode string `json:"route_tls_mode"`
+
+	// RouteTLSSecret 证书所在的secret
+	RouteTLSSecret string `json:"route_tls_secret"`
+
+	// RouteTLSClusterIssuer cert-manager 签发证书使用的 ClusterIssuer
+	RouteTLSClusterIssuer string `json:"route_tls_cluster_issuer"`
+
+	// RouteDefaultBackendService 没有匹配任何规则的请求转发到的服务
+	RouteDefaultBackendService string `json:"route_default_backend_service"`
+
+	// RouteDefaultBackendServicePort 默认服务的端口
+	RouteDefaultBackendServicePort int32 `json:"route_default_backend_service_port"`
+
+	// RouteIngressClass ingress类，默认为 nginx
+	// 以下选项对应 ingress-nginx 的注解，其他控制器不生效
+	RouteIngressClass string `json:"route_ingress_class"`
+
+	// RouteRewriteTarget 转发前改写路径，如 /$2
+	RouteRewriteTarget string `json:"route_rewrite_target"`
+
+	// RouteEnableCors 开启跨域
+	RouteEnableCors bool `json:"route_enable_cors"`
+
+	// RouteCorsAllowOrigin 允许跨域的来源，为空时允许全部
+	RouteCorsAllowOrigin string `json:"route_cors_allow_origin"`
+
+	// RouteLimitRps 每个客户端IP每秒的请求数，为0时不限制
+	RouteLimitRps int32 `json:"route_limit_rps"`
+
+	// RouteProxyBodySize 请求体大小上限，如 10m
+	RouteProxyBodySize string `json:"route_proxy_body_size"`
+
+	// RouteProxyConnectTimeout 连接后端的超时(秒)
+	RouteProxyConnectTimeout int32 `json:"route_proxy_connect_timeout"`
+
+	// RouteProxyReadTimeout 读取后端响应的超时(秒)
+	RouteProxyReadTimeout int32 `json:"route_proxy_read_timeout"`
+
+	// RouteProxySendTimeout 向后端发送请求的超时(秒)
+	RouteProxySendTimeout int32 `json:"route_proxy_send_timeout"`
+
+	// RouteBasicAuthSecret 基本认证使用的secret，需要包含htpasswd格式的auth字段
+	RouteBasicAuthSecret string `json:"route_basic_auth_secret"`
+
+	// RouteWhitelistSourceRange 允许访问的网段，逗号分隔
+	RouteWhitelistSourceRange string `json:"route_whitelist_source_range"`
+
+	// RouteSslRedirect 是否将http重定向到https，true, false，为空时使用控制器的默认值
+	RouteSslRedirect string `json:"route_ssl_redirect"`
+
+	// RouteRawAnnotations 原始注解(JSON对象)，只有管理员可以设置，覆盖以上选项生成的注解
+	RouteRawAnnotations string `gorm:"type:text" json:"route_raw_annotations"`
+
+	// RouteRenderer 生成的k8s对象，为空时使用集群的默认值
+	// ingress: networking.k8s.io/v1 Ingress
+	// gateway: Gateway API 的 HTTPRoute，支持请求头匹配、按权重分流和流量镜像
+	RouteRenderer string `json:"route_renderer"`
+
+	// RouteGateway gateway 模式下挂载到的已有Gateway，格式为 命名空间/名称
+	RouteGateway string `json:"route_gateway"`
+
+	// RouteGatewayClass gateway 模式下为路由单独创建Gateway使用的 GatewayClass
+	RouteGatewayClass string `json:"route_gateway_class"`
+
+	// RouteBackendStatus 后端服务的检查结果 Ok, Missing，由后台定期检查
+	RouteBackendStatus string `json:"route_backend_status"`
+
+	// RouteBackendMsg 缺少的后端服务
+	RouteBackendMsg string `json:"route_backend_msg"`
 }
@@ -14,5 +14,26 @@ type RoutePath struct {
 	RouteBackendService string `json:"route_backend_service"`
 
 	// RouteBackendServicePort route绑定service暴露的端口
-	RouteBackendServicePort string `json:"route_backend_service_port"`
+	RouteBackendServicePort int32 `json:"route_backend_service_port"`
+
+	// RoutePathType 路径匹配方式 Exact, Prefix, ImplementationSpecific
+	RoutePathType string `json:"route_path_type"`
+
+	// RoutePathHost 路径所属的域名，为空时用于路由的全部域名
+	RoutePathHost string `json:"route_path_host"`
+
+	// RouteHeaderMatches 请求头精确匹配，如 X-Env=canary,X-User=1，只用于 gateway 模式
+	RouteHeaderMatches string `json:"route_header_matches"`
+
+	// RouteBackendWeight 主服务的权重，只用于 gateway 模式
+	RouteBackendWeight int32 `json:"route_backend_weight"`
+
+	// RouteExtraBackends 按照权重分流的其他服务，格式为 服务:端口:权重，只用于 gateway 模式
+	RouteExtraBackends string `json:"route_extra_backends"`
+
+	// RouteMirrorService 请求复制到的服务，只用于 gateway 模式
+	RouteMirrorService string `json:"route_mirror_service"`
+
+	// RouteMirrorServicePort 镜像服务的端口
+	RouteMirrorServicePort int32 `json:"route_mirror_service_port"`
 }
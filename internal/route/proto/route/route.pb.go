@@ -26,11 +26,61 @@ type RouteInfo struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id             int64        `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	RouteName      string       `protobuf:"bytes,2,opt,name=route_name,json=routeName,proto3" json:"route_name,omitempty"`
-	RouteNamespace string       `protobuf:"bytes,3,opt,name=route_namespace,json=routeNamespace,proto3" json:"route_namespace,omitempty"`
-	RouteHost      string       `protobuf:"bytes,4,opt,name=route_host,json=routeHost,proto3" json:"route_host,omitempty"`
-	RoutePath      []*RoutePath `protobuf:"bytes,5,rep,name=route_path,json=routePath,proto3" json:"route_path,omitempty"`
+	Id             int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	RouteName      string `protobuf:"bytes,2,opt,name=route_name,json=routeName,proto3" json:"route_name,omitempty"`
+	RouteNamespace string `protobuf:"bytes,3,opt,name=route_namespace,json=routeNamespace,proto3" json:"route_namespace,omitempty"`
+	// 路由域名，多个域名用逗号分隔，如 a.example.com,b.example.com
+	RouteHost string       `protobuf:"bytes,4,opt,name=route_host,json=routeHost,proto3" json:"route_host,omitempty"`
+	RoutePath []*RoutePath `protobuf:"bytes,5,rep,name=route_path,json=routePath,proto3" json:"route_path,omitempty"`
+	// 证书来源：为空时不启用https，upload 上传证书，secret 使用已有的证书，cert-manager 由cert-manager签发
+	RouteTlsMode string `protobuf:"bytes,6,opt,name=route_tls_mode,json=routeTlsMode,proto3" json:"route_tls_mode,omitempty"`
+	// 证书所在的 kubernetes.io/tls 类型的secret，upload 和 cert-manager 为空时使用 <路由名称>-tls
+	RouteTlsSecret string `protobuf:"bytes,7,opt,name=route_tls_secret,json=routeTlsSecret,proto3" json:"route_tls_secret,omitempty"`
+	// cert-manager 签发证书使用的 ClusterIssuer
+	RouteTlsClusterIssuer string `protobuf:"bytes,8,opt,name=route_tls_cluster_issuer,json=routeTlsClusterIssuer,proto3" json:"route_tls_cluster_issuer,omitempty"`
+	// 上传的证书和私钥(PEM)，只写入secret，不保存到数据库
+	RouteTlsCert string `protobuf:"bytes,9,opt,name=route_tls_cert,json=routeTlsCert,proto3" json:"route_tls_cert,omitempty"`
+	RouteTlsKey  string `protobuf:"bytes,10,opt,name=route_tls_key,json=routeTlsKey,proto3" json:"route_tls_key,omitempty"`
+	// 证书到期时间(unix时间戳)和剩余天数，查询时读取
+	RouteTlsNotAfter   int64 `protobuf:"varint,11,opt,name=route_tls_not_after,json=routeTlsNotAfter,proto3" json:"route_tls_not_after,omitempty"`
+	RouteTlsExpireDays int32 `protobuf:"varint,12,opt,name=route_tls_expire_days,json=routeTlsExpireDays,proto3" json:"route_tls_expire_days,omitempty"`
+	// 证书即将到期、已经过期或者还没有签发时的提示
+	RouteTlsWarning string `protobuf:"bytes,13,opt,name=route_tls_warning,json=routeTlsWarning,proto3" json:"route_tls_warning,omitempty"`
+	// 没有匹配任何规则的请求转发到的服务
+	RouteDefaultBackendService     string `protobuf:"bytes,14,opt,name=route_default_backend_service,json=routeDefaultBackendService,proto3" json:"route_default_backend_service,omitempty"`
+	RouteDefaultBackendServicePort int32  `protobuf:"varint,15,opt,name=route_default_backend_service_port,json=routeDefaultBackendServicePort,proto3" json:"route_default_backend_service_port,omitempty"`
+	// ingress类，默认为 nginx，以下选项对应 ingress-nginx 的注解
+	RouteIngressClass string `protobuf:"bytes,16,opt,name=route_ingress_class,json=routeIngressClass,proto3" json:"route_ingress_class,omitempty"`
+	// 转发前改写路径，如 /$2
+	RouteRewriteTarget string `protobuf:"bytes,17,opt,name=route_rewrite_target,json=routeRewriteTarget,proto3" json:"route_rewrite_target,omitempty"`
+	// 跨域
+	RouteEnableCors      bool   `protobuf:"varint,18,opt,name=route_enable_cors,json=routeEnableCors,proto3" json:"route_enable_cors,omitempty"`
+	RouteCorsAllowOrigin string `protobuf:"bytes,19,opt,name=route_cors_allow_origin,json=routeCorsAllowOrigin,proto3" json:"route_cors_allow_origin,omitempty"`
+	// 每个客户端IP每秒的请求数
+	RouteLimitRps int32 `protobuf:"varint,20,opt,name=route_limit_rps,json=routeLimitRps,proto3" json:"route_limit_rps,omitempty"`
+	// 请求体大小上限，如 10m
+	RouteProxyBodySize string `protobuf:"bytes,21,opt,name=route_proxy_body_size,json=routeProxyBodySize,proto3" json:"route_proxy_body_size,omitempty"`
+	// 连接、读取、发送超时(秒)
+	RouteProxyConnectTimeout int32 `protobuf:"varint,22,opt,name=route_proxy_connect_timeout,json=routeProxyConnectTimeout,proto3" json:"route_proxy_connect_timeout,omitempty"`
+	RouteProxyReadTimeout    int32 `protobuf:"varint,23,opt,name=route_proxy_read_timeout,json=routeProxyReadTimeout,proto3" json:"route_proxy_read_timeout,omitempty"`
+	RouteProxySendTimeout    int32 `protobuf:"varint,24,opt,name=route_proxy_send_timeout,json=routeProxySendTimeout,proto3" json:"route_proxy_send_timeout,omitempty"`
+	// 基本认证使用的secret，需要包含htpasswd格式的auth字段
+	RouteBasicAuthSecret string `protobuf:"bytes,25,opt,name=route_basic_auth_secret,json=routeBasicAuthSecret,proto3" json:"route_basic_auth_secret,omitempty"`
+	// 允许访问的网段，逗号分隔
+	RouteWhitelistSourceRange string `protobuf:"bytes,26,opt,name=route_whitelist_source_range,json=routeWhitelistSourceRange,proto3" json:"route_whitelist_source_range,omitempty"`
+	// 是否将http重定向到https，为空时使用控制器的默认值
+	RouteSslRedirect string `protobuf:"bytes,27,opt,name=route_ssl_redirect,json=routeSslRedirect,proto3" json:"route_ssl_redirect,omitempty"`
+	// 原始注解(JSON对象)，只有管理员可以设置
+	RouteRawAnnotations string `protobuf:"bytes,28,opt,name=route_raw_annotations,json=routeRawAnnotations,proto3" json:"route_raw_annotations,omitempty"`
+	// 生成的k8s对象：ingress 或 gateway(Gateway API 的 HTTPRoute)，为空时使用集群的默认值
+	RouteRenderer string `protobuf:"bytes,30,opt,name=route_renderer,json=routeRenderer,proto3" json:"route_renderer,omitempty"`
+	// gateway 模式下挂载到的已有Gateway，格式为 命名空间/名称
+	RouteGateway string `protobuf:"bytes,31,opt,name=route_gateway,json=routeGateway,proto3" json:"route_gateway,omitempty"`
+	// gateway 模式下为路由单独创建Gateway使用的 GatewayClass，设置后忽略 route_gateway
+	RouteGatewayClass string `protobuf:"bytes,32,opt,name=route_gateway_class,json=routeGatewayClass,proto3" json:"route_gateway_class,omitempty"`
+	// 后端服务的检查结果：Ok 或 Missing，由后台定期检查
+	RouteBackendStatus string `protobuf:"bytes,33,opt,name=route_backend_status,json=routeBackendStatus,proto3" json:"route_backend_status,omitempty"`
+	RouteBackendMsg    string `protobuf:"bytes,34,opt,name=route_backend_msg,json=routeBackendMsg,proto3" json:"route_backend_msg,omitempty"`
 }
 
 func (x *RouteInfo) Reset() {
@@ -100,6 +150,202 @@ func (x *RouteInfo) GetRoutePath() []*RoutePath {
 	return nil
 }
 
+func (x *RouteInfo) GetRouteTlsMode() string {
+	if x != nil {
+		return x.RouteTlsMode
+	}
+	return ""
+}
+
+func (x *RouteInfo) GetRouteTlsSecret() string {
+	if x != nil {
+		return x.RouteTlsSecret
+	}
+	return ""
+}
+
+func (x *RouteInfo) GetRouteTlsClusterIssuer() string {
+	if x != nil {
+		return x.RouteTlsClusterIssuer
+	}
+	return ""
+}
+
+func (x *RouteInfo) GetRouteTlsCert() string {
+	if x != nil {
+		return x.RouteTlsCert
+	}
+	return ""
+}
+
+func (x *RouteInfo) GetRouteTlsKey() string {
+	if x != nil {
+		return x.RouteTlsKey
+	}
+	return ""
+}
+
+func (x *RouteInfo) GetRouteTlsNotAfter() int64 {
+	if x != nil {
+		return x.RouteTlsNotAfter
+	}
+	return 0
+}
+
+func (x *RouteInfo) GetRouteTlsExpireDays() int32 {
+	if x != nil {
+		return x.RouteTlsExpireDays
+	}
+	return 0
+}
+
+func (x *RouteInfo) GetRouteTlsWarning() string {
+	if x != nil {
+		return x.RouteTlsWarning
+	}
+	return ""
+}
+
+func (x *RouteInfo) GetRouteDefaultBackendService() string {
+	if x != nil {
+		return x.RouteDefaultBackendService
+	}
+	return ""
+}
+
+func (x *RouteInfo) GetRouteDefaultBackendServicePort() int32 {
+	if x != nil {
+		return x.RouteDefaultBackendServicePort
+	}
+	return 0
+}
+
+func (x *RouteInfo) GetRouteIngressClass() string {
+	if x != nil {
+		return x.RouteIngressClass
+	}
+	return ""
+}
+
+func (x *RouteInfo) GetRouteRewriteTarget() string {
+	if x != nil {
+		return x.RouteRewriteTarget
+	}
+	return ""
+}
+
+func (x *RouteInfo) GetRouteEnableCors() bool {
+	if x != nil {
+		return x.RouteEnableCors
+	}
+	return false
+}
+
+func (x *RouteInfo) GetRouteCorsAllowOrigin() string {
+	if x != nil {
+		return x.RouteCorsAllowOrigin
+	}
+	return ""
+}
+
+func (x *RouteInfo) GetRouteLimitRps() int32 {
+	if x != nil {
+		return x.RouteLimitRps
+	}
+	return 0
+}
+
+func (x *RouteInfo) GetRouteProxyBodySize() string {
+	if x != nil {
+		return x.RouteProxyBodySize
+	}
+	return ""
+}
+
+func (x *RouteInfo) GetRouteProxyConnectTimeout() int32 {
+	if x != nil {
+		return x.RouteProxyConnectTimeout
+	}
+	return 0
+}
+
+func (x *RouteInfo) GetRouteProxyReadTimeout() int32 {
+	if x != nil {
+		return x.RouteProxyReadTimeout
+	}
+	return 0
+}
+
+func (x *RouteInfo) GetRouteProxySendTimeout() int32 {
+	if x != nil {
+		return x.RouteProxySendTimeout
+	}
+	return 0
+}
+
+func (x *RouteInfo) GetRouteBasicAuthSecret() string {
+	if x != nil {
+		return x.RouteBasicAuthSecret
+	}
+	return ""
+}
+
+func (x *RouteInfo) GetRouteWhitelistSourceRange() string {
+	if x != nil {
+		return x.RouteWhitelistSourceRange
+	}
+	return ""
+}
+
+func (x *RouteInfo) GetRouteSslRedirect() string {
+	if x != nil {
+		return x.RouteSslRedirect
+	}
+	return ""
+}
+
+func (x *RouteInfo) GetRouteRawAnnotations() string {
+	if x != nil {
+		return x.RouteRawAnnotations
+	}
+	return ""
+}
+
+func (x *RouteInfo) GetRouteRenderer() string {
+	if x != nil {
+		return x.RouteRenderer
+	}
+	return ""
+}
+
+func (x *RouteInfo) GetRouteGateway() string {
+	if x != nil {
+		return x.RouteGateway
+	}
+	return ""
+}
+
+func (x *RouteInfo) GetRouteGatewayClass() string {
+	if x != nil {
+		return x.RouteGatewayClass
+	}
+	return ""
+}
+
+func (x *RouteInfo) GetRouteBackendStatus() string {
+	if x != nil {
+		return x.RouteBackendStatus
+	}
+	return ""
+}
+
+func (x *RouteInfo) GetRouteBackendMsg() string {
+	if x != nil {
+		return x.RouteBackendMsg
+	}
+	return ""
+}
+
 // RoutePath 关联Path
 type RoutePath struct {
 	state         protoimpl.MessageState
@@ -111,6 +357,20 @@ type RoutePath struct {
 	RoutePathName           string `protobuf:"bytes,3,opt,name=route_path_name,json=routePathName,proto3" json:"route_path_name,omitempty"`
 	RouteBackendService     string `protobuf:"bytes,4,opt,name=route_backend_service,json=routeBackendService,proto3" json:"route_backend_service,omitempty"`
 	RouteBackendServicePort int32  `protobuf:"varint,5,opt,name=route_backend_service_port,json=routeBackendServicePort,proto3" json:"route_backend_service_port,omitempty"`
+	// 路径匹配方式 Exact, Prefix, ImplementationSpecific，默认为 Prefix
+	RoutePathType string `protobuf:"bytes,6,opt,name=route_path_type,json=routePathType,proto3" json:"route_path_type,omitempty"`
+	// 路径所属的域名，为空时用于路由的全部域名
+	RoutePathHost string `protobuf:"bytes,7,opt,name=route_path_host,json=routePathHost,proto3" json:"route_path_host,omitempty"`
+	// 以下只用于 gateway 模式
+	// 请求头精确匹配，多个用逗号分隔，如 X-Env=canary,X-User=1
+	RouteHeaderMatches string `protobuf:"bytes,8,opt,name=route_header_matches,json=routeHeaderMatches,proto3" json:"route_header_matches,omitempty"`
+	// 主服务的权重，有其他服务时按照权重分配流量
+	RouteBackendWeight int32 `protobuf:"varint,9,opt,name=route_backend_weight,json=routeBackendWeight,proto3" json:"route_backend_weight,omitempty"`
+	// 按照权重分流的其他服务，格式为 服务:端口:权重，多个用逗号分隔
+	RouteExtraBackends string `protobuf:"bytes,10,opt,name=route_extra_backends,json=routeExtraBackends,proto3" json:"route_extra_backends,omitempty"`
+	// 请求复制到的服务，用于流量镜像
+	RouteMirrorService     string `protobuf:"bytes,11,opt,name=route_mirror_service,json=routeMirrorService,proto3" json:"route_mirror_service,omitempty"`
+	RouteMirrorServicePort int32  `protobuf:"varint,12,opt,name=route_mirror_service_port,json=routeMirrorServicePort,proto3" json:"route_mirror_service_port,omitempty"`
 }
 
 func (x *RoutePath) Reset() {
@@ -180,6 +440,55 @@ func (x *RoutePath) GetRouteBackendServicePort() int32 {
 	return 0
 }
 
+func (x *RoutePath) GetRoutePathType() string {
+	if x != nil {
+		return x.RoutePathType
+	}
+	return ""
+}
+
+func (x *RoutePath) GetRoutePathHost() string {
+	if x != nil {
+		return x.RoutePathHost
+	}
+	return ""
+}
+
+func (x *RoutePath) GetRouteHeaderMatches() string {
+	if x != nil {
+		return x.RouteHeaderMatches
+	}
+	return ""
+}
+
+func (x *RoutePath) GetRouteBackendWeight() int32 {
+	if x != nil {
+		return x.RouteBackendWeight
+	}
+	return 0
+}
+
+func (x *RoutePath) GetRouteExtraBackends() string {
+	if x != nil {
+		return x.RouteExtraBackends
+	}
+	return ""
+}
+
+func (x *RoutePath) GetRouteMirrorService() string {
+	if x != nil {
+		return x.RouteMirrorService
+	}
+	return ""
+}
+
+func (x *RoutePath) GetRouteMirrorServicePort() int32 {
+	if x != nil {
+		return x.RouteMirrorServicePort
+	}
+	return 0
+}
+
 // RouteID 路由ID
 type RouteID struct {
 	state         protoimpl.MessageState
@@ -228,6 +537,62 @@ func (x *RouteID) GetId() int64 {
 	return 0
 }
 
+// 根据命名空间和名称查找
+type RouteNamespaceName struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name      string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *RouteNamespaceName) Reset() {
+	*x = RouteNamespaceName{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_route_route_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RouteNamespaceName) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RouteNamespaceName) ProtoMessage() {}
+
+func (x *RouteNamespaceName) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_route_route_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RouteNamespaceName.ProtoReflect.Descriptor instead.
+func (*RouteNamespaceName) Descriptor() ([]byte, []int) {
+	return file_proto_route_route_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RouteNamespaceName) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *RouteNamespaceName) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
 // Response 回应
 type Response struct {
 	state         protoimpl.MessageState
@@ -240,7 +605,7 @@ type Response struct {
 func (x *Response) Reset() {
 	*x = Response{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_route_route_proto_msgTypes[3]
+		mi := &file_proto_route_route_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -253,7 +618,7 @@ func (x *Response) String() string {
 func (*Response) ProtoMessage() {}
 
 func (x *Response) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_route_route_proto_msgTypes[3]
+	mi := &file_proto_route_route_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -266,7 +631,7 @@ func (x *Response) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Response.ProtoReflect.Descriptor instead.
 func (*Response) Descriptor() ([]byte, []int) {
-	return file_proto_route_route_proto_rawDescGZIP(), []int{3}
+	return file_proto_route_route_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *Response) GetMsg() string {
@@ -288,7 +653,7 @@ type AllRoute struct {
 func (x *AllRoute) Reset() {
 	*x = AllRoute{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_route_route_proto_msgTypes[4]
+		mi := &file_proto_route_route_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -301,7 +666,7 @@ func (x *AllRoute) String() string {
 func (*AllRoute) ProtoMessage() {}
 
 func (x *AllRoute) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_route_route_proto_msgTypes[4]
+	mi := &file_proto_route_route_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -314,7 +679,7 @@ func (x *AllRoute) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AllRoute.ProtoReflect.Descriptor instead.
 func (*AllRoute) Descriptor() ([]byte, []int) {
-	return file_proto_route_route_proto_rawDescGZIP(), []int{4}
+	return file_proto_route_route_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *AllRoute) GetRouteInfo() []*RouteInfo {
@@ -333,7 +698,7 @@ type FindAll struct {
 func (x *FindAll) Reset() {
 	*x = FindAll{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_route_route_proto_msgTypes[5]
+		mi := &file_proto_route_route_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -346,7 +711,7 @@ func (x *FindAll) String() string {
 func (*FindAll) ProtoMessage() {}
 
 func (x *FindAll) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_route_route_proto_msgTypes[5]
+	mi := &file_proto_route_route_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -359,7 +724,55 @@ func (x *FindAll) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FindAll.ProtoReflect.Descriptor instead.
 func (*FindAll) Descriptor() ([]byte, []int) {
-	return file_proto_route_route_proto_rawDescGZIP(), []int{5}
+	return file_proto_route_route_proto_rawDescGZIP(), []int{6}
+}
+
+// 导出的k8s对象
+type ExportResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Yaml string `protobuf:"bytes,1,opt,name=yaml,proto3" json:"yaml,omitempty"`
+}
+
+func (x *ExportResult) Reset() {
+	*x = ExportResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_route_route_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportResult) ProtoMessage() {}
+
+func (x *ExportResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_route_route_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportResult.ProtoReflect.Descriptor instead.
+func (*ExportResult) Descriptor() ([]byte, []int) {
+	return file_proto_route_route_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ExportResult) GetYaml() string {
+	if x != nil {
+		return x.Yaml
+	}
+	return ""
 }
 
 var File_proto_route_route_proto protoreflect.FileDescriptor
@@ -367,7 +780,7 @@ var File_proto_route_route_proto protoreflect.FileDescriptor
 var file_proto_route_route_proto_rawDesc = []byte{
 	0x0a, 0x17, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2f, 0x72, 0x6f,
 	0x75, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65,
-	0x22, 0xb3, 0x01, 0x0a, 0x09, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e,
+	0x22, 0xb7, 0x0c, 0x0a, 0x09, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e,
 	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d,
 	0x0a, 0x0a, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x09, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x27, 0x0a,
@@ -378,44 +791,172 @@ var file_proto_route_route_proto_rawDesc = []byte{
 	0x65, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x0a, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x70,
 	0x61, 0x74, 0x68, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x72, 0x6f, 0x75, 0x74,
 	0x65, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x50, 0x61, 0x74, 0x68, 0x52, 0x09, 0x72, 0x6f, 0x75,
-	0x74, 0x65, 0x50, 0x61, 0x74, 0x68, 0x22, 0xcf, 0x01, 0x0a, 0x09, 0x52, 0x6f, 0x75, 0x74, 0x65,
-	0x50, 0x61, 0x74, 0x68, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x64, 0x12,
-	0x26, 0x0a, 0x0f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x50,
-	0x61, 0x74, 0x68, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x72, 0x6f, 0x75, 0x74, 0x65,
-	0x5f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x42, 0x61, 0x63,
-	0x6b, 0x65, 0x6e, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3b, 0x0a, 0x1a, 0x72,
+	0x74, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x24, 0x0a, 0x0e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f,
+	0x74, 0x6c, 0x73, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x72, 0x6f, 0x75, 0x74, 0x65, 0x54, 0x6c, 0x73, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x28, 0x0a, 0x10,
+	0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x74, 0x6c, 0x73, 0x5f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x54, 0x6c, 0x73,
+	0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x37, 0x0a, 0x18, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f,
+	0x74, 0x6c, 0x73, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x73, 0x73, 0x75,
+	0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x54,
+	0x6c, 0x73, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x73, 0x73, 0x75, 0x65, 0x72, 0x12,
+	0x24, 0x0a, 0x0e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x74, 0x6c, 0x73, 0x5f, 0x63, 0x65, 0x72,
+	0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x54, 0x6c,
+	0x73, 0x43, 0x65, 0x72, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x74,
+	0x6c, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x6f,
+	0x75, 0x74, 0x65, 0x54, 0x6c, 0x73, 0x4b, 0x65, 0x79, 0x12, 0x2d, 0x0a, 0x13, 0x72, 0x6f, 0x75,
+	0x74, 0x65, 0x5f, 0x74, 0x6c, 0x73, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x54, 0x6c, 0x73,
+	0x4e, 0x6f, 0x74, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x31, 0x0a, 0x15, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x5f, 0x74, 0x6c, 0x73, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x5f, 0x64, 0x61, 0x79,
+	0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x54, 0x6c,
+	0x73, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x44, 0x61, 0x79, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x72,
+	0x6f, 0x75, 0x74, 0x65, 0x5f, 0x74, 0x6c, 0x73, 0x5f, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x54, 0x6c, 0x73,
+	0x57, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x41, 0x0a, 0x1d, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64,
+	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1a,
+	0x72, 0x6f, 0x75, 0x74, 0x65, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x42, 0x61, 0x63, 0x6b,
+	0x65, 0x6e, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4a, 0x0a, 0x22, 0x72, 0x6f,
+	0x75, 0x74, 0x65, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x62, 0x61, 0x63, 0x6b,
+	0x65, 0x6e, 0x64, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x70, 0x6f, 0x72, 0x74,
+	0x18, 0x0f, 0x20, 0x01, 0x28, 0x05, 0x52, 0x1e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x44, 0x65, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f,
+	0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x10, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x11, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x73,
+	0x73, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f,
+	0x72, 0x65, 0x77, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x11,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x77, 0x72, 0x69,
+	0x74, 0x65, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x72, 0x73, 0x18, 0x12, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x43, 0x6f, 0x72, 0x73, 0x12, 0x35, 0x0a, 0x17, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x63, 0x6f,
+	0x72, 0x73, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18,
+	0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x43, 0x6f, 0x72, 0x73,
+	0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x26, 0x0a, 0x0f, 0x72,
+	0x6f, 0x75, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x72, 0x70, 0x73, 0x18, 0x14,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x52, 0x70, 0x73, 0x12, 0x31, 0x0a, 0x15, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x70, 0x72, 0x6f,
+	0x78, 0x79, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x15, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x12, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x42, 0x6f,
+	0x64, 0x79, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x3d, 0x0a, 0x1b, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f,
+	0x70, 0x72, 0x6f, 0x78, 0x79, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x16, 0x20, 0x01, 0x28, 0x05, 0x52, 0x18, 0x72, 0x6f, 0x75,
+	0x74, 0x65, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x54, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x37, 0x0a, 0x18, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x70,
+	0x72, 0x6f, 0x78, 0x79, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x18, 0x17, 0x20, 0x01, 0x28, 0x05, 0x52, 0x15, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x50, 0x72,
+	0x6f, 0x78, 0x79, 0x52, 0x65, 0x61, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x37,
+	0x0a, 0x18, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x5f, 0x73, 0x65,
+	0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x18, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x15, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x53, 0x65, 0x6e, 0x64,
+	0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x35, 0x0a, 0x17, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x5f, 0x62, 0x61, 0x73, 0x69, 0x63, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x73, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x18, 0x19, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x42,
+	0x61, 0x73, 0x69, 0x63, 0x41, 0x75, 0x74, 0x68, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x3f,
+	0x0a, 0x1c, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x77, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73,
+	0x74, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x1a,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x19, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x57, 0x68, 0x69, 0x74, 0x65,
+	0x6c, 0x69, 0x73, 0x74, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12,
+	0x2c, 0x0a, 0x12, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x73, 0x73, 0x6c, 0x5f, 0x72, 0x65, 0x64,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x72, 0x6f, 0x75,
+	0x74, 0x65, 0x53, 0x73, 0x6c, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x12, 0x32, 0x0a,
+	0x15, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x72, 0x61, 0x77, 0x5f, 0x61, 0x6e, 0x6e, 0x6f, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x72, 0x6f,
+	0x75, 0x74, 0x65, 0x52, 0x61, 0x77, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x6e, 0x64, 0x65,
+	0x72, 0x65, 0x72, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x5f, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x12, 0x2e, 0x0a,
+	0x13, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x5f, 0x63,
+	0x6c, 0x61, 0x73, 0x73, 0x18, 0x20, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x30, 0x0a,
+	0x14, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x5f, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x21, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x72, 0x6f, 0x75,
+	0x74, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x2a, 0x0a, 0x11, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64,
+	0x5f, 0x6d, 0x73, 0x67, 0x18, 0x22, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x4d, 0x73, 0x67, 0x4a, 0x04, 0x08, 0x1d, 0x10,
+	0x1e, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x22, 0xa2, 0x04, 0x0a, 0x09, 0x52,
+	0x6f, 0x75, 0x74, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x70, 0x61, 0x74,
+	0x68, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x6f,
+	0x75, 0x74, 0x65, 0x50, 0x61, 0x74, 0x68, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x72,
 	0x6f, 0x75, 0x74, 0x65, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x5f, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x17, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x22, 0x19, 0x0a, 0x07, 0x52, 0x6f, 0x75, 0x74,
-	0x65, 0x49, 0x44, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x02, 0x69, 0x64, 0x22, 0x1c, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73,
-	0x67, 0x22, 0x3b, 0x0a, 0x08, 0x41, 0x6c, 0x6c, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x2f, 0x0a,
-	0x0a, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x10, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x09, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x09,
-	0x0a, 0x07, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x32, 0x86, 0x02, 0x0a, 0x05, 0x52, 0x6f,
-	0x75, 0x74, 0x65, 0x12, 0x2f, 0x0a, 0x08, 0x41, 0x64, 0x64, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12,
-	0x10, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x6e, 0x66,
-	0x6f, 0x1a, 0x0f, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x30, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f,
-	0x75, 0x74, 0x65, 0x12, 0x0e, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x52, 0x6f, 0x75, 0x74,
-	0x65, 0x49, 0x44, 0x1a, 0x0f, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x32, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x10, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x52, 0x6f,
-	0x75, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x0f, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x0d, 0x46, 0x69,
-	0x6e, 0x64, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x42, 0x79, 0x49, 0x44, 0x12, 0x0e, 0x2e, 0x72, 0x6f,
-	0x75, 0x74, 0x65, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x44, 0x1a, 0x10, 0x2e, 0x72, 0x6f,
-	0x75, 0x74, 0x65, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x12,
-	0x31, 0x0a, 0x0c, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12,
-	0x0e, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x1a,
-	0x0f, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x41, 0x6c, 0x6c, 0x52, 0x6f, 0x75, 0x74, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
+	0x3b, 0x0a, 0x1a, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64,
+	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x17, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e,
+	0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x26, 0x0a, 0x0f,
+	0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x50, 0x61, 0x74, 0x68,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x70, 0x61,
+	0x74, 0x68, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72,
+	0x6f, 0x75, 0x74, 0x65, 0x50, 0x61, 0x74, 0x68, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x14,
+	0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x74,
+	0x63, 0x68, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x12, 0x30,
+	0x0a, 0x14, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x5f,
+	0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12, 0x72, 0x6f,
+	0x75, 0x74, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x12, 0x30, 0x0a, 0x14, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f,
+	0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12,
+	0x72, 0x6f, 0x75, 0x74, 0x65, 0x45, 0x78, 0x74, 0x72, 0x61, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e,
+	0x64, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x6d, 0x69, 0x72, 0x72,
+	0x6f, 0x72, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x12, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x4d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x12, 0x39, 0x0a, 0x19, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x6d, 0x69,
+	0x72, 0x72, 0x6f, 0x72, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x70, 0x6f, 0x72,
+	0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x16, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x4d, 0x69,
+	0x72, 0x72, 0x6f, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x22,
+	0x19, 0x0a, 0x07, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x44, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x46, 0x0a, 0x12, 0x52, 0x6f,
+	0x75, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x22, 0x1c, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10,
+	0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67,
+	0x22, 0x3b, 0x0a, 0x08, 0x41, 0x6c, 0x6c, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x2f, 0x0a, 0x0a,
+	0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x09, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x09, 0x0a,
+	0x07, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x22, 0x22, 0x0a, 0x0c, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x79, 0x61, 0x6d, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x79, 0x61, 0x6d, 0x6c, 0x32, 0xc3, 0x03, 0x0a,
+	0x05, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x2f, 0x0a, 0x08, 0x41, 0x64, 0x64, 0x52, 0x6f, 0x75,
+	0x74, 0x65, 0x12, 0x10, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65,
+	0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x0f, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x30, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x0e, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x52,
+	0x6f, 0x75, 0x74, 0x65, 0x49, 0x44, 0x1a, 0x0f, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x32, 0x0a, 0x0b, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x10, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x0f, 0x2e, 0x72, 0x6f, 0x75,
+	0x74, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x33, 0x0a,
+	0x0d, 0x46, 0x69, 0x6e, 0x64, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x42, 0x79, 0x49, 0x44, 0x12, 0x0e,
+	0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x44, 0x1a, 0x10,
+	0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f,
+	0x22, 0x00, 0x12, 0x4c, 0x0a, 0x1b, 0x46, 0x69, 0x6e, 0x64, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x42,
+	0x79, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x41, 0x6e, 0x64, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x19, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x1a, 0x10, 0x2e, 0x72,
+	0x6f, 0x75, 0x74, 0x65, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00,
+	0x12, 0x31, 0x0a, 0x0c, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x52, 0x6f, 0x75, 0x74, 0x65,
+	0x12, 0x0e, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c,
+	0x1a, 0x0f, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x41, 0x6c, 0x6c, 0x52, 0x6f, 0x75, 0x74,
+	0x65, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x12, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x78, 0x70, 0x69, 0x72,
+	0x69, 0x6e, 0x67, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x12, 0x0e, 0x2e, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x1a, 0x0f, 0x2e, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x2e, 0x41, 0x6c, 0x6c, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x0b,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x0e, 0x2e, 0x72, 0x6f,
+	0x75, 0x74, 0x65, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x44, 0x1a, 0x13, 0x2e, 0x72, 0x6f,
+	0x75, 0x74, 0x65, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
 	0x22, 0x00, 0x42, 0x15, 0x5a, 0x13, 0x2e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x72, 0x6f,
 	0x75, 0x74, 0x65, 0x3b, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x33,
@@ -433,33 +974,41 @@ func file_proto_route_route_proto_rawDescGZIP() []byte {
 	return file_proto_route_route_proto_rawDescData
 }
 
-var file_proto_route_route_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_proto_route_route_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_proto_route_route_proto_goTypes = []interface{}{
-	(*RouteInfo)(nil), // 0: route.RouteInfo
-	(*RoutePath)(nil), // 1: route.RoutePath
-	(*RouteID)(nil),   // 2: route.RouteID
-	(*Response)(nil),  // 3: route.Response
-	(*AllRoute)(nil),  // 4: route.AllRoute
-	(*FindAll)(nil),   // 5: route.FindAll
+	(*RouteInfo)(nil),          // 0: route.RouteInfo
+	(*RoutePath)(nil),          // 1: route.RoutePath
+	(*RouteID)(nil),            // 2: route.RouteID
+	(*RouteNamespaceName)(nil), // 3: route.RouteNamespaceName
+	(*Response)(nil),           // 4: route.Response
+	(*AllRoute)(nil),           // 5: route.AllRoute
+	(*FindAll)(nil),            // 6: route.FindAll
+	(*ExportResult)(nil),       // 7: route.ExportResult
 }
 var file_proto_route_route_proto_depIdxs = []int32{
-	1, // 0: route.RouteInfo.route_path:type_name -> route.RoutePath
-	0, // 1: route.AllRoute.route_info:type_name -> route.RouteInfo
-	0, // 2: route.Route.AddRoute:input_type -> route.RouteInfo
-	2, // 3: route.Route.DeleteRoute:input_type -> route.RouteID
-	0, // 4: route.Route.UpdateRoute:input_type -> route.RouteInfo
-	2, // 5: route.Route.FindRouteByID:input_type -> route.RouteID
-	5, // 6: route.Route.FindAllRoute:input_type -> route.FindAll
-	3, // 7: route.Route.AddRoute:output_type -> route.Response
-	3, // 8: route.Route.DeleteRoute:output_type -> route.Response
-	3, // 9: route.Route.UpdateRoute:output_type -> route.Response
-	0, // 10: route.Route.FindRouteByID:output_type -> route.RouteInfo
-	4, // 11: route.Route.FindAllRoute:output_type -> route.AllRoute
-	7, // [7:12] is the sub-list for method output_type
-	2, // [2:7] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	1,  // 0: route.RouteInfo.route_path:type_name -> route.RoutePath
+	0,  // 1: route.AllRoute.route_info:type_name -> route.RouteInfo
+	0,  // 2: route.Route.AddRoute:input_type -> route.RouteInfo
+	2,  // 3: route.Route.DeleteRoute:input_type -> route.RouteID
+	0,  // 4: route.Route.UpdateRoute:input_type -> route.RouteInfo
+	2,  // 5: route.Route.FindRouteByID:input_type -> route.RouteID
+	3,  // 6: route.Route.FindRouteByNamespaceAndName:input_type -> route.RouteNamespaceName
+	6,  // 7: route.Route.FindAllRoute:input_type -> route.FindAll
+	6,  // 8: route.Route.FindExpiringRoutes:input_type -> route.FindAll
+	2,  // 9: route.Route.ExportRoute:input_type -> route.RouteID
+	4,  // 10: route.Route.AddRoute:output_type -> route.Response
+	4,  // 11: route.Route.DeleteRoute:output_type -> route.Response
+	4,  // 12: route.Route.UpdateRoute:output_type -> route.Response
+	0,  // 13: route.Route.FindRouteByID:output_type -> route.RouteInfo
+	0,  // 14: route.Route.FindRouteByNamespaceAndName:output_type -> route.RouteInfo
+	5,  // 15: route.Route.FindAllRoute:output_type -> route.AllRoute
+	5,  // 16: route.Route.FindExpiringRoutes:output_type -> route.AllRoute
+	7,  // 17: route.Route.ExportRoute:output_type -> route.ExportResult
+	10, // [10:18] is the sub-list for method output_type
+	2,  // [2:10] is the sub-list for method input_type
+	2,  // [2:2] is the sub-list for extension type_name
+	2,  // [2:2] is the sub-list for extension extendee
+	0,  // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_proto_route_route_proto_init() }
@@ -505,7 +1054,7 @@ func file_proto_route_route_proto_init() {
 			}
 		}
 		file_proto_route_route_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Response); i {
+			switch v := v.(*RouteNamespaceName); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -517,7 +1066,7 @@ func file_proto_route_route_proto_init() {
 			}
 		}
 		file_proto_route_route_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AllRoute); i {
+			switch v := v.(*Response); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -529,6 +1078,18 @@ func file_proto_route_route_proto_init() {
 			}
 		}
 		file_proto_route_route_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AllRoute); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_route_route_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FindAll); i {
 			case 0:
 				return &v.state
@@ -540,6 +1101,18 @@ func file_proto_route_route_proto_init() {
 				return nil
 			}
 		}
+		file_proto_route_route_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -547,7 +1120,7 @@ func file_proto_route_route_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_route_route_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -40,7 +40,11 @@ type RouteService interface {
 	DeleteRoute(ctx context.Context, in *RouteID, opts ...client.CallOption) (*Response, error)
 	UpdateRoute(ctx context.Context, in *RouteInfo, opts ...client.CallOption) (*Response, error)
 	FindRouteByID(ctx context.Context, in *RouteID, opts ...client.CallOption) (*RouteInfo, error)
+	FindRouteByNamespaceAndName(ctx context.Context, in *RouteNamespaceName, opts ...client.CallOption) (*RouteInfo, error)
 	FindAllRoute(ctx context.Context, in *FindAll, opts ...client.CallOption) (*AllRoute, error)
+	FindExpiringRoutes(ctx context.Context, in *FindAll, opts ...client.CallOption) (*AllRoute, error)
+	// 导出应用到k8s的对象，多文档YAML
+	ExportRoute(ctx context.Context, in *RouteID, opts ...client.CallOption) (*ExportResult, error)
 }
 
 type routeService struct {
@@ -95,6 +99,16 @@ func (c *routeService) FindRouteByID(ctx context.Context, in *RouteID, opts ...c
 	return out, nil
 }
 
+func (c *routeService) FindRouteByNamespaceAndName(ctx context.Context, in *RouteNamespaceName, opts ...client.CallOption) (*RouteInfo, error) {
+	req := c.c.NewRequest(c.name, "Route.FindRouteByNamespaceAndName", in)
+	out := new(RouteInfo)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *routeService) FindAllRoute(ctx context.Context, in *FindAll, opts ...client.CallOption) (*AllRoute, error) {
 	req := c.c.NewRequest(c.name, "Route.FindAllRoute", in)
 	out := new(AllRoute)
@@ -105,6 +119,26 @@ func (c *routeService) FindAllRoute(ctx context.Context, in *FindAll, opts ...cl
 	return out, nil
 }
 
+func (c *routeService) FindExpiringRoutes(ctx context.Context, in *FindAll, opts ...client.CallOption) (*AllRoute, error) {
+	req := c.c.NewRequest(c.name, "Route.FindExpiringRoutes", in)
+	out := new(AllRoute)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routeService) ExportRoute(ctx context.Context, in *RouteID, opts ...client.CallOption) (*ExportResult, error) {
+	req := c.c.NewRequest(c.name, "Route.ExportRoute", in)
+	out := new(ExportResult)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for Route service
 
 type RouteHandler interface {
@@ -112,7 +146,11 @@ type RouteHandler interface {
 	DeleteRoute(context.Context, *RouteID, *Response) error
 	UpdateRoute(context.Context, *RouteInfo, *Response) error
 	FindRouteByID(context.Context, *RouteID, *RouteInfo) error
+	FindRouteByNamespaceAndName(context.Context, *RouteNamespaceName, *RouteInfo) error
 	FindAllRoute(context.Context, *FindAll, *AllRoute) error
+	FindExpiringRoutes(context.Context, *FindAll, *AllRoute) error
+	// 导出应用到k8s的对象，多文档YAML
+	ExportRoute(context.Context, *RouteID, *ExportResult) error
 }
 
 func RegisterRouteHandler(s server.Server, hdlr RouteHandler, opts ...server.HandlerOption) error {
@@ -121,7 +159,10 @@ func RegisterRouteHandler(s server.Server, hdlr RouteHandler, opts ...server.Han
 		DeleteRoute(ctx context.Context, in *RouteID, out *Response) error
 		UpdateRoute(ctx context.Context, in *RouteInfo, out *Response) error
 		FindRouteByID(ctx context.Context, in *RouteID, out *RouteInfo) error
+		FindRouteByNamespaceAndName(ctx context.Context, in *RouteNamespaceName, out *RouteInfo) error
 		FindAllRoute(ctx context.Context, in *FindAll, out *AllRoute) error
+		FindExpiringRoutes(ctx context.Context, in *FindAll, out *AllRoute) error
+		ExportRoute(ctx context.Context, in *RouteID, out *ExportResult) error
 	}
 	type Route struct {
 		route
@@ -150,6 +191,18 @@ func (h *routeHandler) FindRouteByID(ctx context.Context, in *RouteID, out *Rout
 	return h.RouteHandler.FindRouteByID(ctx, in, out)
 }
 
+func (h *routeHandler) FindRouteByNamespaceAndName(ctx context.Context, in *RouteNamespaceName, out *RouteInfo) error {
+	return h.RouteHandler.FindRouteByNamespaceAndName(ctx, in, out)
+}
+
 func (h *routeHandler) FindAllRoute(ctx context.Context, in *FindAll, out *AllRoute) error {
 	return h.RouteHandler.FindAllRoute(ctx, in, out)
 }
+
+func (h *routeHandler) FindExpiringRoutes(ctx context.Context, in *FindAll, out *AllRoute) error {
+	return h.RouteHandler.FindExpiringRoutes(ctx, in, out)
+}
+
+func (h *routeHandler) ExportRoute(ctx context.Context, in *RouteID, out *ExportResult) error {
+	return h.RouteHandler.ExportRoute(ctx, in, out)
+}
@@ -11,6 +11,9 @@ type RouteRepository interface {
 	// InitTable 初始化表
 	InitTable() error
 
+	// MigrateTable 迁移已有数据表
+	MigrateTable() error
+
 	// CreateRoute 创建Route
 	CreateRoute(*model.Route) (int64, error)
 
@@ -23,8 +26,14 @@ type RouteRepository interface {
 	// FindRouteByID 查找Route
 	FindRouteByID(int64) (*model.Route, error)
 
+	// FindRouteByNamespaceAndName 根据命名空间和名称查找Route
+	FindRouteByNamespaceAndName(string, string) (*model.Route, error)
+
 	// FindAll 查找所有Route
 	FindAll() ([]model.Route, error)
+
+	// UpdateBackendStatus 更新后端服务的检查结果
+	UpdateBackendStatus(*model.Route) error
 }
 
 // NewRouteRepository 创建Route对象
@@ -44,6 +53,15 @@ func (r *Route) InitTable() error {
 	return r.db.CreateTable(&model.Route{}, &model.RoutePath{}).Error
 }
 
+// MigrateTable 迁移已有数据表，名称唯一改为同一命名空间内唯一，并补充证书、注解、生成方式和后端状态等新增的字段
+func (r *Route) MigrateTable() error {
+	err := common.MigrateUniqueIndex(r.db, &model.Route{}, "", "idx_route_namespace_name", "route_namespace", "route_name")
+	if err != nil {
+		return err
+	}
+	return common.MigrateTables(r.db, &model.Route{}, &model.RoutePath{})
+}
+
 // CreateRoute 创建Route
 func (r *Route) CreateRoute(route *model.Route) (int64, error) {
 	return route.ID, r.db.Create(route).Error
@@ -84,8 +102,9 @@ func (r *Route) DeleteRouteByID(i int64) error {
 }
 
 // UpdateRoute 更新Route
+// Save 会更新所有字段，生成方式和 GatewayClass 等允许改为空
 func (r *Route) UpdateRoute(route *model.Route) error {
-	return r.db.Model(route).Update(route).Error
+	return r.db.Save(route).Error
 }
 
 // FindRouteByID 查找Route
@@ -94,8 +113,22 @@ func (r *Route) FindRouteByID(i int64) (*model.Route, error) {
 	return route, r.db.Preload("RoutePath").First(route, i).Error
 }
 
+// FindRouteByNamespaceAndName 根据命名空间和名称查找Route
+func (r *Route) FindRouteByNamespaceAndName(namespace, name string) (*model.Route, error) {
+	route := &model.Route{}
+	return route, r.db.Preload("RoutePath").Where("route_namespace = ? AND route_name = ?", namespace, name).First(route).Error
+}
+
 // FindAll 查找所有Route
 func (r *Route) FindAll() ([]model.Route, error) {
 	var routeAll []model.Route
 	return routeAll, r.db.Preload("RoutePath").Find(&routeAll).Error
 }
+
+// UpdateBackendStatus 更新后端服务的检查结果，检查通过时清空信息
+func (r *Route) UpdateBackendStatus(route *model.Route) error {
+	return r.db.Model(route).Updates(map[string]interface{}{
+		"route_backend_status": route.RouteBackendStatus,
+		"route_backend_msg":    route.RouteBackendMsg,
+	}).Error
+}
@@ -0,0 +1,174 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	v14 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"net"
+	"strconv"
+	"strings"
+	"tini-paas/internal/route/proto/route"
+)
+
+// defaultIngressClass 没有指定时使用 ingress-nginx
+const defaultIngressClass = "nginx"
+
+// nginxAnnotationPrefix ingress-nginx 注解的前缀
+const nginxAnnotationPrefix = "nginx.ingress.kubernetes.io/"
+
+// basicAuthKey 基本认证secret中htpasswd内容的字段
+const basicAuthKey = "auth"
+
+// getIngressClass ingress类，未设置时为 nginx
+func getIngressClass(className string) string {
+	if className == "" {
+		return defaultIngressClass
+	}
+	return className
+}
+
+// getNginxAnnotations 将路由选项转换为 ingress-nginx 的注解，原始注解最后写入，可以覆盖前面的选项
+func getNginxAnnotations(info *route.RouteInfo) map[string]string {
+	annotations := map[string]string{}
+	set := func(key, value string) {
+		annotations[nginxAnnotationPrefix+key] = value
+	}
+	setSeconds := func(key string, value int32) {
+		if value > 0 {
+			set(key, strconv.Itoa(int(value)))
+		}
+	}
+
+	if info.RouteRewriteTarget != "" {
+		set("rewrite-target", info.RouteRewriteTarget)
+		// 改写目标引用了捕获组时，路径按照正则表达式处理
+		if strings.Contains(info.RouteRewriteTarget, "$") {
+			set("use-regex", "true")
+		}
+	}
+	if info.RouteEnableCors {
+		set("enable-cors", "true")
+		if info.RouteCorsAllowOrigin != "" {
+			set("cors-allow-origin", info.RouteCorsAllowOrigin)
+		}
+	}
+	if info.RouteLimitRps > 0 {
+		set("limit-rps", strconv.Itoa(int(info.RouteLimitRps)))
+	}
+	if info.RouteProxyBodySize != "" {
+		set("proxy-body-size", info.RouteProxyBodySize)
+	}
+	setSeconds("proxy-connect-timeout", info.RouteProxyConnectTimeout)
+	setSeconds("proxy-read-timeout", info.RouteProxyReadTimeout)
+	setSeconds("proxy-send-timeout", info.RouteProxySendTimeout)
+	if info.RouteBasicAuthSecret != "" {
+		set("auth-type", "basic")
+		set("auth-secret", info.RouteBasicAuthSecret)
+		set("auth-realm", "Authentication Required")
+	}
+	if info.RouteWhitelistSourceRange != "" {
+		set("whitelist-source-range", strings.Join(splitList(info.RouteWhitelistSourceRange), ","))
+	}
+	if info.RouteSslRedirect != "" {
+		set("ssl-redirect", info.RouteSslRedirect)
+	}
+
+	// 检查时已经确认格式正确
+	raw, _ := parseRawAnnotations(info.RouteRawAnnotations)
+	for key, value := range raw {
+		annotations[key] = value
+	}
+	return annotations
+}
+
+// checkAnnotations 检查路由选项的取值
+func checkAnnotations(info *route.RouteInfo) error {
+	if info.RouteLimitRps < 0 {
+		return errors.New("每秒请求数不能小于0")
+	}
+	if info.RouteProxyConnectTimeout < 0 || info.RouteProxyReadTimeout < 0 || info.RouteProxySendTimeout < 0 {
+		return errors.New("超时时间不能小于0")
+	}
+	if info.RouteProxyBodySize != "" {
+		// ingress-nginx 使用nginx的大小格式，如 8m、1g，0表示不限制
+		if _, err := resource.ParseQuantity(strings.ToUpper(info.RouteProxyBodySize)); err != nil {
+			return errors.New("请求体大小 " + info.RouteProxyBodySize + " 格式错误，如 10m")
+		}
+	}
+	for _, cidr := range splitList(info.RouteWhitelistSourceRange) {
+		if _, _, err := net.ParseCIDR(cidr); err != nil {
+			return errors.New("网段 " + cidr + " 格式错误")
+		}
+	}
+	switch info.RouteSslRedirect {
+	case "", "true", "false":
+	default:
+		return errors.New("ssl重定向只能是 true 或者 false")
+	}
+	if info.RouteCorsAllowOrigin != "" && !info.RouteEnableCors {
+		return errors.New("设置跨域来源需要开启跨域")
+	}
+
+	raw, err := parseRawAnnotations(info.RouteRawAnnotations)
+	if err != nil {
+		return err
+	}
+	for key := range raw {
+		if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+			return errors.New("注解 " + key + " 格式错误：" + strings.Join(errs, "; "))
+		}
+	}
+	return nil
+}
+
+// parseRawAnnotations 解析JSON格式的原始注解
+func parseRawAnnotations(raw string) (map[string]string, error) {
+	annotations := map[string]string{}
+	if raw == "" {
+		return annotations, nil
+	}
+	err := json.Unmarshal([]byte(raw), &annotations)
+	if err != nil {
+		return nil, errors.New("原始注解需要是字符串的JSON对象：" + err.Error())
+	}
+	return annotations, nil
+}
+
+// checkIngressClass 检查ingress类在集群中存在
+func (r *RouteDataService) checkIngressClass(className string) error {
+	_, err := r.K8sClientSet.NetworkingV1().IngressClasses().Get(context.TODO(), className, v14.GetOptions{})
+	if !k8serrors.IsNotFound(err) {
+		return err
+	}
+	list, err := r.K8sClientSet.NetworkingV1().IngressClasses().List(context.TODO(), v14.ListOptions{})
+	if err != nil {
+		return err
+	}
+	var names []string
+	for _, class := range list.Items {
+		names = append(names, class.Name)
+	}
+	return errors.New("ingress类 " + className + " 不存在，可用的ingress类：" + strings.Join(names, ", "))
+}
+
+// checkBasicAuthSecret 检查基本认证的secret存在并且包含auth字段
+func (r *RouteDataService) checkBasicAuthSecret(info *route.RouteInfo) error {
+	if info.RouteBasicAuthSecret == "" {
+		return nil
+	}
+	secret, err := r.K8sClientSet.CoreV1().Secrets(info.RouteNamespace).Get(context.TODO(), info.RouteBasicAuthSecret, v14.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		return errors.New("基本认证的secret " + info.RouteBasicAuthSecret + " 不存在")
+	}
+	if err != nil {
+		return err
+	}
+	if len(secret.Data[basicAuthKey]) == 0 {
+		return errors.New("secret " + info.RouteBasicAuthSecret + " 中没有htpasswd格式的 " + basicAuthKey + " 字段")
+	}
+	return nil
+}
@@ -0,0 +1,164 @@
+package service
+
+import (
+	"context"
+	"errors"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	v14 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"strconv"
+	"strings"
+	"time"
+	"tini-paas/internal/route/model"
+	"tini-paas/internal/route/proto/route"
+	"tini-paas/pkg/common"
+)
+
+// 后端服务的检查结果
+const (
+	BackendOk      = "Ok"
+	BackendMissing = "Missing"
+)
+
+// BackendError 路由引用的后端服务不可用的原因
+type BackendError struct {
+	// Path 引用服务的路径，默认服务为空
+	Path string `json:"path"`
+
+	// Service 服务名称
+	Service string `json:"service"`
+
+	// Port 引用的端口
+	Port int32 `json:"port"`
+
+	// Reason 不可用的原因
+	Reason string `json:"reason"`
+}
+
+// backendRef 路由引用的一个服务
+type backendRef struct {
+	path    string
+	service string
+	port    int32
+}
+
+// getBackendRefs 路由引用的全部服务，包括分流服务、镜像服务和默认服务
+func getBackendRefs(info *route.RouteInfo) []backendRef {
+	var refs []backendRef
+	for _, path := range info.RoutePath {
+		refs = append(refs, backendRef{path.RoutePathName, path.RouteBackendService, path.RouteBackendServicePort})
+		for _, backend := range splitList(path.RouteExtraBackends) {
+			parts := strings.Split(backend, ":")
+			if len(parts) != 3 {
+				continue
+			}
+			port, _ := strconv.ParseInt(parts[1], 10, 32)
+			refs = append(refs, backendRef{path.RoutePathName, parts[0], int32(port)})
+		}
+		if path.RouteMirrorService != "" {
+			refs = append(refs, backendRef{path.RoutePathName, path.RouteMirrorService, path.RouteMirrorServicePort})
+		}
+	}
+	if info.RouteDefaultBackendService != "" {
+		refs = append(refs, backendRef{"", info.RouteDefaultBackendService, info.RouteDefaultBackendServicePort})
+	}
+	return refs
+}
+
+// CheckBackends 检查路由引用的服务在同一命名空间中存在并且暴露了引用的端口
+// 返回全部不可用的服务，k8s访问失败时返回错误
+func (r *RouteDataService) CheckBackends(info *route.RouteInfo) ([]BackendError, error) {
+	var problems []BackendError
+	checked := map[string]bool{}
+	for _, ref := range getBackendRefs(info) {
+		key := ref.service + ":" + strconv.Itoa(int(ref.port))
+		if checked[key] {
+			continue
+		}
+		checked[key] = true
+
+		reason, err := r.checkBackend(info.RouteNamespace, ref.service, ref.port)
+		if err != nil {
+			return nil, err
+		}
+		if reason != "" {
+			problems = append(problems, BackendError{
+				Path:    ref.path,
+				Service: ref.service,
+				Port:    ref.port,
+				Reason:  reason,
+			})
+		}
+	}
+	return problems, nil
+}
+
+// checkBackend 检查一个服务，返回不可用的原因，可用时为空
+func (r *RouteDataService) checkBackend(namespace, name string, port int32) (string, error) {
+	service, err := r.K8sClientSet.CoreV1().Services(namespace).Get(context.TODO(), name, v14.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		return "服务 " + namespace + "/" + name + " 不存在", nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	var ports []string
+	for _, servicePort := range service.Spec.Ports {
+		if servicePort.Port == port {
+			return "", nil
+		}
+		ports = append(ports, strconv.Itoa(int(servicePort.Port)))
+	}
+	if len(ports) == 0 {
+		return "服务 " + name + " 没有暴露任何端口", nil
+	}
+	return "服务 " + name + " 没有暴露端口 " + strconv.Itoa(int(port)) + "，可用端口：" + strings.Join(ports, ", "), nil
+}
+
+// RunBackendChecker 定期检查全部路由的后端服务，服务被删除或者端口变化时标记路由，阻塞运行
+func (r *RouteDataService) RunBackendChecker(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for range ticker.C {
+		routes, err := r.RouteRepository.FindAll()
+		if err != nil {
+			common.Error(err)
+			continue
+		}
+		for i := range routes {
+			err = r.checkRouteBackends(&routes[i])
+			if err != nil {
+				common.Error(err)
+			}
+		}
+	}
+}
+
+// checkRouteBackends 检查一个路由的后端服务，结果变化时写入数据库
+func (r *RouteDataService) checkRouteBackends(m *model.Route) error {
+	info := &route.RouteInfo{}
+	err := common.SwapTo(m, info)
+	if err != nil {
+		return err
+	}
+	problems, err := r.CheckBackends(info)
+	if err != nil {
+		return err
+	}
+
+	status, msg := BackendOk, ""
+	if len(problems) > 0 {
+		var reasons []string
+		for _, problem := range problems {
+			reasons = append(reasons, problem.Reason)
+		}
+		status, msg = BackendMissing, strings.Join(reasons, "; ")
+		common.Error(errors.New("路由 " + m.RouteNamespace + "/" + m.RouteName + " 的后端服务不可用：" + msg))
+	}
+	if status == m.RouteBackendStatus && msg == m.RouteBackendMsg {
+		return nil
+	}
+	m.RouteBackendStatus = status
+	m.RouteBackendMsg = msg
+	return r.RouteRepository.UpdateBackendStatus(m)
+}
@@ -0,0 +1,398 @@
+package service
+
+import (
+	"context"
+	"errors"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	v14 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"os"
+	"strconv"
+	"strings"
+	"tini-paas/internal/route/model"
+	"tini-paas/internal/route/proto/route"
+	"tini-paas/pkg/common"
+)
+
+// 路由生成的k8s对象
+const (
+	RendererIngress = "ingress"
+	RendererGateway = "gateway"
+)
+
+// routeRendererEnv 集群默认生成的k8s对象，没有设置时为 ingress
+const routeRendererEnv = "PAAS_ROUTE_RENDERER"
+
+// defaultGatewayEnv gateway 模式下默认挂载的Gateway，格式为 命名空间/名称
+const defaultGatewayEnv = "PAAS_ROUTE_GATEWAY"
+
+// gatewayGroup Gateway API 的API组，需要集群安装 Gateway API 的CRD和支持的控制器
+const gatewayGroup = "gateway.networking.k8s.io"
+
+// Gateway API 不在 client-go 中，通过动态客户端操作
+var (
+	gatewayResource   = schema.GroupVersionResource{Group: gatewayGroup, Version: "v1", Resource: "gateways"}
+	httpRouteResource = schema.GroupVersionResource{Group: gatewayGroup, Version: "v1", Resource: "httproutes"}
+)
+
+// getRenderer 路由生成的k8s对象，路由没有指定时使用集群的默认值
+func getRenderer(renderer string) string {
+	if renderer == "" {
+		renderer = os.Getenv(routeRendererEnv)
+	}
+	if renderer == "" {
+		return RendererIngress
+	}
+	return renderer
+}
+
+// usesGatewayFeatures 路径是否使用了只有 Gateway API 支持的功能
+func usesGatewayFeatures(path *route.RoutePath) bool {
+	return path.RouteHeaderMatches != "" || path.RouteExtraBackends != "" || path.RouteMirrorService != ""
+}
+
+// getParentRef HTTPRoute 挂载的Gateway，单独创建Gateway时挂载到同名的Gateway
+func getParentRef(info *route.RouteInfo) (string, string, error) {
+	if info.RouteGatewayClass != "" {
+		return info.RouteNamespace, info.RouteName, nil
+	}
+	gateway := info.RouteGateway
+	if gateway == "" {
+		gateway = os.Getenv(defaultGatewayEnv)
+	}
+	if gateway == "" {
+		return "", "", errors.New("gateway 模式需要指定挂载的Gateway或者 GatewayClass")
+	}
+	parts := strings.SplitN(gateway, "/", 2)
+	if len(parts) == 1 {
+		return info.RouteNamespace, parts[0], nil
+	}
+	return parts[0], parts[1], nil
+}
+
+// checkGatewayRoute 检查 gateway 模式下的配置，ingress-nginx 的选项在这个模式下不生效
+func checkGatewayRoute(info *route.RouteInfo) error {
+	if len(getNginxAnnotations(info)) > 0 {
+		return errors.New("ingress-nginx 的路由选项和原始注解只用于 ingress 模式")
+	}
+	if info.RouteTlsMode != "" && info.RouteGatewayClass == "" {
+		return errors.New("挂载到已有Gateway时证书由Gateway配置，只有单独创建Gateway时可以设置证书")
+	}
+	if _, _, err := getParentRef(info); err != nil {
+		return err
+	}
+
+	for _, path := range info.RoutePath {
+		if path.RoutePathHost != "" {
+			return errors.New("gateway 模式下域名对整个路由生效，路径 " + path.RoutePathName + " 不能单独指定域名")
+		}
+		if getPathType(path.RoutePathType) == "ImplementationSpecific" {
+			return errors.New("gateway 模式不支持 ImplementationSpecific 匹配方式")
+		}
+		if path.RouteBackendWeight < 0 {
+			return errors.New("路径 " + path.RoutePathName + " 的权重不能小于0")
+		}
+		if _, err := getHeaderMatches(path.RouteHeaderMatches); err != nil {
+			return err
+		}
+		if _, err := getExtraBackends(path.RouteExtraBackends); err != nil {
+			return err
+		}
+		if path.RouteMirrorService != "" && path.RouteMirrorServicePort == 0 {
+			return errors.New("路径 " + path.RoutePathName + " 的镜像服务需要指定端口")
+		}
+	}
+	return nil
+}
+
+// getHeaderMatches 解析 名称=值 格式的请求头匹配
+func getHeaderMatches(matches string) ([]interface{}, error) {
+	var headers []interface{}
+	for _, match := range splitList(matches) {
+		parts := strings.SplitN(match, "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, errors.New("请求头匹配 " + match + " 格式错误，需要 名称=值")
+		}
+		if errs := validation.IsHTTPHeaderName(parts[0]); len(errs) > 0 {
+			return nil, errors.New("请求头 " + parts[0] + " 格式错误：" + strings.Join(errs, "; "))
+		}
+		headers = append(headers, map[string]interface{}{
+			"type":  "Exact",
+			"name":  parts[0],
+			"value": parts[1],
+		})
+	}
+	return headers, nil
+}
+
+// getExtraBackends 解析 服务:端口:权重 格式的分流服务
+func getExtraBackends(backends string) ([]interface{}, error) {
+	var refs []interface{}
+	for _, backend := range splitList(backends) {
+		parts := strings.Split(backend, ":")
+		if len(parts) != 3 || parts[0] == "" {
+			return nil, errors.New("分流服务 " + backend + " 格式错误，需要 服务:端口:权重")
+		}
+		port, err := strconv.ParseInt(parts[1], 10, 32)
+		if err != nil || port < 1 || port > 65535 {
+			return nil, errors.New("分流服务 " + backend + " 的端口错误")
+		}
+		weight, err := strconv.ParseInt(parts[2], 10, 32)
+		if err != nil || weight < 0 {
+			return nil, errors.New("分流服务 " + backend + " 的权重错误")
+		}
+		refs = append(refs, getBackendRef(parts[0], int32(port), int32(weight)))
+	}
+	return refs, nil
+}
+
+// getBackendRef 转发到服务的 backendRef，weight为0时不设置，使用默认权重1
+func getBackendRef(service string, port, weight int32) map[string]interface{} {
+	ref := map[string]interface{}{
+		"name": service,
+		"port": int64(port),
+	}
+	if weight > 0 {
+		ref["weight"] = int64(weight)
+	}
+	return ref
+}
+
+// getGatewayPathType 将Ingress的路径匹配方式转换为 Gateway API 的匹配方式
+func getGatewayPathType(pathType string) string {
+	if getPathType(pathType) == "Exact" {
+		return "Exact"
+	}
+	return "PathPrefix"
+}
+
+// setHTTPRoute 封装HTTPRoute，每个路径一条规则，默认服务作为没有匹配条件的规则
+func setHTTPRoute(info *route.RouteInfo) (map[string]interface{}, error) {
+	parentNamespace, parentName, err := getParentRef(info)
+	if err != nil {
+		return nil, err
+	}
+
+	var rules []interface{}
+	for _, path := range info.RoutePath {
+		match := map[string]interface{}{
+			"path": map[string]interface{}{
+				"type":  getGatewayPathType(path.RoutePathType),
+				"value": path.RoutePathName,
+			},
+		}
+		headers, err := getHeaderMatches(path.RouteHeaderMatches)
+		if err != nil {
+			return nil, err
+		}
+		if len(headers) > 0 {
+			match["headers"] = headers
+		}
+
+		backendRefs := []interface{}{getBackendRef(path.RouteBackendService, path.RouteBackendServicePort, path.RouteBackendWeight)}
+		extra, err := getExtraBackends(path.RouteExtraBackends)
+		if err != nil {
+			return nil, err
+		}
+		backendRefs = append(backendRefs, extra...)
+
+		rule := map[string]interface{}{
+			"matches":     []interface{}{match},
+			"backendRefs": backendRefs,
+		}
+		if path.RouteMirrorService != "" {
+			rule["filters"] = []interface{}{
+				map[string]interface{}{
+					"type": "RequestMirror",
+					"requestMirror": map[string]interface{}{
+						"backendRef": getBackendRef(path.RouteMirrorService, path.RouteMirrorServicePort, 0),
+					},
+				},
+			}
+		}
+		rules = append(rules, rule)
+	}
+	// 没有匹配条件的规则匹配 PathPrefix /，优先级最低
+	if info.RouteDefaultBackendService != "" {
+		rules = append(rules, map[string]interface{}{
+			"backendRefs": []interface{}{getBackendRef(info.RouteDefaultBackendService, info.RouteDefaultBackendServicePort, 0)},
+		})
+	}
+
+	spec := map[string]interface{}{
+		"parentRefs": []interface{}{
+			map[string]interface{}{
+				"name":      parentName,
+				"namespace": parentNamespace,
+			},
+		},
+		"rules": rules,
+	}
+	if hosts := getHosts(info.RouteHost); len(hosts) > 0 {
+		spec["hostnames"] = hosts
+	}
+	return map[string]interface{}{
+		"apiVersion": gatewayGroup + "/v1",
+		"kind":       "HTTPRoute",
+		"metadata":   getGatewayMetadata(info),
+		"spec":       spec,
+	}, nil
+}
+
+// setGateway 封装路由单独使用的Gateway，每个域名一个http监听，启用https时再加一个https监听
+func setGateway(info *route.RouteInfo) map[string]interface{} {
+	hosts := getHosts(info.RouteHost)
+	if len(hosts) == 0 {
+		hosts = []string{""}
+	}
+
+	var listeners []interface{}
+	for i, host := range hosts {
+		listener := map[string]interface{}{
+			"name":     "http-" + strconv.Itoa(i),
+			"port":     int64(80),
+			"protocol": "HTTP",
+			"allowedRoutes": map[string]interface{}{
+				"namespaces": map[string]interface{}{"from": "Same"},
+			},
+		}
+		if host != "" {
+			listener["hostname"] = host
+		}
+		listeners = append(listeners, listener)
+
+		if info.RouteTlsMode == "" {
+			continue
+		}
+		tlsListener := map[string]interface{}{
+			"name":     "https-" + strconv.Itoa(i),
+			"port":     int64(443),
+			"protocol": "HTTPS",
+			"tls": map[string]interface{}{
+				"mode": "Terminate",
+				"certificateRefs": []interface{}{
+					map[string]interface{}{"name": tlsSecretName(info.RouteName, info.RouteTlsSecret)},
+				},
+			},
+			"allowedRoutes": listener["allowedRoutes"],
+		}
+		if host != "" {
+			tlsListener["hostname"] = host
+		}
+		listeners = append(listeners, tlsListener)
+	}
+
+	metadata := getGatewayMetadata(info)
+	// cert-manager 的 gateway-shim 同样根据这个注解签发证书
+	if info.RouteTlsMode == TLSModeCertManager {
+		metadata["annotations"] = map[string]interface{}{
+			clusterIssuerAnnotation: info.RouteTlsClusterIssuer,
+		}
+	}
+	return map[string]interface{}{
+		"apiVersion": gatewayGroup + "/v1",
+		"kind":       "Gateway",
+		"metadata":   metadata,
+		"spec": map[string]interface{}{
+			"gatewayClassName": info.RouteGatewayClass,
+			"listeners":        listeners,
+		},
+	}
+}
+
+// getGatewayMetadata Gateway和HTTPRoute的基础信息
+func getGatewayMetadata(info *route.RouteInfo) map[string]interface{} {
+	return map[string]interface{}{
+		"name":      info.RouteName,
+		"namespace": info.RouteNamespace,
+		"labels": map[string]interface{}{
+			"app-name": info.RouteName,
+			"author":   "router",
+		},
+	}
+}
+
+// applyGatewayRoute 以平台字段管理者的身份将Gateway和HTTPRoute应用到k8s
+func (r *RouteDataService) applyGatewayRoute(info *route.RouteInfo) error {
+	if info.RouteGatewayClass != "" {
+		err := r.applyDynamic(gatewayResource, "Gateway", info.RouteNamespace, info.RouteName, setGateway(info))
+		if err != nil {
+			return err
+		}
+	}
+
+	httpRoute, err := setHTTPRoute(info)
+	if err != nil {
+		return err
+	}
+	return r.applyDynamic(httpRouteResource, "HTTPRoute", info.RouteNamespace, info.RouteName, httpRoute)
+}
+
+// deletePreviousObjects 根据更新前保存的路由删除不再使用的对象，previous 为空时是新建的路由，不删除
+// 是否单独创建了Gateway以保存的 GatewayClass 为准，本次请求没有填写 GatewayClass 不代表之前没有创建
+func (r *RouteDataService) deletePreviousObjects(info *route.RouteInfo, previous *model.Route) error {
+	if previous == nil {
+		return nil
+	}
+	ownedGateway := previous.RouteGatewayClass != ""
+
+	if getRenderer(info.RouteRenderer) == RendererIngress {
+		// 从 gateway 模式切换过来时删除原来的HTTPRoute和Gateway
+		return r.deleteGatewayRoute(info.RouteNamespace, info.RouteName, ownedGateway)
+	}
+
+	// 从 ingress 模式切换过来时删除原来的Ingress
+	err := r.K8sClientSet.NetworkingV1().Ingresses(info.RouteNamespace).Delete(context.TODO(), info.RouteName, v14.DeleteOptions{})
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return err
+	}
+
+	// 改为挂载到已有的Gateway时删除之前单独创建的Gateway
+	if ownedGateway && info.RouteGatewayClass == "" && r.DynamicClient != nil {
+		err = r.DynamicClient.Resource(gatewayResource).Namespace(info.RouteNamespace).Delete(context.TODO(), info.RouteName, v14.DeleteOptions{})
+		if err != nil && !k8serrors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// applyDynamic 通过动态客户端服务端应用对象
+func (r *RouteDataService) applyDynamic(resource schema.GroupVersionResource, kind, namespace, name string, obj map[string]interface{}) error {
+	data, err := common.ApplyData(obj)
+	if err != nil {
+		return err
+	}
+	opts, err := common.ApplyOptions(func() (v14.Object, error) {
+		return r.DynamicClient.Resource(resource).Namespace(namespace).Get(context.TODO(), name, v14.GetOptions{})
+	})
+	if err != nil {
+		return err
+	}
+	_, err = r.DynamicClient.Resource(resource).Namespace(namespace).Patch(context.TODO(), name, types.ApplyPatchType, data, opts)
+	if err != nil {
+		return common.ApplyError(kind, name, err)
+	}
+	return nil
+}
+
+// deleteGatewayRoute 删除路由生成的HTTPRoute和单独创建的Gateway，不存在时忽略
+func (r *RouteDataService) deleteGatewayRoute(namespace, name string, withGateway bool) error {
+	if r.DynamicClient == nil {
+		return nil
+	}
+	err := r.DynamicClient.Resource(httpRouteResource).Namespace(namespace).Delete(context.TODO(), name, v14.DeleteOptions{})
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return err
+	}
+	if !withGateway {
+		return nil
+	}
+	err = r.DynamicClient.Resource(gatewayResource).Namespace(namespace).Delete(context.TODO(), name, v14.DeleteOptions{})
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,148 @@
+package service
+
+import (
+	"context"
+	v12 "k8s.io/api/networking/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	v14 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/fake"
+	"path/filepath"
+	"testing"
+	"tini-paas/internal/route/model"
+	"tini-paas/internal/route/proto/route"
+	"tini-paas/pkg/common"
+)
+
+// newGatewayObject Gateway API 的对象，kind 为 Gateway 或 HTTPRoute
+func newGatewayObject(kind, namespace, name string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion(gatewayGroup + "/v1")
+	obj.SetKind(kind)
+	obj.SetNamespace(namespace)
+	obj.SetName(name)
+	return obj
+}
+
+// newGatewayTestService 动态客户端按照资源名称保存对象，Gateway 由kind推测出的资源名称不是 gateways
+// 日志写到测试的临时目录
+func newGatewayTestService(t *testing.T, objects []runtime.Object, dynamicObjects ...*unstructured.Unstructured) *RouteDataService {
+	common.SetLogFile(filepath.Join(t.TempDir(), "micro.log"))
+	dynamicClient := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme())
+	for _, obj := range dynamicObjects {
+		resource := gatewayResource
+		if obj.GetKind() == "HTTPRoute" {
+			resource = httpRouteResource
+		}
+		err := dynamicClient.Tracker().Create(resource, obj, obj.GetNamespace())
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	return &RouteDataService{
+		K8sClientSet:  fake.NewSimpleClientset(objects...),
+		DynamicClient: dynamicClient,
+	}
+}
+
+// exists 对象是否还存在
+func (r *RouteDataService) exists(t *testing.T, resource schema.GroupVersionResource, namespace, name string) bool {
+	t.Helper()
+	_, err := r.DynamicClient.Resource(resource).Namespace(namespace).Get(context.TODO(), name, v14.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		return false
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	return true
+}
+
+func TestDeletePreviousObjects(t *testing.T) {
+	tests := []struct {
+		name          string
+		previous      *model.Route
+		info          *route.RouteInfo
+		wantHTTPRoute bool
+		wantGateway   bool
+		wantIngress   bool
+	}{
+		{
+			// 请求中没有填写 GatewayClass，按照保存的路由删除单独创建的Gateway
+			name:        "gateway with class to ingress",
+			previous:    &model.Route{RouteRenderer: RendererGateway, RouteGatewayClass: "istio"},
+			info:        &route.RouteInfo{RouteRenderer: RendererIngress},
+			wantIngress: true,
+		},
+		{
+			// 挂载到已有Gateway时同名的Gateway不属于路由
+			name:        "shared gateway to ingress",
+			previous:    &model.Route{RouteRenderer: RendererGateway, RouteGateway: "infra/shared"},
+			info:        &route.RouteInfo{RouteRenderer: RendererIngress},
+			wantGateway: true,
+			wantIngress: true,
+		},
+		{
+			name:          "gateway with class to shared gateway",
+			previous:      &model.Route{RouteRenderer: RendererGateway, RouteGatewayClass: "istio"},
+			info:          &route.RouteInfo{RouteRenderer: RendererGateway, RouteGateway: "infra/shared"},
+			wantHTTPRoute: true,
+		},
+		{
+			name:          "gateway class unchanged",
+			previous:      &model.Route{RouteRenderer: RendererGateway, RouteGatewayClass: "istio"},
+			info:          &route.RouteInfo{RouteRenderer: RendererGateway, RouteGatewayClass: "istio"},
+			wantHTTPRoute: true,
+			wantGateway:   true,
+		},
+		{
+			// 新建的路由不删除已经存在的同名对象
+			name:          "new route",
+			info:          &route.RouteInfo{RouteRenderer: RendererGateway, RouteGatewayClass: "istio"},
+			wantHTTPRoute: true,
+			wantGateway:   true,
+			wantIngress:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.info.RouteNamespace = "default"
+			tt.info.RouteName = "web"
+			ingress := &v12.Ingress{ObjectMeta: v14.ObjectMeta{Namespace: "default", Name: "web"}}
+			r := newGatewayTestService(t, []runtime.Object{ingress},
+				newGatewayObject("HTTPRoute", "default", "web"),
+				newGatewayObject("Gateway", "default", "web"),
+			)
+
+			err := r.deletePreviousObjects(tt.info, tt.previous)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got := r.exists(t, httpRouteResource, "default", "web"); got != tt.wantHTTPRoute {
+				t.Errorf("HTTPRoute exists = %v, want %v", got, tt.wantHTTPRoute)
+			}
+			if got := r.exists(t, gatewayResource, "default", "web"); got != tt.wantGateway {
+				t.Errorf("Gateway exists = %v, want %v", got, tt.wantGateway)
+			}
+			_, err = r.K8sClientSet.NetworkingV1().Ingresses("default").Get(context.TODO(), "web", v14.GetOptions{})
+			if got := err == nil; got != tt.wantIngress {
+				t.Errorf("Ingress exists = %v, want %v (%v)", got, tt.wantIngress, err)
+			}
+		})
+	}
+}
+
+func TestDeletePreviousObjectsIgnoresMissing(t *testing.T) {
+	r := newGatewayTestService(t, nil)
+	err := r.deletePreviousObjects(
+		&route.RouteInfo{RouteNamespace: "default", RouteName: "web", RouteRenderer: RendererIngress},
+		&model.Route{RouteRenderer: RendererGateway, RouteGatewayClass: "istio"},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
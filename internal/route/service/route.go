@@ -5,9 +5,13 @@ import (
 	"errors"
 	v1 "k8s.io/api/apps/v1"
 	v12 "k8s.io/api/networking/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	v14 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	"strconv"
+	"time"
 	"tini-paas/internal/route/model"
 	"tini-paas/internal/route/proto/route"
 	"tini-paas/internal/route/repository"
@@ -28,24 +32,40 @@ type RouteService interface {
 	// FindRouteByID 根据ID查找Route
 	FindRouteByID(int64) (*model.Route, error)
 
+	// FindRouteByNamespaceAndName 根据命名空间和名称查找Route
+	FindRouteByNamespaceAndName(string, string) (*model.Route, error)
+
 	// FindAllRoute 查找全部Route
 	FindAllRoute() ([]model.Route, error)
 
 	// CreateRouteToK8s 创建Route到K8s
 	CreateRouteToK8s(*route.RouteInfo) error
 
-	// UpdateRouteToK8s 更新Route到k8s
-	UpdateRouteToK8s(*route.RouteInfo) error
+	// UpdateRouteToK8s 更新Route到k8s，previous 为更新前保存的路由，切换生成方式时删除原来的对象
+	UpdateRouteToK8s(info *route.RouteInfo, previous *model.Route) error
 
 	// DeleteRouteFromK8s 从k8s删除Route
 	DeleteRouteFromK8s(*model.Route) error
+
+	// SetTLSStatus 读取证书的到期时间和提示
+	SetTLSStatus(*route.RouteInfo) error
+
+	// CheckBackends 检查路由引用的服务是否存在并且暴露了引用的端口
+	CheckBackends(*route.RouteInfo) ([]BackendError, error)
+
+	// RunBackendChecker 定期检查全部路由的后端服务
+	RunBackendChecker(time.Duration)
+
+	// ExportYAML 导出应用到k8s的Ingress或者Gateway和HTTPRoute
+	ExportYAML(*route.RouteInfo) (string, error)
 }
 
 // NewRouteService 初始化route接口服务
-func NewRouteService(routerRepository repository.RouteRepository, clientSet *kubernetes.Clientset) RouteService {
+func NewRouteService(routerRepository repository.RouteRepository, clientSet kubernetes.Interface, dynamicClient dynamic.Interface) RouteService {
 	return &RouteDataService{
 		RouteRepository: routerRepository,
 		K8sClientSet:    clientSet,
+		DynamicClient:   dynamicClient,
 		deployment:      &v1.Deployment{},
 	}
 }
@@ -56,7 +76,10 @@ type RouteDataService struct {
 	RouteRepository repository.RouteRepository
 
 	// K8sClientSet k8s客户端集合
-	K8sClientSet *kubernetes.Clientset
+	K8sClientSet kubernetes.Interface
+
+	// DynamicClient 操作 Gateway API 的Gateway和HTTPRoute
+	DynamicClient dynamic.Interface
 
 	// deployment 发布控制器
 	deployment *v1.Deployment
@@ -82,57 +105,156 @@ func (r *RouteDataService) FindRouteByID(i int64) (*model.Route, error) {
 	return r.RouteRepository.FindRouteByID(i)
 }
 
+// FindRouteByNamespaceAndName 根据命名空间和名称查找Route
+func (r *RouteDataService) FindRouteByNamespaceAndName(namespace, name string) (*model.Route, error) {
+	return r.RouteRepository.FindRouteByNamespaceAndName(namespace, name)
+}
+
 // FindAllRoute 查找全部Route
 func (r *RouteDataService) FindAllRoute() ([]model.Route, error) {
 	return r.RouteRepository.FindAll()
 }
 
 // CreateRouteToK8s 创建Route到K8s
+// 采用服务端应用(server-side apply)，平台创建过的同名对象按照重试处理，不是平台创建的同名对象返回错误
 func (r *RouteDataService) CreateRouteToK8s(info *route.RouteInfo) error {
-	// 组装信息
-	ingress := r.setIngress(info)
+	err := r.checkCreate(info)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	err = r.applyRouteToK8s(info, nil)
+	if err != nil {
+		return err
+	}
+	common.Info("路由：" + info.RouteName + "创建成功")
+	return nil
+}
 
-	// 先查询之前是否存在
-	_, err := r.K8sClientSet.NetworkingV1().Ingresses(info.RouteNamespace).Get(context.TODO(), info.RouteName, v14.GetOptions{})
+// UpdateRouteToK8s 更新Route到k8s，previous 为更新前保存的路由
+func (r *RouteDataService) UpdateRouteToK8s(info *route.RouteInfo, previous *model.Route) error {
+	err := r.applyRouteToK8s(info, previous)
 	if err != nil {
-		// 之前不存在 -> 可以创建
-		_, err = r.K8sClientSet.NetworkingV1().Ingresses(info.RouteNamespace).Create(context.TODO(), ingress, v14.CreateOptions{})
-		if err != nil {
-			// 创建失败
-			common.Error(err)
-			return err
+		return err
+	}
+	common.Info("路由：" + info.RouteName + "更新成功")
+	return nil
+}
+
+// applyRouteToK8s 以平台字段管理者的身份将路由应用到k8s，按照路由的生成方式生成Ingress或者HTTPRoute
+// 启用https时先准备证书，应用成功后按照更新前的路由删除不再使用的对象
+func (r *RouteDataService) applyRouteToK8s(info *route.RouteInfo, previous *model.Route) error {
+	err := checkRoute(info)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+
+	switch getRenderer(info.RouteRenderer) {
+	case RendererIngress:
+		err = r.applyIngress(info)
+	case RendererGateway:
+		err = checkGatewayRoute(info)
+		if err == nil {
+			err = r.prepareTLS(info)
 		}
-		return nil
+		if err == nil {
+			err = r.applyGatewayRoute(info)
+		}
+	default:
+		err = errors.New("不支持的路由生成方式：" + info.RouteRenderer)
+	}
+	if err == nil {
+		err = r.deletePreviousObjects(info, previous)
+	}
+	if err != nil {
+		common.Error(err)
+		return err
 	}
+	return nil
+}
 
-	// 之前存在ingress
-	// TODO
-	common.Error("路由：" + info.RouteName + "已经存在")
-	return errors.New("路由：" + info.RouteName + "已经存在")
+// checkCreate 创建前按照路由的生成方式检查同名的Ingress或者HTTPRoute
+func (r *RouteDataService) checkCreate(info *route.RouteInfo) error {
+	if getRenderer(info.RouteRenderer) == RendererGateway {
+		return common.CheckCreate("HTTPRoute", info.RouteName, func() (v14.Object, error) {
+			return r.DynamicClient.Resource(httpRouteResource).Namespace(info.RouteNamespace).Get(context.TODO(), info.RouteName, v14.GetOptions{})
+		})
+	}
+	return common.CheckCreate("路由", info.RouteName, func() (v14.Object, error) {
+		return r.K8sClientSet.NetworkingV1().Ingresses(info.RouteNamespace).Get(context.TODO(), info.RouteName, v14.GetOptions{})
+	})
 }
 
-// UpdateRouteToK8s 更新Route到k8s
-func (r *RouteDataService) UpdateRouteToK8s(info *route.RouteInfo) error {
+// applyIngress 将路由应用为Ingress，应用前检查路径是否与其他路由冲突
+func (r *RouteDataService) applyIngress(info *route.RouteInfo) error {
+	for _, path := range info.RoutePath {
+		if usesGatewayFeatures(path) {
+			return errors.New("路径 " + path.RoutePathName + " 使用了请求头匹配、分流或者镜像，需要使用 gateway 模式")
+		}
+	}
+	err := checkAnnotations(info)
+	if err != nil {
+		return err
+	}
+	err = r.checkIngressClass(getIngressClass(info.RouteIngressClass))
+	if err != nil {
+		return err
+	}
+	err = r.checkBasicAuthSecret(info)
+	if err != nil {
+		return err
+	}
 	ingress := r.setIngress(info)
+	err = r.checkPathConflict(info, ingress)
+	if err != nil {
+		return err
+	}
+	err = r.prepareTLS(info)
+	if err != nil {
+		return err
+	}
 
-	_, err := r.K8sClientSet.NetworkingV1().Ingresses(info.RouteNamespace).Update(context.TODO(), ingress, v14.UpdateOptions{})
+	data, err := common.ApplyData(ingress)
+	if err != nil {
+		return err
+	}
+
+	opts, err := common.ApplyOptions(func() (v14.Object, error) {
+		return r.K8sClientSet.NetworkingV1().Ingresses(info.RouteNamespace).Get(context.TODO(), info.RouteName, v14.GetOptions{})
+	})
 	if err != nil {
-		// 更新失败
-		common.Error(err)
 		return err
 	}
+	// 字段冲突时不强制覆盖，返回冲突信息
+	_, err = r.K8sClientSet.NetworkingV1().Ingresses(info.RouteNamespace).Patch(context.TODO(), info.RouteName, types.ApplyPatchType, data, opts)
+	if err != nil {
+		return common.ApplyError("路由", info.RouteName, err)
+	}
 	return nil
 }
 
 // DeleteRouteFromK8s 从k8s删除Route
 func (r *RouteDataService) DeleteRouteFromK8s(m *model.Route) error {
-	// 先删除k8s
+	// 先删除k8s，Ingress和HTTPRoute只有一个存在
 	err := r.K8sClientSet.NetworkingV1().Ingresses(m.RouteNamespace).Delete(context.TODO(), m.RouteName, v14.DeleteOptions{})
-	if err != nil {
+	if err != nil && !k8serrors.IsNotFound(err) {
 		// 删除失败
 		common.Error(err)
 		return err
 	}
+	err = r.deleteGatewayRoute(m.RouteNamespace, m.RouteName, m.RouteGatewayClass != "")
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+
+	// 删除上传的证书
+	err = r.deleteTLSSecret(m)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
 
 	// 删除k8s成功后，删除数据库
 	err = r.RouteRepository.DeleteRouteByID(m.ID)
@@ -145,6 +267,27 @@ func (r *RouteDataService) DeleteRouteFromK8s(m *model.Route) error {
 	return nil
 }
 
+// ExportYAML 导出应用到k8s的Ingress或者Gateway和HTTPRoute，与 UpdateRouteToK8s 发送的内容一致
+// 证书保存在单独的Secret中，不导出
+func (r *RouteDataService) ExportYAML(info *route.RouteInfo) (string, error) {
+	switch getRenderer(info.RouteRenderer) {
+	case RendererIngress:
+		return common.ExportYAML(r.setIngress(info))
+	case RendererGateway:
+		var objs []interface{}
+		if info.RouteGatewayClass != "" {
+			objs = append(objs, setGateway(info))
+		}
+		httpRoute, err := setHTTPRoute(info)
+		if err != nil {
+			return "", err
+		}
+		return common.ExportYAML(append(objs, httpRoute)...)
+	default:
+		return "", errors.New("不支持的路由生成方式：" + info.RouteRenderer)
+	}
+}
+
 // setIngress 封装ingress
 func (r *RouteDataService) setIngress(info *route.RouteInfo) *v12.Ingress {
 	router := &v12.Ingress{}
@@ -152,7 +295,7 @@ func (r *RouteDataService) setIngress(info *route.RouteInfo) *v12.Ingress {
 	// 设置路由
 	router.TypeMeta = v14.TypeMeta{
 		Kind:       "Ingress",
-		APIVersion: "v1",
+		APIVersion: "networking.k8s.io/v1",
 	}
 
 	// 设置路由基础信息
@@ -163,59 +306,65 @@ func (r *RouteDataService) setIngress(info *route.RouteInfo) *v12.Ingress {
 			"app-name": info.RouteName,
 			"author":   "router",
 		},
-		Annotations: map[string]string{
-			"k8s/generated": "paasmicro",
-		},
+		Annotations: getNginxAnnotations(info),
+	}
+	router.Annotations["k8s/generated"] = "paasmicro"
+	if info.RouteTlsMode == TLSModeCertManager {
+		router.Annotations[clusterIssuerAnnotation] = info.RouteTlsClusterIssuer
 	}
 
-	// 使用 ingress-nginx
-	className := "nginx"
+	// 默认使用 ingress-nginx
+	className := getIngressClass(info.RouteIngressClass)
 	// 设置路由 spec 信息
 	router.Spec = v12.IngressSpec{
 		IngressClassName: &className,
-		DefaultBackend:   nil, // 默认访问服务
-		TLS:              nil, // https
+		DefaultBackend:   getDefaultBackend(info),
+		TLS:              getIngressTLS(info),
 		Rules:            r.getIngressPath(info),
 	}
 
 	return router
 }
 
-// getIngressPath 封装Ingress路径
+// getIngressPath 封装Ingress路径，每个域名一条规则，指定了域名的路径只加入对应域名的规则
 func (r *RouteDataService) getIngressPath(info *route.RouteInfo) []v12.IngressRule {
 	var path []v12.IngressRule
 
-	// 1、设置host
-	pathRule := v12.IngressRule{
-		Host: info.RouteHost,
-	}
-
-	// 2、设置path
-	var ingressPath []v12.HTTPIngressPath
-	for _, routePath := range info.RoutePath {
-		pathType := v12.PathTypePrefix
-
-		// 将信息写入
-		ingressPath = append(ingressPath, v12.HTTPIngressPath{
-			Path:     routePath.RoutePathName,
-			PathType: &pathType,
-			Backend: v12.IngressBackend{
-				Service: &v12.IngressServiceBackend{
-					Name: routePath.RouteBackendService,
-					Port: v12.ServiceBackendPort{
-						Number: routePath.RouteBackendServicePort,
-					},
+	// 1、设置host，没有域名时匹配所有域名
+	hosts := getHosts(info.RouteHost)
+	if len(hosts) == 0 {
+		hosts = []string{""}
+	}
+
+	for _, host := range hosts {
+		// 2、设置path
+		var ingressPath []v12.HTTPIngressPath
+		for _, routePath := range info.RoutePath {
+			if routePath.RoutePathHost != "" && routePath.RoutePathHost != host {
+				continue
+			}
+			pathType := getPathType(routePath.RoutePathType)
+
+			// 将信息写入
+			ingressPath = append(ingressPath, v12.HTTPIngressPath{
+				Path:     routePath.RoutePathName,
+				PathType: &pathType,
+				Backend:  getIngressBackend(routePath.RouteBackendService, routePath.RouteBackendServicePort),
+			})
+		}
+		if len(ingressPath) == 0 {
+			continue
+		}
+
+		// 3、赋值path
+		path = append(path, v12.IngressRule{
+			Host: host,
+			IngressRuleValue: v12.IngressRuleValue{
+				HTTP: &v12.HTTPIngressRuleValue{
+					Paths: ingressPath,
 				},
 			},
 		})
 	}
-
-	// 3、赋值path
-	pathRule.IngressRuleValue = v12.IngressRuleValue{
-		HTTP: &v12.HTTPIngressRuleValue{
-			Paths: ingressPath,
-		},
-	}
-	path = append(path, pathRule)
 	return path
 }
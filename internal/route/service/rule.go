@@ -0,0 +1,171 @@
+package service
+
+import (
+	"context"
+	"errors"
+	v12 "k8s.io/api/networking/v1"
+	v14 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"strings"
+	"tini-paas/internal/route/proto/route"
+)
+
+// getHosts 拆分逗号分隔的域名
+func getHosts(hosts string) []string {
+	return splitList(hosts)
+}
+
+// splitList 拆分逗号分隔的列表，去掉空白
+func splitList(value string) []string {
+	var result []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
+// getPathType 路径匹配方式，未设置时为 Prefix
+func getPathType(pathType string) v12.PathType {
+	if pathType == "" {
+		return v12.PathTypePrefix
+	}
+	return v12.PathType(pathType)
+}
+
+// getIngressBackend 转发到服务的后端
+func getIngressBackend(service string, port int32) v12.IngressBackend {
+	return v12.IngressBackend{
+		Service: &v12.IngressServiceBackend{
+			Name: service,
+			Port: v12.ServiceBackendPort{
+				Number: port,
+			},
+		},
+	}
+}
+
+// getDefaultBackend 没有匹配任何规则时的后端
+func getDefaultBackend(info *route.RouteInfo) *v12.IngressBackend {
+	if info.RouteDefaultBackendService == "" {
+		return nil
+	}
+	backend := getIngressBackend(info.RouteDefaultBackendService, info.RouteDefaultBackendServicePort)
+	return &backend
+}
+
+// checkRoute 检查域名、路径和匹配方式，路由至少需要一个路径或者默认服务
+func checkRoute(info *route.RouteInfo) error {
+	hosts := map[string]bool{}
+	for _, host := range getHosts(info.RouteHost) {
+		if errs := validation.IsDNS1123Subdomain(strings.TrimPrefix(host, "*.")); len(errs) > 0 {
+			return errors.New("域名 " + host + " 格式错误：" + strings.Join(errs, "; "))
+		}
+		if hosts[host] {
+			return errors.New("域名 " + host + " 重复")
+		}
+		hosts[host] = true
+	}
+	if len(info.RoutePath) == 0 && info.RouteDefaultBackendService == "" {
+		return errors.New("路由至少需要一个路径或者默认服务")
+	}
+	if info.RouteDefaultBackendService != "" && info.RouteDefaultBackendServicePort == 0 {
+		return errors.New("默认服务需要指定端口")
+	}
+
+	exists := map[string]bool{}
+	for _, path := range info.RoutePath {
+		if !strings.HasPrefix(path.RoutePathName, "/") {
+			return errors.New("路径 " + path.RoutePathName + " 需要以 / 开头")
+		}
+		switch getPathType(path.RoutePathType) {
+		case v12.PathTypeExact, v12.PathTypePrefix, v12.PathTypeImplementationSpecific:
+		default:
+			return errors.New("不支持的路径匹配方式：" + path.RoutePathType)
+		}
+		if path.RoutePathHost != "" && !hosts[path.RoutePathHost] {
+			return errors.New("路径 " + path.RoutePathName + " 的域名 " + path.RoutePathHost + " 不是路由的域名")
+		}
+		if path.RouteBackendService == "" || path.RouteBackendServicePort == 0 {
+			return errors.New("路径 " + path.RoutePathName + " 需要指定服务和端口")
+		}
+		for _, host := range pathHosts(info, path) {
+			key := pathKey(host, path.RoutePathName, getPathType(path.RoutePathType))
+			if exists[key] {
+				return errors.New("路径 " + host + path.RoutePathName + " 重复")
+			}
+			exists[key] = true
+		}
+	}
+	return nil
+}
+
+// pathHosts 路径生效的域名，没有域名时为空字符串，匹配所有域名
+func pathHosts(info *route.RouteInfo, path *route.RoutePath) []string {
+	if path.RoutePathHost != "" {
+		return []string{path.RoutePathHost}
+	}
+	hosts := getHosts(info.RouteHost)
+	if len(hosts) == 0 {
+		return []string{""}
+	}
+	return hosts
+}
+
+// pathKey 判断路径冲突的键，ingress-nginx 按照前缀处理 ImplementationSpecific
+func pathKey(host, path string, pathType v12.PathType) string {
+	if pathType == v12.PathTypeImplementationSpecific {
+		pathType = v12.PathTypePrefix
+	}
+	return host + "|" + string(pathType) + "|" + path
+}
+
+// checkPathConflict 检查集群中同一个ingress类的其他ingress是否已经在相同域名上使用了相同的路径
+func (r *RouteDataService) checkPathConflict(info *route.RouteInfo, ingress *v12.Ingress) error {
+	paths := map[string]string{}
+	for _, rule := range ingress.Spec.Rules {
+		if rule.HTTP == nil {
+			continue
+		}
+		for _, path := range rule.HTTP.Paths {
+			paths[pathKey(rule.Host, path.Path, *path.PathType)] = rule.Host + path.Path
+		}
+	}
+
+	ingresses, err := r.K8sClientSet.NetworkingV1().Ingresses("").List(context.TODO(), v14.ListOptions{})
+	if err != nil {
+		return err
+	}
+	for _, other := range ingresses.Items {
+		if other.Namespace == info.RouteNamespace && other.Name == info.RouteName {
+			continue
+		}
+		if !sameIngressClass(ingress, &other) {
+			continue
+		}
+		for _, rule := range other.Spec.Rules {
+			if rule.HTTP == nil {
+				continue
+			}
+			for _, path := range rule.HTTP.Paths {
+				pathType := v12.PathTypeImplementationSpecific
+				if path.PathType != nil {
+					pathType = *path.PathType
+				}
+				if url, ok := paths[pathKey(rule.Host, path.Path, pathType)]; ok {
+					return errors.New("路径 " + url + " 已经被路由 " + other.Namespace + "/" + other.Name + " 使用")
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// sameIngressClass 两个ingress是否由同一个ingress控制器处理，没有指定时视为默认的类
+func sameIngressClass(a, b *v12.Ingress) bool {
+	if a.Spec.IngressClassName == nil || b.Spec.IngressClassName == nil {
+		return true
+	}
+	return *a.Spec.IngressClassName == *b.Spec.IngressClassName
+}
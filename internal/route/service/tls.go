@@ -0,0 +1,221 @@
+package service
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	v1 "k8s.io/api/core/v1"
+	v12 "k8s.io/api/networking/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	v14 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"strconv"
+	"time"
+	"tini-paas/internal/route/model"
+	"tini-paas/internal/route/proto/route"
+	"tini-paas/pkg/common"
+)
+
+// 证书来源
+const (
+	TLSModeUpload      = "upload"
+	TLSModeSecret      = "secret"
+	TLSModeCertManager = "cert-manager"
+)
+
+// clusterIssuerAnnotation cert-manager 根据这个注解为ingress签发证书
+const clusterIssuerAnnotation = "cert-manager.io/cluster-issuer"
+
+// tlsExpireWarningDays 证书剩余有效期少于这个天数时提示
+const tlsExpireWarningDays = 14
+
+// tlsSecretName 证书所在的secret，没有指定时使用 <路由名称>-tls
+func tlsSecretName(routeName, secretName string) string {
+	if secretName != "" {
+		return secretName
+	}
+	return routeName + "-tls"
+}
+
+// getIngressTLS ingress的https配置，证书覆盖路由的全部域名
+func getIngressTLS(info *route.RouteInfo) []v12.IngressTLS {
+	if info.RouteTlsMode == "" {
+		return nil
+	}
+	return []v12.IngressTLS{
+		{
+			Hosts:      getHosts(info.RouteHost),
+			SecretName: tlsSecretName(info.RouteName, info.RouteTlsSecret),
+		},
+	}
+}
+
+// prepareTLS 按照证书来源检查配置，上传的证书保存为 kubernetes.io/tls 类型的secret
+func (r *RouteDataService) prepareTLS(info *route.RouteInfo) error {
+	if info.RouteTlsMode == "" {
+		return nil
+	}
+	if info.RouteHost == "" {
+		return errors.New("启用https需要设置路由域名")
+	}
+
+	switch info.RouteTlsMode {
+	case TLSModeUpload:
+		// 更新路由时没有重新上传，继续使用之前上传的证书
+		if info.RouteTlsCert == "" && info.RouteTlsKey == "" {
+			return r.checkTLSSecret(info.RouteNamespace, tlsSecretName(info.RouteName, info.RouteTlsSecret))
+		}
+		err := checkCertificate(info.RouteTlsCert, info.RouteTlsKey, getHosts(info.RouteHost))
+		if err != nil {
+			return err
+		}
+		return r.applyTLSSecret(info)
+	case TLSModeSecret:
+		if info.RouteTlsSecret == "" {
+			return errors.New("需要指定证书所在的secret")
+		}
+		return r.checkTLSSecret(info.RouteNamespace, info.RouteTlsSecret)
+	case TLSModeCertManager:
+		if info.RouteTlsClusterIssuer == "" {
+			return errors.New("cert-manager 签发证书需要指定 ClusterIssuer")
+		}
+		return nil
+	default:
+		return errors.New("不支持的证书来源：" + info.RouteTlsMode)
+	}
+}
+
+// checkCertificate 检查证书和私钥是否匹配，证书需要包含路由的全部域名并且没有过期
+func checkCertificate(certPEM, keyPEM string, hosts []string) error {
+	pair, err := tls.X509KeyPair([]byte(certPEM), []byte(keyPEM))
+	if err != nil {
+		return errors.New("证书和私钥不匹配或者格式错误：" + err.Error())
+	}
+	cert, err := x509.ParseCertificate(pair.Certificate[0])
+	if err != nil {
+		return err
+	}
+	for _, host := range hosts {
+		if err = cert.VerifyHostname(host); err != nil {
+			return errors.New("证书不包含域名 " + host + "：" + err.Error())
+		}
+	}
+	if time.Now().After(cert.NotAfter) {
+		return errors.New("证书已于 " + cert.NotAfter.Format("2006-01-02") + " 过期")
+	}
+	return nil
+}
+
+// applyTLSSecret 将上传的证书和私钥应用为 kubernetes.io/tls 类型的secret
+func (r *RouteDataService) applyTLSSecret(info *route.RouteInfo) error {
+	name := tlsSecretName(info.RouteName, info.RouteTlsSecret)
+	secret := &v1.Secret{
+		TypeMeta: v14.TypeMeta{
+			Kind:       "Secret",
+			APIVersion: "v1",
+		},
+		ObjectMeta: v14.ObjectMeta{
+			Name:      name,
+			Namespace: info.RouteNamespace,
+			Labels: map[string]string{
+				"app-name": info.RouteName,
+				"author":   "router",
+			},
+		},
+		Type: v1.SecretTypeTLS,
+		Data: map[string][]byte{
+			v1.TLSCertKey:       []byte(info.RouteTlsCert),
+			v1.TLSPrivateKeyKey: []byte(info.RouteTlsKey),
+		},
+	}
+	data, err := common.ApplyData(secret)
+	if err != nil {
+		return err
+	}
+	opts, err := common.ApplyOptions(func() (v14.Object, error) {
+		return r.K8sClientSet.CoreV1().Secrets(info.RouteNamespace).Get(context.TODO(), name, v14.GetOptions{})
+	})
+	if err != nil {
+		return err
+	}
+	_, err = r.K8sClientSet.CoreV1().Secrets(info.RouteNamespace).Patch(context.TODO(), name, types.ApplyPatchType, data, opts)
+	if err != nil {
+		return common.ApplyError("证书", name, err)
+	}
+	return nil
+}
+
+// checkTLSSecret 检查证书secret存在并且类型正确
+func (r *RouteDataService) checkTLSSecret(namespace, name string) error {
+	secret, err := r.K8sClientSet.CoreV1().Secrets(namespace).Get(context.TODO(), name, v14.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		return errors.New("证书secret " + name + " 不存在")
+	}
+	if err != nil {
+		return err
+	}
+	if secret.Type != v1.SecretTypeTLS {
+		return errors.New("secret " + name + " 的类型是 " + string(secret.Type) + "，需要 " + string(v1.SecretTypeTLS))
+	}
+	return nil
+}
+
+// SetTLSStatus 读取证书的到期时间，即将到期、已经过期或者还没有签发时写入提示
+func (r *RouteDataService) SetTLSStatus(info *route.RouteInfo) error {
+	if info.RouteTlsMode == "" {
+		return nil
+	}
+	name := tlsSecretName(info.RouteName, info.RouteTlsSecret)
+	secret, err := r.K8sClientSet.CoreV1().Secrets(info.RouteNamespace).Get(context.TODO(), name, v14.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		if info.RouteTlsMode == TLSModeCertManager {
+			info.RouteTlsWarning = "证书还没有签发，请检查 cert-manager 的 Certificate " + name
+		} else {
+			info.RouteTlsWarning = "证书secret " + name + " 不存在"
+		}
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	block, _ := pem.Decode(secret.Data[v1.TLSCertKey])
+	if block == nil {
+		info.RouteTlsWarning = "secret " + name + " 中没有证书"
+		return nil
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		info.RouteTlsWarning = "证书格式错误：" + err.Error()
+		return nil
+	}
+
+	info.RouteTlsNotAfter = cert.NotAfter.Unix()
+	info.RouteTlsExpireDays = int32(time.Until(cert.NotAfter).Hours() / 24)
+	expireDate := cert.NotAfter.Format("2006-01-02")
+	switch {
+	case time.Now().After(cert.NotAfter):
+		info.RouteTlsWarning = "证书已于 " + expireDate + " 过期"
+	case info.RouteTlsExpireDays < tlsExpireWarningDays:
+		info.RouteTlsWarning = "证书将在 " + strconv.Itoa(int(info.RouteTlsExpireDays)) + " 天后(" + expireDate + ")过期"
+		// cert-manager 默认在到期前30天续期，剩余时间不足说明续期失败
+		if info.RouteTlsMode == TLSModeCertManager {
+			info.RouteTlsWarning += "，cert-manager 没有按时续期，请检查 Certificate " + name
+		}
+	}
+	return nil
+}
+
+// deleteTLSSecret 删除上传证书时创建的secret，已有的secret和cert-manager签发的证书不删除
+func (r *RouteDataService) deleteTLSSecret(m *model.Route) error {
+	if m.RouteTLSMode != TLSModeUpload {
+		return nil
+	}
+	err := r.K8sClientSet.CoreV1().Secrets(m.RouteNamespace).Delete(context.TODO(), tlsSecretName(m.RouteName, m.RouteTLSSecret), v14.DeleteOptions{})
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
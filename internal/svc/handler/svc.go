@@ -2,6 +2,9 @@ package handler
 
 import (
 	"context"
+	"errors"
+	microErrors "github.com/asim/go-micro/v3/errors"
+	"github.com/jinzhu/gorm"
 	"strconv"
 	"tini-paas/internal/svc/model"
 	"tini-paas/internal/svc/proto/svc"
@@ -16,17 +19,34 @@ type SvcHandler struct {
 
 // AddSvc 添加服务
 func (s *SvcHandler) AddSvc(ctx context.Context, info *svc.SvcInfo, response *svc.Response) error {
+	return s.addSvc(info, response)
+}
+
+// addSvc 在k8s中创建服务并保存到数据库
+func (s *SvcHandler) addSvc(info *svc.SvcInfo, response *svc.Response) error {
 	svcModel := &model.Svc{}
 
-	// 将info数据类型转换为svcModel
-	err := common.SwapTo(info, svcModel)
-	if err != nil {
+	// 同一命名空间内名称唯一，避免覆盖k8s中已经存在的资源
+	// 查询出错时不能当作不存在，否则数据库异常时会绕过检查
+	_, err := s.SvcService.FindSvcByNamespaceAndName(info.SvcNamespace, info.SvcName)
+	if err == nil {
+		err = errors.New("Svc " + info.SvcNamespace + "/" + info.SvcName + " 已经存在")
+	}
+	if !gorm.IsRecordNotFoundError(err) {
 		common.Error(err)
 		return err
 	}
 
 	// 在k8s中创建服务
 	err = s.SvcService.CreateSvcToK8s(info)
+	if err != nil {
+		common.Error(err)
+		response.Msg = err.Error()
+		return err
+	}
+
+	// 将info数据类型转换为svcModel，包含k8s分配的节点端口
+	err = common.SwapTo(info, svcModel)
 	if err != nil {
 		common.Error(err)
 		return err
@@ -105,6 +125,7 @@ func (s *SvcHandler) FindSvcByID(ctx context.Context, id *svc.SvcID, info *svc.S
 		common.Error(err)
 		return err
 	}
+	info.Id = svcModel.ID
 	return nil
 }
 
@@ -130,3 +151,78 @@ func (s *SvcHandler) FindAllSvc(ctx context.Context, all *svc.FindAll, rsp *svc.
 	}
 	return nil
 }
+
+// FindSvcByNamespaceAndName 根据命名空间和名称查找
+func (s *SvcHandler) FindSvcByNamespaceAndName(ctx context.Context, req *svc.SvcNamespaceName, info *svc.SvcInfo) error {
+	svcModel, err := s.SvcService.FindSvcByNamespaceAndName(req.Namespace, req.Name)
+	if err != nil {
+		common.Error(err)
+		if gorm.IsRecordNotFoundError(err) {
+			return microErrors.NotFound("go.micro.service.svc", "svc %s/%s 不存在", req.Namespace, req.Name)
+		}
+		return err
+	}
+
+	// 数据转换
+	err = common.SwapTo(svcModel, info)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	info.Id = svcModel.ID
+	return nil
+}
+
+// GetSvcEndpoints 查看服务的端点状态
+func (s *SvcHandler) GetSvcEndpoints(ctx context.Context, id *svc.SvcID, rsp *svc.SvcEndpoints) error {
+	svcModel, err := s.SvcService.FindSvcByID(id.Id)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+
+	err = s.SvcService.GetSvcEndpoints(svcModel, rsp)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	return nil
+}
+
+// ExposePod 根据pod声明的端口生成服务并关联到pod，之后pod端口变化时服务端口随之同步
+func (s *SvcHandler) ExposePod(ctx context.Context, req *svc.ExposePodRequest, response *svc.Response) error {
+	info, err := s.SvcService.BuildPodSvc(req)
+	if err != nil {
+		common.Error(err)
+		response.Msg = err.Error()
+		return err
+	}
+	return s.addSvc(info, response)
+}
+
+// SyncPodSvc 同步关联到pod的服务端口，由pod服务在更新pod后调用
+func (s *SvcHandler) SyncPodSvc(ctx context.Context, req *svc.SvcPodID, response *svc.Response) error {
+	err := s.SvcService.SyncPodSvc(req.PodId)
+	if err != nil {
+		common.Error(err)
+		response.Msg = err.Error()
+		return err
+	}
+	response.Msg = "pod " + strconv.FormatInt(req.PodId, 10) + " 关联的服务端口已同步"
+	return nil
+}
+
+// ExportSvc 导出服务应用到k8s的service
+func (s *SvcHandler) ExportSvc(ctx context.Context, id *svc.SvcID, rsp *svc.ExportResult) error {
+	info := &svc.SvcInfo{}
+	err := s.FindSvcByID(ctx, id, info)
+	if err != nil {
+		return err
+	}
+	rsp.Yaml, err = s.SvcService.ExportYAML(info)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	return nil
+}
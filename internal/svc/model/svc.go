@@ -5,24 +5,42 @@ type Svc struct {
 	// ID 服务ID
 	ID int64 `gorm:"primary_key;not_null;auto_increment"`
 
-	// SvcName 服务名称
-	SvcName string `gorm:"unique_index;not_null" json:"service_name"`
+	// SvcName 服务名称，同一命名空间内唯一
+	SvcName string `gorm:"unique_index:idx_svc_namespace_name;not_null" json:"svc_name"`
 
 	// SvcNamespace 服务名称命名空间
-	SvcNamespace string `gorm:"not_null" json:"service_namespace"`
+	SvcNamespace string `gorm:"unique_index:idx_svc_namespace_name;not_null" json:"svc_namespace"`
 
 	// SvcPodName 绑定的pod名称
-	SvcPodName string `gorm:"not_null" json:"service_pod_name"`
+	SvcPodName string `gorm:"not_null" json:"svc_pod_name"`
+
+	// SvcPodID 由pod端口生成服务时关联的pod，pod端口变化时同步服务端口，为0时没有关联
+	SvcPodID int64 `gorm:"index" json:"svc_pod_id"`
 
 	// SvcType 服务类型 ClusterIP, NodePort, LoadBalancer, ExternalName
-	SvcType string `json:"service_type"`
+	SvcType string `json:"svc_type"`
 
 	// SvcExternalName 服务外部名称， ExternalName时候启用该字段
-	SvcExternalName string `json:"service_external_name"`
+	SvcExternalName string `json:"svc_external_name"`
 
 	// SvcTeamID 业务侧团队ID
-	SvcTeamID string `json:"service_team_id"`
+	SvcTeamID string `json:"svc_team_id"`
 
 	// SvcPort 服务上的端口设置
-	SvcPort []SvcPort `gorm:"ForeignKey:SvcID" json:"service_port"`
+	SvcPort []SvcPort `gorm:"ForeignKey:SvcID" json:"svc_port"`
+
+	// SvcHeadless 无头服务(clusterIP: None)，只用于 ClusterIP 类型
+	SvcHeadless bool `json:"svc_headless"`
+
+	// SvcLoadBalancerSourceRanges 允许访问负载均衡的网段，逗号分隔，只用于 LoadBalancer 类型
+	SvcLoadBalancerSourceRanges string `json:"svc_load_balancer_source_ranges"`
+
+	// SvcExternalTrafficPolicy 外部流量策略 Cluster, Local，只用于 NodePort 和 LoadBalancer 类型
+	SvcExternalTrafficPolicy string `json:"svc_external_traffic_policy"`
+
+	// SvcSessionAffinity 会话保持 None, ClientIP
+	SvcSessionAffinity string `json:"svc_session_affinity"`
+
+	// SvcSessionAffinityTimeout ClientIP 会话保持的时间(秒)
+	SvcSessionAffinityTimeout int32 `json:"svc_session_affinity_timeout"`
 }
@@ -34,6 +34,18 @@ type SvcInfo struct {
 	SvcExternalName string     `protobuf:"bytes,6,opt,name=svc_external_name,json=svcExternalName,proto3" json:"svc_external_name,omitempty"`
 	SvcTeamId       string     `protobuf:"bytes,7,opt,name=svc_team_id,json=svcTeamId,proto3" json:"svc_team_id,omitempty"`
 	SvcPort         []*SvcPort `protobuf:"bytes,8,rep,name=svc_port,json=svcPort,proto3" json:"svc_port,omitempty"`
+	// 无头服务(clusterIP: None)，只用于 ClusterIP 类型
+	SvcHeadless bool `protobuf:"varint,9,opt,name=svc_headless,json=svcHeadless,proto3" json:"svc_headless,omitempty"`
+	// 允许访问负载均衡的网段，逗号分隔，如 10.0.0.0/8,192.168.1.0/24
+	SvcLoadBalancerSourceRanges string `protobuf:"bytes,10,opt,name=svc_load_balancer_source_ranges,json=svcLoadBalancerSourceRanges,proto3" json:"svc_load_balancer_source_ranges,omitempty"`
+	// 外部流量策略 Cluster, Local
+	SvcExternalTrafficPolicy string `protobuf:"bytes,11,opt,name=svc_external_traffic_policy,json=svcExternalTrafficPolicy,proto3" json:"svc_external_traffic_policy,omitempty"`
+	// 会话保持 None, ClientIP
+	SvcSessionAffinity string `protobuf:"bytes,12,opt,name=svc_session_affinity,json=svcSessionAffinity,proto3" json:"svc_session_affinity,omitempty"`
+	// ClientIP 会话保持的时间(秒)，默认10800
+	SvcSessionAffinityTimeout int32 `protobuf:"varint,13,opt,name=svc_session_affinity_timeout,json=svcSessionAffinityTimeout,proto3" json:"svc_session_affinity_timeout,omitempty"`
+	// 由pod端口生成服务时关联的pod
+	SvcPodId int64 `protobuf:"varint,14,opt,name=svc_pod_id,json=svcPodId,proto3" json:"svc_pod_id,omitempty"`
 }
 
 func (x *SvcInfo) Reset() {
@@ -124,6 +136,48 @@ func (x *SvcInfo) GetSvcPort() []*SvcPort {
 	return nil
 }
 
+func (x *SvcInfo) GetSvcHeadless() bool {
+	if x != nil {
+		return x.SvcHeadless
+	}
+	return false
+}
+
+func (x *SvcInfo) GetSvcLoadBalancerSourceRanges() string {
+	if x != nil {
+		return x.SvcLoadBalancerSourceRanges
+	}
+	return ""
+}
+
+func (x *SvcInfo) GetSvcExternalTrafficPolicy() string {
+	if x != nil {
+		return x.SvcExternalTrafficPolicy
+	}
+	return ""
+}
+
+func (x *SvcInfo) GetSvcSessionAffinity() string {
+	if x != nil {
+		return x.SvcSessionAffinity
+	}
+	return ""
+}
+
+func (x *SvcInfo) GetSvcSessionAffinityTimeout() int32 {
+	if x != nil {
+		return x.SvcSessionAffinityTimeout
+	}
+	return 0
+}
+
+func (x *SvcInfo) GetSvcPodId() int64 {
+	if x != nil {
+		return x.SvcPodId
+	}
+	return 0
+}
+
 // ServicePort 服务端口信息
 type SvcPort struct {
 	state         protoimpl.MessageState
@@ -260,6 +314,176 @@ func (x *SvcID) GetId() int64 {
 	return 0
 }
 
+// pod id
+type SvcPodID struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PodId int64 `protobuf:"varint,1,opt,name=pod_id,json=podId,proto3" json:"pod_id,omitempty"`
+}
+
+func (x *SvcPodID) Reset() {
+	*x = SvcPodID{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_svc_svc_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SvcPodID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SvcPodID) ProtoMessage() {}
+
+func (x *SvcPodID) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_svc_svc_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SvcPodID.ProtoReflect.Descriptor instead.
+func (*SvcPodID) Descriptor() ([]byte, []int) {
+	return file_proto_svc_svc_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SvcPodID) GetPodId() int64 {
+	if x != nil {
+		return x.PodId
+	}
+	return 0
+}
+
+// 根据pod声明的端口生成服务
+type ExposePodRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PodId int64 `protobuf:"varint,1,opt,name=pod_id,json=podId,proto3" json:"pod_id,omitempty"`
+	// 服务类型，为空时为 ClusterIP
+	SvcType string `protobuf:"bytes,2,opt,name=svc_type,json=svcType,proto3" json:"svc_type,omitempty"`
+	// 服务名称，为空时与pod同名
+	SvcName string `protobuf:"bytes,3,opt,name=svc_name,json=svcName,proto3" json:"svc_name,omitempty"`
+}
+
+func (x *ExposePodRequest) Reset() {
+	*x = ExposePodRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_svc_svc_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExposePodRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExposePodRequest) ProtoMessage() {}
+
+func (x *ExposePodRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_svc_svc_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExposePodRequest.ProtoReflect.Descriptor instead.
+func (*ExposePodRequest) Descriptor() ([]byte, []int) {
+	return file_proto_svc_svc_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ExposePodRequest) GetPodId() int64 {
+	if x != nil {
+		return x.PodId
+	}
+	return 0
+}
+
+func (x *ExposePodRequest) GetSvcType() string {
+	if x != nil {
+		return x.SvcType
+	}
+	return ""
+}
+
+func (x *ExposePodRequest) GetSvcName() string {
+	if x != nil {
+		return x.SvcName
+	}
+	return ""
+}
+
+// 根据命名空间和名称查找
+type SvcNamespaceName struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name      string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *SvcNamespaceName) Reset() {
+	*x = SvcNamespaceName{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_svc_svc_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SvcNamespaceName) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SvcNamespaceName) ProtoMessage() {}
+
+func (x *SvcNamespaceName) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_svc_svc_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SvcNamespaceName.ProtoReflect.Descriptor instead.
+func (*SvcNamespaceName) Descriptor() ([]byte, []int) {
+	return file_proto_svc_svc_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SvcNamespaceName) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *SvcNamespaceName) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
 // 查找全部service
 type FindAll struct {
 	state         protoimpl.MessageState
@@ -270,7 +494,7 @@ type FindAll struct {
 func (x *FindAll) Reset() {
 	*x = FindAll{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_svc_svc_proto_msgTypes[3]
+		mi := &file_proto_svc_svc_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -283,7 +507,7 @@ func (x *FindAll) String() string {
 func (*FindAll) ProtoMessage() {}
 
 func (x *FindAll) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_svc_svc_proto_msgTypes[3]
+	mi := &file_proto_svc_svc_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -296,7 +520,7 @@ func (x *FindAll) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FindAll.ProtoReflect.Descriptor instead.
 func (*FindAll) Descriptor() ([]byte, []int) {
-	return file_proto_svc_svc_proto_rawDescGZIP(), []int{3}
+	return file_proto_svc_svc_proto_rawDescGZIP(), []int{6}
 }
 
 // 回应
@@ -311,7 +535,7 @@ type Response struct {
 func (x *Response) Reset() {
 	*x = Response{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_svc_svc_proto_msgTypes[4]
+		mi := &file_proto_svc_svc_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -324,7 +548,7 @@ func (x *Response) String() string {
 func (*Response) ProtoMessage() {}
 
 func (x *Response) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_svc_svc_proto_msgTypes[4]
+	mi := &file_proto_svc_svc_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -337,7 +561,7 @@ func (x *Response) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Response.ProtoReflect.Descriptor instead.
 func (*Response) Descriptor() ([]byte, []int) {
-	return file_proto_svc_svc_proto_rawDescGZIP(), []int{4}
+	return file_proto_svc_svc_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *Response) GetMsg() string {
@@ -359,7 +583,7 @@ type AllSvc struct {
 func (x *AllSvc) Reset() {
 	*x = AllSvc{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_svc_svc_proto_msgTypes[5]
+		mi := &file_proto_svc_svc_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -372,7 +596,7 @@ func (x *AllSvc) String() string {
 func (*AllSvc) ProtoMessage() {}
 
 func (x *AllSvc) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_svc_svc_proto_msgTypes[5]
+	mi := &file_proto_svc_svc_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -385,7 +609,7 @@ func (x *AllSvc) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AllSvc.ProtoReflect.Descriptor instead.
 func (*AllSvc) Descriptor() ([]byte, []int) {
-	return file_proto_svc_svc_proto_rawDescGZIP(), []int{5}
+	return file_proto_svc_svc_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *AllSvc) GetSvcInfo() []*SvcInfo {
@@ -395,12 +619,312 @@ func (x *AllSvc) GetSvcInfo() []*SvcInfo {
 	return nil
 }
 
+// 服务的端点状态，来自 EndpointSlice
+type SvcEndpoints struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SvcId        int64  `protobuf:"varint,1,opt,name=svc_id,json=svcId,proto3" json:"svc_id,omitempty"`
+	SvcNamespace string `protobuf:"bytes,2,opt,name=svc_namespace,json=svcNamespace,proto3" json:"svc_namespace,omitempty"`
+	SvcName      string `protobuf:"bytes,3,opt,name=svc_name,json=svcName,proto3" json:"svc_name,omitempty"`
+	// 服务的选择器，如 app-name=nginx
+	SvcSelector string `protobuf:"bytes,4,opt,name=svc_selector,json=svcSelector,proto3" json:"svc_selector,omitempty"`
+	// 选择器匹配到的pod数量
+	SvcMatchedPods    int32          `protobuf:"varint,5,opt,name=svc_matched_pods,json=svcMatchedPods,proto3" json:"svc_matched_pods,omitempty"`
+	ReadyEndpoints    []*SvcEndpoint `protobuf:"bytes,6,rep,name=ready_endpoints,json=readyEndpoints,proto3" json:"ready_endpoints,omitempty"`
+	NotReadyEndpoints []*SvcEndpoint `protobuf:"bytes,7,rep,name=not_ready_endpoints,json=notReadyEndpoints,proto3" json:"not_ready_endpoints,omitempty"`
+	// 常见的配置问题，如选择器没有匹配到任何pod
+	Warnings []string `protobuf:"bytes,8,rep,name=warnings,proto3" json:"warnings,omitempty"`
+}
+
+func (x *SvcEndpoints) Reset() {
+	*x = SvcEndpoints{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_svc_svc_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SvcEndpoints) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SvcEndpoints) ProtoMessage() {}
+
+func (x *SvcEndpoints) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_svc_svc_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SvcEndpoints.ProtoReflect.Descriptor instead.
+func (*SvcEndpoints) Descriptor() ([]byte, []int) {
+	return file_proto_svc_svc_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SvcEndpoints) GetSvcId() int64 {
+	if x != nil {
+		return x.SvcId
+	}
+	return 0
+}
+
+func (x *SvcEndpoints) GetSvcNamespace() string {
+	if x != nil {
+		return x.SvcNamespace
+	}
+	return ""
+}
+
+func (x *SvcEndpoints) GetSvcName() string {
+	if x != nil {
+		return x.SvcName
+	}
+	return ""
+}
+
+func (x *SvcEndpoints) GetSvcSelector() string {
+	if x != nil {
+		return x.SvcSelector
+	}
+	return ""
+}
+
+func (x *SvcEndpoints) GetSvcMatchedPods() int32 {
+	if x != nil {
+		return x.SvcMatchedPods
+	}
+	return 0
+}
+
+func (x *SvcEndpoints) GetReadyEndpoints() []*SvcEndpoint {
+	if x != nil {
+		return x.ReadyEndpoints
+	}
+	return nil
+}
+
+func (x *SvcEndpoints) GetNotReadyEndpoints() []*SvcEndpoint {
+	if x != nil {
+		return x.NotReadyEndpoints
+	}
+	return nil
+}
+
+func (x *SvcEndpoints) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+// 端点信息
+type SvcEndpoint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address  string             `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	PodName  string             `protobuf:"bytes,2,opt,name=pod_name,json=podName,proto3" json:"pod_name,omitempty"`
+	NodeName string             `protobuf:"bytes,3,opt,name=node_name,json=nodeName,proto3" json:"node_name,omitempty"`
+	Ports    []*SvcEndpointPort `protobuf:"bytes,4,rep,name=ports,proto3" json:"ports,omitempty"`
+	// pod正在终止
+	Terminating bool `protobuf:"varint,5,opt,name=terminating,proto3" json:"terminating,omitempty"`
+}
+
+func (x *SvcEndpoint) Reset() {
+	*x = SvcEndpoint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_svc_svc_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SvcEndpoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SvcEndpoint) ProtoMessage() {}
+
+func (x *SvcEndpoint) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_svc_svc_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SvcEndpoint.ProtoReflect.Descriptor instead.
+func (*SvcEndpoint) Descriptor() ([]byte, []int) {
+	return file_proto_svc_svc_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SvcEndpoint) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *SvcEndpoint) GetPodName() string {
+	if x != nil {
+		return x.PodName
+	}
+	return ""
+}
+
+func (x *SvcEndpoint) GetNodeName() string {
+	if x != nil {
+		return x.NodeName
+	}
+	return ""
+}
+
+func (x *SvcEndpoint) GetPorts() []*SvcEndpointPort {
+	if x != nil {
+		return x.Ports
+	}
+	return nil
+}
+
+func (x *SvcEndpoint) GetTerminating() bool {
+	if x != nil {
+		return x.Terminating
+	}
+	return false
+}
+
+// 端点端口
+type SvcEndpointPort struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Port     int32  `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+	Protocol string `protobuf:"bytes,3,opt,name=protocol,proto3" json:"protocol,omitempty"`
+}
+
+func (x *SvcEndpointPort) Reset() {
+	*x = SvcEndpointPort{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_svc_svc_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SvcEndpointPort) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SvcEndpointPort) ProtoMessage() {}
+
+func (x *SvcEndpointPort) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_svc_svc_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SvcEndpointPort.ProtoReflect.Descriptor instead.
+func (*SvcEndpointPort) Descriptor() ([]byte, []int) {
+	return file_proto_svc_svc_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SvcEndpointPort) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SvcEndpointPort) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *SvcEndpointPort) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+// 导出的k8s对象
+type ExportResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Yaml string `protobuf:"bytes,1,opt,name=yaml,proto3" json:"yaml,omitempty"`
+}
+
+func (x *ExportResult) Reset() {
+	*x = ExportResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_svc_svc_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportResult) ProtoMessage() {}
+
+func (x *ExportResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_svc_svc_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportResult.ProtoReflect.Descriptor instead.
+func (*ExportResult) Descriptor() ([]byte, []int) {
+	return file_proto_svc_svc_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ExportResult) GetYaml() string {
+	if x != nil {
+		return x.Yaml
+	}
+	return ""
+}
+
 var File_proto_svc_svc_proto protoreflect.FileDescriptor
 
 var file_proto_svc_svc_proto_rawDesc = []byte{
 	0x0a, 0x13, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x73, 0x76, 0x63, 0x2f, 0x73, 0x76, 0x63, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x22, 0x8f,
-	0x02, 0x0a, 0x07, 0x53, 0x76, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x22, 0xc8,
+	0x04, 0x0a, 0x07, 0x53, 0x76, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x76,
 	0x63, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x0c, 0x73, 0x76, 0x63, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12,
@@ -417,44 +941,136 @@ var file_proto_svc_svc_proto_rawDesc = []byte{
 	0x6d, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x08, 0x73, 0x76, 0x63, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18,
 	0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
 	0x53, 0x76, 0x63, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x07, 0x73, 0x76, 0x63, 0x50, 0x6f, 0x72, 0x74,
-	0x22, 0xc3, 0x01, 0x0a, 0x07, 0x53, 0x76, 0x63, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x15, 0x0a, 0x06,
-	0x73, 0x76, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x76,
-	0x63, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x76, 0x63, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x73, 0x76, 0x63, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x26,
-	0x0a, 0x0f, 0x73, 0x76, 0x63, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x70, 0x6f, 0x72,
-	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x73, 0x76, 0x63, 0x54, 0x61, 0x72, 0x67,
-	0x65, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x73, 0x76, 0x63, 0x5f, 0x6e, 0x6f,
-	0x64, 0x65, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x73,
-	0x76, 0x63, 0x4e, 0x6f, 0x64, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x73, 0x76,
-	0x63, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x73, 0x76, 0x63, 0x50, 0x6f, 0x72, 0x74, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x22, 0x17, 0x0a, 0x05, 0x53, 0x76, 0x63, 0x49, 0x44, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22,
-	0x09, 0x0a, 0x07, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x22, 0x1c, 0x0a, 0x08, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x22, 0x35, 0x0a, 0x06, 0x41, 0x6c, 0x6c, 0x53,
-	0x76, 0x63, 0x12, 0x2b, 0x0a, 0x08, 0x73, 0x76, 0x63, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53,
-	0x76, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x73, 0x76, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x32,
-	0x82, 0x02, 0x0a, 0x03, 0x53, 0x76, 0x63, 0x12, 0x2f, 0x0a, 0x06, 0x41, 0x64, 0x64, 0x53, 0x76,
-	0x63, 0x12, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x76, 0x63, 0x49,
-	0x6e, 0x66, 0x6f, 0x1a, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x30, 0x0a, 0x09, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x53, 0x76, 0x63, 0x12, 0x0e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
-	0x53, 0x76, 0x63, 0x49, 0x44, 0x1a, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x32, 0x0a, 0x09, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x53, 0x76, 0x63, 0x12, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x2e, 0x53, 0x76, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x31,
-	0x0a, 0x0b, 0x46, 0x69, 0x6e, 0x64, 0x53, 0x76, 0x63, 0x42, 0x79, 0x49, 0x44, 0x12, 0x0e, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x76, 0x63, 0x49, 0x44, 0x1a, 0x10, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x76, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x22,
-	0x00, 0x12, 0x31, 0x0a, 0x0a, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x53, 0x76, 0x63, 0x12,
-	0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c,
-	0x6c, 0x1a, 0x0f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x6c, 0x6c, 0x53,
-	0x76, 0x63, 0x22, 0x00, 0x42, 0x11, 0x5a, 0x0f, 0x2e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
-	0x73, 0x76, 0x63, 0x3b, 0x73, 0x76, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x12, 0x21, 0x0a, 0x0c, 0x73, 0x76, 0x63, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x6c, 0x65, 0x73, 0x73,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x73, 0x76, 0x63, 0x48, 0x65, 0x61, 0x64, 0x6c,
+	0x65, 0x73, 0x73, 0x12, 0x44, 0x0a, 0x1f, 0x73, 0x76, 0x63, 0x5f, 0x6c, 0x6f, 0x61, 0x64, 0x5f,
+	0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f,
+	0x72, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1b, 0x73, 0x76,
+	0x63, 0x4c, 0x6f, 0x61, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x3d, 0x0a, 0x1b, 0x73, 0x76, 0x63,
+	0x5f, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69,
+	0x63, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x18,
+	0x73, 0x76, 0x63, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x54, 0x72, 0x61, 0x66, 0x66,
+	0x69, 0x63, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x30, 0x0a, 0x14, 0x73, 0x76, 0x63, 0x5f,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x66, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x79,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x73, 0x76, 0x63, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x41, 0x66, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x79, 0x12, 0x3f, 0x0a, 0x1c, 0x73, 0x76,
+	0x63, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x66, 0x66, 0x69, 0x6e, 0x69,
+	0x74, 0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x19, 0x73, 0x76, 0x63, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x66, 0x66, 0x69,
+	0x6e, 0x69, 0x74, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x1c, 0x0a, 0x0a, 0x73,
+	0x76, 0x63, 0x5f, 0x70, 0x6f, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x73, 0x76, 0x63, 0x50, 0x6f, 0x64, 0x49, 0x64, 0x22, 0xc3, 0x01, 0x0a, 0x07, 0x53, 0x76,
+	0x63, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x73, 0x76, 0x63, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x76, 0x63, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08,
+	0x73, 0x76, 0x63, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07,
+	0x73, 0x76, 0x63, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x73, 0x76, 0x63, 0x5f, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0d, 0x73, 0x76, 0x63, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x12,
+	0x22, 0x0a, 0x0d, 0x73, 0x76, 0x63, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x70, 0x6f, 0x72, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x73, 0x76, 0x63, 0x4e, 0x6f, 0x64, 0x65, 0x50,
+	0x6f, 0x72, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x73, 0x76, 0x63, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x5f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
+	0x73, 0x76, 0x63, 0x50, 0x6f, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x22,
+	0x17, 0x0a, 0x05, 0x53, 0x76, 0x63, 0x49, 0x44, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x21, 0x0a, 0x08, 0x53, 0x76, 0x63, 0x50,
+	0x6f, 0x64, 0x49, 0x44, 0x12, 0x15, 0x0a, 0x06, 0x70, 0x6f, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x6f, 0x64, 0x49, 0x64, 0x22, 0x5f, 0x0a, 0x10, 0x45,
+	0x78, 0x70, 0x6f, 0x73, 0x65, 0x50, 0x6f, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x15, 0x0a, 0x06, 0x70, 0x6f, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x70, 0x6f, 0x64, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x76, 0x63, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x76, 0x63, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x76, 0x63, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x76, 0x63, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x44, 0x0a, 0x10,
+	0x53, 0x76, 0x63, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x22, 0x09, 0x0a, 0x07, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x22, 0x1c, 0x0a,
+	0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x22, 0x35, 0x0a, 0x06, 0x41,
+	0x6c, 0x6c, 0x53, 0x76, 0x63, 0x12, 0x2b, 0x0a, 0x08, 0x73, 0x76, 0x63, 0x5f, 0x69, 0x6e, 0x66,
+	0x6f, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x53, 0x76, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x73, 0x76, 0x63, 0x49, 0x6e,
+	0x66, 0x6f, 0x22, 0xd3, 0x02, 0x0a, 0x0c, 0x53, 0x76, 0x63, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x73, 0x12, 0x15, 0x0a, 0x06, 0x73, 0x76, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x76, 0x63, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x76,
+	0x63, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x73, 0x76, 0x63, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12,
+	0x19, 0x0a, 0x08, 0x73, 0x76, 0x63, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x73, 0x76, 0x63, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x76,
+	0x63, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x73, 0x76, 0x63, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x28, 0x0a,
+	0x10, 0x73, 0x76, 0x63, 0x5f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x70, 0x6f, 0x64,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x73, 0x76, 0x63, 0x4d, 0x61, 0x74, 0x63,
+	0x68, 0x65, 0x64, 0x50, 0x6f, 0x64, 0x73, 0x12, 0x3d, 0x0a, 0x0f, 0x72, 0x65, 0x61, 0x64, 0x79,
+	0x5f, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x76, 0x63, 0x45, 0x6e,
+	0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x0e, 0x72, 0x65, 0x61, 0x64, 0x79, 0x45, 0x6e, 0x64,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x44, 0x0a, 0x13, 0x6e, 0x6f, 0x74, 0x5f, 0x72, 0x65,
+	0x61, 0x64, 0x79, 0x5f, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x07, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x76,
+	0x63, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x11, 0x6e, 0x6f, 0x74, 0x52, 0x65,
+	0x61, 0x64, 0x79, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08,
+	0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08,
+	0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x22, 0xb1, 0x01, 0x0a, 0x0b, 0x53, 0x76, 0x63,
+	0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x6f, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x6f, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a,
+	0x09, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x05, 0x70, 0x6f,
+	0x72, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x53, 0x76, 0x63, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x50,
+	0x6f, 0x72, 0x74, 0x52, 0x05, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x65,
+	0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0b, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x22, 0x55, 0x0a, 0x0f,
+	0x53, 0x76, 0x63, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x22, 0x22, 0x0a, 0x0c, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x79, 0x61, 0x6d, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x79, 0x61, 0x6d, 0x6c, 0x32, 0xb3, 0x04, 0x0a, 0x03, 0x53, 0x76, 0x63, 0x12,
+	0x2f, 0x0a, 0x06, 0x41, 0x64, 0x64, 0x53, 0x76, 0x63, 0x12, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x53, 0x76, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x11, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x30, 0x0a, 0x09, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x76, 0x63, 0x12, 0x0e, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x76, 0x63, 0x49, 0x44, 0x1a, 0x11, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x32, 0x0a, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x76, 0x63, 0x12,
+	0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x76, 0x63, 0x49, 0x6e, 0x66,
+	0x6f, 0x1a, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x0b, 0x46, 0x69, 0x6e, 0x64, 0x53, 0x76,
+	0x63, 0x42, 0x79, 0x49, 0x44, 0x12, 0x0e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x53, 0x76, 0x63, 0x49, 0x44, 0x1a, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x53, 0x76, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x19, 0x46, 0x69, 0x6e,
+	0x64, 0x53, 0x76, 0x63, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x41,
+	0x6e, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x53, 0x76, 0x63, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x1a, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x76, 0x63, 0x49,
+	0x6e, 0x66, 0x6f, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x0a, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c,
+	0x53, 0x76, 0x63, 0x12, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x46, 0x69,
+	0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x1a, 0x0f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x41, 0x6c, 0x6c, 0x53, 0x76, 0x63, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x53,
+	0x76, 0x63, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x0e, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x76, 0x63, 0x49, 0x44, 0x1a, 0x15, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x76, 0x63, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x73, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x09, 0x45, 0x78, 0x70, 0x6f, 0x73, 0x65, 0x50, 0x6f,
+	0x64, 0x12, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x45, 0x78, 0x70, 0x6f,
+	0x73, 0x65, 0x50, 0x6f, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x34, 0x0a, 0x0a, 0x53, 0x79, 0x6e, 0x63, 0x50, 0x6f, 0x64, 0x53, 0x76, 0x63, 0x12,
+	0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x76, 0x63, 0x50, 0x6f, 0x64,
+	0x49, 0x44, 0x1a, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x09, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x53, 0x76, 0x63, 0x12, 0x0e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53,
+	0x76, 0x63, 0x49, 0x44, 0x1a, 0x15, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x00, 0x42, 0x11, 0x5a,
+	0x0f, 0x2e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x73, 0x76, 0x63, 0x3b, 0x73, 0x76, 0x63,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -469,33 +1085,53 @@ func file_proto_svc_svc_proto_rawDescGZIP() []byte {
 	return file_proto_svc_svc_proto_rawDescData
 }
 
-var file_proto_svc_svc_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_proto_svc_svc_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
 var file_proto_svc_svc_proto_goTypes = []interface{}{
-	(*SvcInfo)(nil),  // 0: service.SvcInfo
-	(*SvcPort)(nil),  // 1: service.SvcPort
-	(*SvcID)(nil),    // 2: service.SvcID
-	(*FindAll)(nil),  // 3: service.FindAll
-	(*Response)(nil), // 4: service.Response
-	(*AllSvc)(nil),   // 5: service.AllSvc
+	(*SvcInfo)(nil),          // 0: service.SvcInfo
+	(*SvcPort)(nil),          // 1: service.SvcPort
+	(*SvcID)(nil),            // 2: service.SvcID
+	(*SvcPodID)(nil),         // 3: service.SvcPodID
+	(*ExposePodRequest)(nil), // 4: service.ExposePodRequest
+	(*SvcNamespaceName)(nil), // 5: service.SvcNamespaceName
+	(*FindAll)(nil),          // 6: service.FindAll
+	(*Response)(nil),         // 7: service.Response
+	(*AllSvc)(nil),           // 8: service.AllSvc
+	(*SvcEndpoints)(nil),     // 9: service.SvcEndpoints
+	(*SvcEndpoint)(nil),      // 10: service.SvcEndpoint
+	(*SvcEndpointPort)(nil),  // 11: service.SvcEndpointPort
+	(*ExportResult)(nil),     // 12: service.ExportResult
 }
 var file_proto_svc_svc_proto_depIdxs = []int32{
-	1, // 0: service.SvcInfo.svc_port:type_name -> service.SvcPort
-	0, // 1: service.AllSvc.svc_info:type_name -> service.SvcInfo
-	0, // 2: service.Svc.AddSvc:input_type -> service.SvcInfo
-	2, // 3: service.Svc.DeleteSvc:input_type -> service.SvcID
-	0, // 4: service.Svc.UpdateSvc:input_type -> service.SvcInfo
-	2, // 5: service.Svc.FindSvcByID:input_type -> service.SvcID
-	3, // 6: service.Svc.FindAllSvc:input_type -> service.FindAll
-	4, // 7: service.Svc.AddSvc:output_type -> service.Response
-	4, // 8: service.Svc.DeleteSvc:output_type -> service.Response
-	4, // 9: service.Svc.UpdateSvc:output_type -> service.Response
-	0, // 10: service.Svc.FindSvcByID:output_type -> service.SvcInfo
-	5, // 11: service.Svc.FindAllSvc:output_type -> service.AllSvc
-	7, // [7:12] is the sub-list for method output_type
-	2, // [2:7] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	1,  // 0: service.SvcInfo.svc_port:type_name -> service.SvcPort
+	0,  // 1: service.AllSvc.svc_info:type_name -> service.SvcInfo
+	10, // 2: service.SvcEndpoints.ready_endpoints:type_name -> service.SvcEndpoint
+	10, // 3: service.SvcEndpoints.not_ready_endpoints:type_name -> service.SvcEndpoint
+	11, // 4: service.SvcEndpoint.ports:type_name -> service.SvcEndpointPort
+	0,  // 5: service.Svc.AddSvc:input_type -> service.SvcInfo
+	2,  // 6: service.Svc.DeleteSvc:input_type -> service.SvcID
+	0,  // 7: service.Svc.UpdateSvc:input_type -> service.SvcInfo
+	2,  // 8: service.Svc.FindSvcByID:input_type -> service.SvcID
+	5,  // 9: service.Svc.FindSvcByNamespaceAndName:input_type -> service.SvcNamespaceName
+	6,  // 10: service.Svc.FindAllSvc:input_type -> service.FindAll
+	2,  // 11: service.Svc.GetSvcEndpoints:input_type -> service.SvcID
+	4,  // 12: service.Svc.ExposePod:input_type -> service.ExposePodRequest
+	3,  // 13: service.Svc.SyncPodSvc:input_type -> service.SvcPodID
+	2,  // 14: service.Svc.ExportSvc:input_type -> service.SvcID
+	7,  // 15: service.Svc.AddSvc:output_type -> service.Response
+	7,  // 16: service.Svc.DeleteSvc:output_type -> service.Response
+	7,  // 17: service.Svc.UpdateSvc:output_type -> service.Response
+	0,  // 18: service.Svc.FindSvcByID:output_type -> service.SvcInfo
+	0,  // 19: service.Svc.FindSvcByNamespaceAndName:output_type -> service.SvcInfo
+	8,  // 20: service.Svc.FindAllSvc:output_type -> service.AllSvc
+	9,  // 21: service.Svc.GetSvcEndpoints:output_type -> service.SvcEndpoints
+	7,  // 22: service.Svc.ExposePod:output_type -> service.Response
+	7,  // 23: service.Svc.SyncPodSvc:output_type -> service.Response
+	12, // 24: service.Svc.ExportSvc:output_type -> service.ExportResult
+	15, // [15:25] is the sub-list for method output_type
+	5,  // [5:15] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_proto_svc_svc_proto_init() }
@@ -541,7 +1177,7 @@ func file_proto_svc_svc_proto_init() {
 			}
 		}
 		file_proto_svc_svc_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FindAll); i {
+			switch v := v.(*SvcPodID); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -553,7 +1189,7 @@ func file_proto_svc_svc_proto_init() {
 			}
 		}
 		file_proto_svc_svc_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Response); i {
+			switch v := v.(*ExposePodRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -565,6 +1201,42 @@ func file_proto_svc_svc_proto_init() {
 			}
 		}
 		file_proto_svc_svc_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SvcNamespaceName); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_svc_svc_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindAll); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_svc_svc_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_svc_svc_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AllSvc); i {
 			case 0:
 				return &v.state
@@ -576,6 +1248,54 @@ func file_proto_svc_svc_proto_init() {
 				return nil
 			}
 		}
+		file_proto_svc_svc_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SvcEndpoints); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_svc_svc_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SvcEndpoint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_svc_svc_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SvcEndpointPort); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_svc_svc_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -583,7 +1303,7 @@ func file_proto_svc_svc_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_svc_svc_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -40,7 +40,13 @@ type SvcService interface {
 	DeleteSvc(ctx context.Context, in *SvcID, opts ...client.CallOption) (*Response, error)
 	UpdateSvc(ctx context.Context, in *SvcInfo, opts ...client.CallOption) (*Response, error)
 	FindSvcByID(ctx context.Context, in *SvcID, opts ...client.CallOption) (*SvcInfo, error)
+	FindSvcByNamespaceAndName(ctx context.Context, in *SvcNamespaceName, opts ...client.CallOption) (*SvcInfo, error)
 	FindAllSvc(ctx context.Context, in *FindAll, opts ...client.CallOption) (*AllSvc, error)
+	GetSvcEndpoints(ctx context.Context, in *SvcID, opts ...client.CallOption) (*SvcEndpoints, error)
+	ExposePod(ctx context.Context, in *ExposePodRequest, opts ...client.CallOption) (*Response, error)
+	SyncPodSvc(ctx context.Context, in *SvcPodID, opts ...client.CallOption) (*Response, error)
+	// 导出应用到k8s的对象，多文档YAML
+	ExportSvc(ctx context.Context, in *SvcID, opts ...client.CallOption) (*ExportResult, error)
 }
 
 type svcService struct {
@@ -95,6 +101,16 @@ func (c *svcService) FindSvcByID(ctx context.Context, in *SvcID, opts ...client.
 	return out, nil
 }
 
+func (c *svcService) FindSvcByNamespaceAndName(ctx context.Context, in *SvcNamespaceName, opts ...client.CallOption) (*SvcInfo, error) {
+	req := c.c.NewRequest(c.name, "Svc.FindSvcByNamespaceAndName", in)
+	out := new(SvcInfo)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *svcService) FindAllSvc(ctx context.Context, in *FindAll, opts ...client.CallOption) (*AllSvc, error) {
 	req := c.c.NewRequest(c.name, "Svc.FindAllSvc", in)
 	out := new(AllSvc)
@@ -105,6 +121,46 @@ func (c *svcService) FindAllSvc(ctx context.Context, in *FindAll, opts ...client
 	return out, nil
 }
 
+func (c *svcService) GetSvcEndpoints(ctx context.Context, in *SvcID, opts ...client.CallOption) (*SvcEndpoints, error) {
+	req := c.c.NewRequest(c.name, "Svc.GetSvcEndpoints", in)
+	out := new(SvcEndpoints)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *svcService) ExposePod(ctx context.Context, in *ExposePodRequest, opts ...client.CallOption) (*Response, error) {
+	req := c.c.NewRequest(c.name, "Svc.ExposePod", in)
+	out := new(Response)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *svcService) SyncPodSvc(ctx context.Context, in *SvcPodID, opts ...client.CallOption) (*Response, error) {
+	req := c.c.NewRequest(c.name, "Svc.SyncPodSvc", in)
+	out := new(Response)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *svcService) ExportSvc(ctx context.Context, in *SvcID, opts ...client.CallOption) (*ExportResult, error) {
+	req := c.c.NewRequest(c.name, "Svc.ExportSvc", in)
+	out := new(ExportResult)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for Svc service
 
 type SvcHandler interface {
@@ -112,7 +168,13 @@ type SvcHandler interface {
 	DeleteSvc(context.Context, *SvcID, *Response) error
 	UpdateSvc(context.Context, *SvcInfo, *Response) error
 	FindSvcByID(context.Context, *SvcID, *SvcInfo) error
+	FindSvcByNamespaceAndName(context.Context, *SvcNamespaceName, *SvcInfo) error
 	FindAllSvc(context.Context, *FindAll, *AllSvc) error
+	GetSvcEndpoints(context.Context, *SvcID, *SvcEndpoints) error
+	ExposePod(context.Context, *ExposePodRequest, *Response) error
+	SyncPodSvc(context.Context, *SvcPodID, *Response) error
+	// 导出应用到k8s的对象，多文档YAML
+	ExportSvc(context.Context, *SvcID, *ExportResult) error
 }
 
 func RegisterSvcHandler(s server.Server, hdlr SvcHandler, opts ...server.HandlerOption) error {
@@ -121,7 +183,12 @@ func RegisterSvcHandler(s server.Server, hdlr SvcHandler, opts ...server.Handler
 		DeleteSvc(ctx context.Context, in *SvcID, out *Response) error
 		UpdateSvc(ctx context.Context, in *SvcInfo, out *Response) error
 		FindSvcByID(ctx context.Context, in *SvcID, out *SvcInfo) error
+		FindSvcByNamespaceAndName(ctx context.Context, in *SvcNamespaceName, out *SvcInfo) error
 		FindAllSvc(ctx context.Context, in *FindAll, out *AllSvc) error
+		GetSvcEndpoints(ctx context.Context, in *SvcID, out *SvcEndpoints) error
+		ExposePod(ctx context.Context, in *ExposePodRequest, out *Response) error
+		SyncPodSvc(ctx context.Context, in *SvcPodID, out *Response) error
+		ExportSvc(ctx context.Context, in *SvcID, out *ExportResult) error
 	}
 	type Svc struct {
 		svc
@@ -150,6 +217,26 @@ func (h *svcHandler) FindSvcByID(ctx context.Context, in *SvcID, out *SvcInfo) e
 	return h.SvcHandler.FindSvcByID(ctx, in, out)
 }
 
+func (h *svcHandler) FindSvcByNamespaceAndName(ctx context.Context, in *SvcNamespaceName, out *SvcInfo) error {
+	return h.SvcHandler.FindSvcByNamespaceAndName(ctx, in, out)
+}
+
 func (h *svcHandler) FindAllSvc(ctx context.Context, in *FindAll, out *AllSvc) error {
 	return h.SvcHandler.FindAllSvc(ctx, in, out)
 }
+
+func (h *svcHandler) GetSvcEndpoints(ctx context.Context, in *SvcID, out *SvcEndpoints) error {
+	return h.SvcHandler.GetSvcEndpoints(ctx, in, out)
+}
+
+func (h *svcHandler) ExposePod(ctx context.Context, in *ExposePodRequest, out *Response) error {
+	return h.SvcHandler.ExposePod(ctx, in, out)
+}
+
+func (h *svcHandler) SyncPodSvc(ctx context.Context, in *SvcPodID, out *Response) error {
+	return h.SvcHandler.SyncPodSvc(ctx, in, out)
+}
+
+func (h *svcHandler) ExportSvc(ctx context.Context, in *SvcID, out *ExportResult) error {
+	return h.SvcHandler.ExportSvc(ctx, in, out)
+}
@@ -11,6 +11,9 @@ type SvcRepository interface {
 	// InitTable 初始化表
 	InitTable() error
 
+	// MigrateTable 迁移已有数据表
+	MigrateTable() error
+
 	// CreateSvc 创建一条service数据
 	CreateSvc(*model.Svc) (int64, error)
 
@@ -23,8 +26,17 @@ type SvcRepository interface {
 	// FindSvcByID 根据ID查找数据
 	FindSvcByID(int64) (*model.Svc, error)
 
+	// FindSvcByNamespaceAndName 根据命名空间和名称查找数据
+	FindSvcByNamespaceAndName(string, string) (*model.Svc, error)
+
 	// FindAll 查找所有service数据
 	FindAll() ([]model.Svc, error)
+
+	// FindAllByPodID 查找关联到pod的service数据
+	FindAllByPodID(int64) ([]model.Svc, error)
+
+	// ReplaceSvcPorts 用新的端口替换service的全部端口
+	ReplaceSvcPorts(int64, []model.SvcPort) error
 }
 
 // NewSvcRepository 初始化ServiceRepository
@@ -45,6 +57,15 @@ func (s *Svc) InitTable() error {
 	return s.db.CreateTable(&model.Svc{}, &model.SvcPort{}).Error
 }
 
+// MigrateTable 迁移已有数据表，名称唯一改为同一命名空间内唯一，并补充服务类型和关联pod等新增的字段
+func (s *Svc) MigrateTable() error {
+	err := common.MigrateUniqueIndex(s.db, &model.Svc{}, "uix_svcs_svc_name", "idx_svc_namespace_name", "svc_namespace", "svc_name")
+	if err != nil {
+		return err
+	}
+	return common.MigrateTables(s.db, &model.Svc{}, &model.SvcPort{})
+}
+
 // CreateSvc 创建一条service数据
 func (s *Svc) CreateSvc(service *model.Svc) (int64, error) {
 	return service.ID, s.db.Create(service).Error
@@ -95,8 +116,39 @@ func (s *Svc) FindSvcByID(i int64) (*model.Svc, error) {
 	return service, s.db.Preload("SvcPort").First(service, i).Error
 }
 
+// FindSvcByNamespaceAndName 根据命名空间和名称查找数据
+func (s *Svc) FindSvcByNamespaceAndName(namespace, name string) (*model.Svc, error) {
+	service := &model.Svc{}
+	return service, s.db.Preload("SvcPort").Where("svc_namespace = ? AND svc_name = ?", namespace, name).First(service).Error
+}
+
 // FindAll 查找所有service数据
 func (s *Svc) FindAll() ([]model.Svc, error) {
 	var serviceAll []model.Svc
 	return serviceAll, s.db.Find(&serviceAll).Error
 }
+
+// FindAllByPodID 查找关联到pod的service数据
+func (s *Svc) FindAllByPodID(podID int64) ([]model.Svc, error) {
+	var serviceAll []model.Svc
+	return serviceAll, s.db.Preload("SvcPort").Where("svc_pod_id = ?", podID).Find(&serviceAll).Error
+}
+
+// ReplaceSvcPorts 用新的端口替换service的全部端口
+func (s *Svc) ReplaceSvcPorts(svcID int64, ports []model.SvcPort) error {
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Where("svc_id = ?", svcID).Delete(&model.SvcPort{}).Error
+		if err != nil {
+			return err
+		}
+		for i := range ports {
+			ports[i].ID = 0
+			ports[i].SvcID = svcID
+			err = tx.Create(&ports[i]).Error
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,169 @@
+package service
+
+import (
+	"context"
+	v1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	v12 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sort"
+	"strconv"
+	"strings"
+	"tini-paas/internal/svc/model"
+	"tini-paas/internal/svc/proto/svc"
+)
+
+// GetSvcEndpoints 读取服务的 EndpointSlice，按照是否就绪写入端点，并检查选择器是否匹配到pod
+// 服务返回503时通常是没有就绪的端点，不需要登录集群就能看到原因
+func (s *SvcDataService) GetSvcEndpoints(m *model.Svc, result *svc.SvcEndpoints) error {
+	result.SvcId = m.ID
+	result.SvcNamespace = m.SvcNamespace
+	result.SvcName = m.SvcName
+	if getSvcType(m.SvcType) == v1.ServiceTypeExternalName {
+		result.Warnings = append(result.Warnings, "ExternalName 类型的服务只是DNS别名，没有端点")
+		return nil
+	}
+
+	service, err := s.K8sClientSet.CoreV1().Services(m.SvcNamespace).Get(context.TODO(), m.SvcName, v12.GetOptions{})
+	if err != nil {
+		return err
+	}
+	if len(service.Spec.Selector) == 0 {
+		result.Warnings = append(result.Warnings, "服务没有选择器，端点需要手动维护")
+	} else {
+		result.SvcSelector = labels.SelectorFromSet(service.Spec.Selector).String()
+		err = s.checkSelector(service, result)
+		if err != nil {
+			return err
+		}
+	}
+
+	// 一个服务可能有多个 EndpointSlice，双栈时IPv4和IPv6各一份
+	slices, err := s.K8sClientSet.DiscoveryV1().EndpointSlices(m.SvcNamespace).List(context.TODO(), v12.ListOptions{
+		LabelSelector: discoveryv1.LabelServiceName + "=" + m.SvcName,
+	})
+	if err != nil {
+		return err
+	}
+	for _, slice := range slices.Items {
+		ports := getEndpointPorts(slice.Ports)
+		for _, endpoint := range slice.Endpoints {
+			for _, address := range endpoint.Addresses {
+				item := &svc.SvcEndpoint{
+					Address:     address,
+					Ports:       ports,
+					Terminating: endpoint.Conditions.Terminating != nil && *endpoint.Conditions.Terminating,
+				}
+				if endpoint.TargetRef != nil && endpoint.TargetRef.Kind == "Pod" {
+					item.PodName = endpoint.TargetRef.Name
+				}
+				if endpoint.NodeName != nil {
+					item.NodeName = *endpoint.NodeName
+				}
+				// Ready 为空时按照就绪处理
+				if endpoint.Conditions.Ready == nil || *endpoint.Conditions.Ready {
+					result.ReadyEndpoints = append(result.ReadyEndpoints, item)
+				} else {
+					result.NotReadyEndpoints = append(result.NotReadyEndpoints, item)
+				}
+			}
+		}
+	}
+
+	if result.SvcMatchedPods > 0 && len(result.ReadyEndpoints) == 0 {
+		result.Warnings = append(result.Warnings, "匹配到 "+strconv.Itoa(int(result.SvcMatchedPods))+" 个pod，但是没有就绪的端点，请求会返回503，请检查pod的就绪探针")
+	}
+	return nil
+}
+
+// checkSelector 检查选择器匹配到的pod，没有匹配时给出可能的原因
+func (s *SvcDataService) checkSelector(service *v1.Service, result *svc.SvcEndpoints) error {
+	pods, err := s.K8sClientSet.CoreV1().Pods(service.Namespace).List(context.TODO(), v12.ListOptions{
+		LabelSelector: result.SvcSelector,
+	})
+	if err != nil {
+		return err
+	}
+	var matched []v1.Pod
+	for _, pod := range pods.Items {
+		if pod.Status.Phase != v1.PodSucceeded && pod.Status.Phase != v1.PodFailed {
+			matched = append(matched, pod)
+		}
+	}
+	result.SvcMatchedPods = int32(len(matched))
+
+	if len(matched) > 0 {
+		checkTargetPorts(service, matched, result)
+		return nil
+	}
+
+	// 选择器没有匹配到pod，常见原因是 SvcPodName 和pod的 app-name 标签不一致
+	msg := "选择器 " + result.SvcSelector + " 没有匹配到任何pod"
+	names, err := s.appNames(service.Namespace)
+	if err != nil {
+		return err
+	}
+	if len(names) > 0 {
+		msg += "，命名空间中pod的 app-name 标签有：" + strings.Join(names, ", ")
+	}
+	result.Warnings = append(result.Warnings, msg)
+	return nil
+}
+
+// appNames 命名空间中pod使用的 app-name 标签
+func (s *SvcDataService) appNames(namespace string) ([]string, error) {
+	pods, err := s.K8sClientSet.CoreV1().Pods(namespace).List(context.TODO(), v12.ListOptions{
+		LabelSelector: "app-name",
+	})
+	if err != nil {
+		return nil, err
+	}
+	exists := map[string]bool{}
+	var names []string
+	for _, pod := range pods.Items {
+		name := pod.Labels["app-name"]
+		if !exists[name] {
+			exists[name] = true
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// checkTargetPorts 检查数字目标端口是否是pod声明的容器端口，pod没有声明任何端口时不检查
+func checkTargetPorts(service *v1.Service, pods []v1.Pod, result *svc.SvcEndpoints) {
+	declared := map[int32]bool{}
+	for _, container := range pods[0].Spec.Containers {
+		for _, port := range container.Ports {
+			declared[port.ContainerPort] = true
+		}
+	}
+	if len(declared) == 0 {
+		return
+	}
+	for _, port := range service.Spec.Ports {
+		if port.TargetPort.IntVal != 0 && !declared[port.TargetPort.IntVal] {
+			result.Warnings = append(result.Warnings, "目标端口 "+port.TargetPort.String()+" 不是pod "+pods[0].Name+" 声明的容器端口")
+		}
+	}
+}
+
+// getEndpointPorts 转换端点端口
+func getEndpointPorts(ports []discoveryv1.EndpointPort) []*svc.SvcEndpointPort {
+	var result []*svc.SvcEndpointPort
+	for _, port := range ports {
+		item := &svc.SvcEndpointPort{}
+		if port.Name != nil {
+			item.Name = *port.Name
+		}
+		if port.Port != nil {
+			item.Port = *port.Port
+		}
+		if port.Protocol != nil {
+			item.Protocol = string(*port.Protocol)
+		}
+		result = append(result, item)
+	}
+	return result
+}
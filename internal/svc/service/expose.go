@@ -0,0 +1,109 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"tini-paas/internal/pod/proto/pod"
+	"tini-paas/internal/svc/model"
+	"tini-paas/internal/svc/proto/svc"
+	"tini-paas/pkg/common"
+)
+
+// BuildPodSvc 根据pod声明的端口生成服务，选择器使用pod的 app-name 标签，服务端口与容器端口相同
+func (s *SvcDataService) BuildPodSvc(req *svc.ExposePodRequest) (*svc.SvcInfo, error) {
+	podInfo, err := s.findPod(req.PodId)
+	if err != nil {
+		return nil, err
+	}
+
+	info := &svc.SvcInfo{
+		SvcNamespace: podInfo.PodNamespace,
+		SvcName:      req.SvcName,
+		SvcPodName:   podInfo.PodName,
+		SvcType:      req.SvcType,
+		SvcTeamId:    podInfo.PodTeamId,
+		SvcPodId:     podInfo.Id,
+		SvcPort:      getPodSvcPorts(podInfo, nil),
+	}
+	if info.SvcName == "" {
+		info.SvcName = podInfo.PodName
+	}
+	if len(info.SvcPort) == 0 {
+		return nil, errors.New("pod " + podInfo.PodName + " 没有声明端口，不能生成服务")
+	}
+	return info, nil
+}
+
+// SyncPodSvc pod端口变化后同步关联服务的端口，保留已经分配的节点端口
+func (s *SvcDataService) SyncPodSvc(podID int64) error {
+	services, err := s.ServiceRepository.FindAllByPodID(podID)
+	if err != nil {
+		return err
+	}
+	if len(services) == 0 {
+		return nil
+	}
+	podInfo, err := s.findPod(podID)
+	if err != nil {
+		return err
+	}
+
+	for i := range services {
+		info := &svc.SvcInfo{}
+		err = common.SwapTo(services[i], info)
+		if err != nil {
+			return err
+		}
+		info.Id = services[i].ID
+
+		ports := getPodSvcPorts(podInfo, info.SvcPort)
+		if len(ports) == 0 {
+			common.Info("pod " + podInfo.PodName + " 已经没有端口，服务 " + info.SvcName + " 保持不变")
+			continue
+		}
+		info.SvcPort = ports
+		err = s.UpdateSvcToK8s(info)
+		if err != nil {
+			return err
+		}
+
+		var svcPorts []model.SvcPort
+		err = common.SwapTo(info.SvcPort, &svcPorts)
+		if err != nil {
+			return err
+		}
+		err = s.ServiceRepository.ReplaceSvcPorts(info.Id, svcPorts)
+		if err != nil {
+			return err
+		}
+		common.Info("服务 " + info.SvcName + " 的端口已经与pod " + podInfo.PodName + " 同步")
+	}
+	return nil
+}
+
+// findPod 通过pod微服务查找pod
+func (s *SvcDataService) findPod(podID int64) (*pod.PodInfo, error) {
+	if s.PodService == nil {
+		return nil, errors.New("未配置pod服务")
+	}
+	return s.PodService.FindPodByID(context.TODO(), &pod.PodID{Id: podID})
+}
+
+// getPodSvcPorts 将pod的容器端口转换为服务端口，existing中相同端口和协议的节点端口保持不变
+func getPodSvcPorts(podInfo *pod.PodInfo, existing []*svc.SvcPort) []*svc.SvcPort {
+	var ports []*svc.SvcPort
+	for _, podPort := range podInfo.PodPort {
+		port := &svc.SvcPort{
+			SvcPort:         podPort.ContainerPort,
+			SvcTargetPort:   podPort.ContainerPort,
+			SvcPortProtocol: getProtocol(podPort.Protocol),
+		}
+		for _, old := range existing {
+			if old.SvcPort == port.SvcPort && getProtocol(old.SvcPortProtocol) == port.SvcPortProtocol {
+				port.SvcNodePort = old.SvcNodePort
+			}
+		}
+		ports = append(ports, port)
+	}
+	return ports
+}
@@ -2,12 +2,14 @@ package service
 
 import (
 	"context"
-	"errors"
 	v1 "k8s.io/api/core/v1"
 	v12 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/client-go/kubernetes"
 	"strconv"
+	"strings"
+	"tini-paas/internal/pod/proto/pod"
 	"tini-paas/internal/svc/model"
 	"tini-paas/internal/svc/proto/svc"
 	"tini-paas/internal/svc/repository"
@@ -28,24 +30,40 @@ type SvcService interface {
 	// FindSvcByID 根据ID查找service
 	FindSvcByID(int64) (*model.Svc, error)
 
+	// FindSvcByNamespaceAndName 根据命名空间和名称查找service
+	FindSvcByNamespaceAndName(string, string) (*model.Svc, error)
+
 	// FindAllSvc 查找全部service
 	FindAllSvc() ([]model.Svc, error)
 
-	// CreateSvcToK8s 创建服务到k8s
+	// CreateSvcToK8s 创建服务到k8s，k8s分配的节点端口会回写到info
 	CreateSvcToK8s(*svc.SvcInfo) error
 
-	// UpdateSvcToK8s 更新服务到k8s
+	// UpdateSvcToK8s 更新服务到k8s，k8s分配的节点端口会回写到info
 	UpdateSvcToK8s(*svc.SvcInfo) error
 
 	// DeleteFromK8s 从k8s删除服务
 	DeleteFromK8s(*model.Svc) error
+
+	// GetSvcEndpoints 查看服务的就绪和未就绪端点
+	GetSvcEndpoints(*model.Svc, *svc.SvcEndpoints) error
+
+	// BuildPodSvc 根据pod声明的端口生成服务
+	BuildPodSvc(*svc.ExposePodRequest) (*svc.SvcInfo, error)
+
+	// SyncPodSvc pod端口变化后同步关联服务的端口
+	SyncPodSvc(int64) error
+
+	// ExportYAML 导出应用到k8s的service
+	ExportYAML(*svc.SvcInfo) (string, error)
 }
 
 // NewService 初始化Service
-func NewService(serviceRepository repository.SvcRepository, clientSet *kubernetes.Clientset) SvcService {
+func NewService(serviceRepository repository.SvcRepository, clientSet *kubernetes.Clientset, podService pod.PodService) SvcService {
 	return &SvcDataService{
 		ServiceRepository: serviceRepository,
 		K8sClientSet:      clientSet,
+		PodService:        podService,
 	}
 }
 
@@ -56,6 +74,9 @@ type SvcDataService struct {
 
 	// K8sClientSet k8s客户端集合
 	K8sClientSet *kubernetes.Clientset
+
+	// PodService pod微服务客户端，根据pod端口生成和同步服务
+	PodService pod.PodService
 }
 
 // AddSvc 添加service
@@ -78,56 +99,79 @@ func (s *SvcDataService) FindSvcByID(i int64) (*model.Svc, error) {
 	return s.ServiceRepository.FindSvcByID(i)
 }
 
+// FindSvcByNamespaceAndName 根据命名空间和名称查找service
+func (s *SvcDataService) FindSvcByNamespaceAndName(namespace, name string) (*model.Svc, error) {
+	return s.ServiceRepository.FindSvcByNamespaceAndName(namespace, name)
+}
+
 // FindAllSvc 查找全部service
 func (s *SvcDataService) FindAllSvc() ([]model.Svc, error) {
 	return s.ServiceRepository.FindAll()
 }
 
 // CreateSvcToK8s 创建服务到k8s
+// 采用服务端应用(server-side apply)，平台创建过的同名对象按照重试处理，不是平台创建的同名对象返回错误
 func (s *SvcDataService) CreateSvcToK8s(info *svc.SvcInfo) error {
-	svc := s.setService(info)
-
-	// 查找是否存在指定服务
-	_, err := s.K8sClientSet.CoreV1().Services(info.SvcNamespace).Get(context.TODO(), info.SvcName, v12.GetOptions{})
+	err := common.CheckCreate("SvcService", info.SvcName, func() (v12.Object, error) {
+		return s.K8sClientSet.CoreV1().Services(info.SvcNamespace).Get(context.TODO(), info.SvcName, v12.GetOptions{})
+	})
 	if err != nil {
-		// 如果不存在 -> 创建service
-		_, err = s.K8sClientSet.CoreV1().Services(info.SvcNamespace).Create(context.TODO(), svc, v12.CreateOptions{})
-		if err != nil {
-			// 创建失败
-			common.Error(err)
-			return err
-		}
-
-		// 创建成功
-		return nil
+		common.Error(err)
+		return err
 	}
-
-	// 说明已经存在
-	common.Error("SvcService: " + info.SvcName + "已经存在")
-	return errors.New("SvcService: " + info.SvcName + "已经存在")
+	err = s.applySvcToK8s(info)
+	if err != nil {
+		return err
+	}
+	common.Info("SvcService: " + info.SvcName + "创建成功")
+	return nil
 }
 
 // UpdateSvcToK8s 更新服务到k8s
 func (s *SvcDataService) UpdateSvcToK8s(info *svc.SvcInfo) error {
-	svc := s.setService(info)
+	err := s.applySvcToK8s(info)
+	if err != nil {
+		return err
+	}
+	common.Info("SvcService: " + info.SvcName + "更新成功")
+	return nil
+}
 
-	// 查找是否存在指定服务
-	_, err := s.K8sClientSet.CoreV1().Services(info.SvcNamespace).Get(context.TODO(), info.SvcName, v12.GetOptions{})
+// applySvcToK8s 以平台字段管理者的身份将service应用到k8s
+// 应用前按照服务类型检查配置，应用后把k8s分配的节点端口回写到info
+func (s *SvcDataService) applySvcToK8s(info *svc.SvcInfo) error {
+	err := s.checkService(info)
 	if err != nil {
-		// 不存在服务
 		common.Error(err)
-		return errors.New("SvcService：" + info.SvcName + "不存在服务请先创建")
+		return err
+	}
+	err = s.checkClusterIP(info)
+	if err != nil {
+		common.Error(err)
+		return err
 	}
 
-	// 之前存在 -> 可以更新
-	_, err = s.K8sClientSet.CoreV1().Services(info.SvcNamespace).Update(context.TODO(), svc, v12.UpdateOptions{})
+	data, err := common.ApplyData(s.setService(info))
 	if err != nil {
-		// 更新失败
 		common.Error(err)
 		return err
 	}
-	// 更新成功
-	common.Info("SvcService: ")
+
+	opts, err := common.ApplyOptions(func() (v12.Object, error) {
+		return s.K8sClientSet.CoreV1().Services(info.SvcNamespace).Get(context.TODO(), info.SvcName, v12.GetOptions{})
+	})
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	// 字段冲突时不强制覆盖，返回冲突信息
+	service, err := s.K8sClientSet.CoreV1().Services(info.SvcNamespace).Patch(context.TODO(), info.SvcName, types.ApplyPatchType, data, opts)
+	if err != nil {
+		err = common.ApplyError("SvcService", info.SvcName, err)
+		common.Error(err)
+		return err
+	}
+	setNodePorts(info, service)
 	return nil
 }
 
@@ -151,19 +195,24 @@ func (s *SvcDataService) DeleteFromK8s(m *model.Svc) error {
 	return nil
 }
 
+// ExportYAML 导出应用到k8s的service，与 UpdateSvcToK8s 发送的内容一致
+func (s *SvcDataService) ExportYAML(info *svc.SvcInfo) (string, error) {
+	return common.ExportYAML(s.setService(info))
+}
+
 // setService 组装service信息
 func (s *SvcDataService) setService(info *svc.SvcInfo) *v1.Service {
 	svc := &v1.Service{}
 
 	// 设置服务类型
 	svc.TypeMeta = v12.TypeMeta{
-		Kind:       "v1",
-		APIVersion: "SvcService",
+		Kind:       "Service",
+		APIVersion: "v1",
 	}
 	// 设置基础信息
 	svc.ObjectMeta = v12.ObjectMeta{
-		Name:         info.SvcName,
-		GenerateName: info.SvcNamespace,
+		Name:      info.SvcName,
+		Namespace: info.SvcNamespace,
 		Labels: map[string]string{
 			"app-name": info.SvcPodName,
 		},
@@ -171,31 +220,76 @@ func (s *SvcDataService) setService(info *svc.SvcInfo) *v1.Service {
 			"k8s/generated-by-zhao": "备注声明",
 		},
 	}
-	// 设置服务的spec信息，采用ClusterIP模式
+
+	svcType := getSvcType(info.SvcType)
 	svc.Spec = v1.ServiceSpec{
+		Type:  svcType,
 		Ports: s.getServicePort(info),
-		Selector: map[string]string{
-			"app-name": info.SvcPodName,
-		},
-		Type: "ClusterIP",
 	}
 
+	// ExternalName 只是集群内的DNS别名，没有选择器和集群IP
+	if svcType == v1.ServiceTypeExternalName {
+		svc.Spec.ExternalName = info.SvcExternalName
+		return svc
+	}
+	svc.Spec.Selector = map[string]string{
+		"app-name": info.SvcPodName,
+	}
+
+	switch svcType {
+	case v1.ServiceTypeClusterIP:
+		if info.SvcHeadless {
+			svc.Spec.ClusterIP = v1.ClusterIPNone
+		}
+	case v1.ServiceTypeLoadBalancer:
+		svc.Spec.LoadBalancerSourceRanges = splitRanges(info.SvcLoadBalancerSourceRanges)
+		fallthrough
+	case v1.ServiceTypeNodePort:
+		if info.SvcExternalTrafficPolicy != "" {
+			svc.Spec.ExternalTrafficPolicy = v1.ServiceExternalTrafficPolicy(info.SvcExternalTrafficPolicy)
+		}
+	}
+
+	if info.SvcSessionAffinity == string(v1.ServiceAffinityClientIP) {
+		svc.Spec.SessionAffinity = v1.ServiceAffinityClientIP
+		if info.SvcSessionAffinityTimeout > 0 {
+			svc.Spec.SessionAffinityConfig = &v1.SessionAffinityConfig{
+				ClientIP: &v1.ClientIPConfig{TimeoutSeconds: &info.SvcSessionAffinityTimeout},
+			}
+		}
+	}
 	return svc
 }
 
 // getServicePort 获取服务端口
+// 只有 NodePort 和 LoadBalancer 类型设置节点端口，为0时由k8s分配
 func (s *SvcDataService) getServicePort(info *svc.SvcInfo) []v1.ServicePort {
 	var servicePort []v1.ServicePort
+	withNodePort := hasNodePort(getSvcType(info.SvcType))
 
 	for _, port := range info.SvcPort {
 		// 将servicePort信息添加
 		servicePort = append(servicePort, v1.ServicePort{
-			Name:       "port-" + strconv.FormatInt(int64(port.SvcPort), 10),
-			Protocol:   v1.Protocol(port.SvcPortProtocol),
+			Name:       servicePortName(port),
+			Protocol:   v1.Protocol(getProtocol(port.SvcPortProtocol)),
 			Port:       port.SvcPort,
 			TargetPort: intstr.FromInt(int(port.SvcTargetPort)),
 		})
+		if withNodePort {
+			servicePort[len(servicePort)-1].NodePort = port.SvcNodePort
+		}
 	}
 
 	return servicePort
 }
+
+// servicePortName 服务端口名称，同一服务中不能重复
+// 端口号相同、协议不同时名称带上协议，TCP端口保持原来的名称
+func servicePortName(port *svc.SvcPort) string {
+	name := "port-" + strconv.FormatInt(int64(port.SvcPort), 10)
+	protocol := getProtocol(port.SvcPortProtocol)
+	if protocol != string(v1.ProtocolTCP) {
+		name += "-" + strings.ToLower(protocol)
+	}
+	return name
+}
@@ -0,0 +1,230 @@
+package service
+
+import (
+	"context"
+	"errors"
+	v1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	v12 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"tini-paas/internal/svc/proto/svc"
+)
+
+// nodePortRangeEnv 集群的节点端口范围，与 kube-apiserver 的 --service-node-port-range 一致
+const nodePortRangeEnv = "PAAS_NODE_PORT_RANGE"
+
+// defaultNodePortRange k8s默认的节点端口范围
+const defaultNodePortRange = "30000-32767"
+
+// maxSessionAffinityTimeout ClientIP 会话保持的最长时间(秒)
+const maxSessionAffinityTimeout = 86400
+
+// getSvcType 服务类型，未设置时为 ClusterIP
+func getSvcType(svcType string) v1.ServiceType {
+	if svcType == "" {
+		return v1.ServiceTypeClusterIP
+	}
+	return v1.ServiceType(svcType)
+}
+
+// hasNodePort 服务类型是否使用节点端口
+func hasNodePort(svcType v1.ServiceType) bool {
+	return svcType == v1.ServiceTypeNodePort || svcType == v1.ServiceTypeLoadBalancer
+}
+
+// splitRanges 拆分逗号分隔的网段
+func splitRanges(ranges string) []string {
+	var result []string
+	for _, r := range strings.Split(ranges, ",") {
+		if r = strings.TrimSpace(r); r != "" {
+			result = append(result, r)
+		}
+	}
+	return result
+}
+
+// nodePortRange 节点端口的范围
+func nodePortRange() (int32, int32, error) {
+	portRange := os.Getenv(nodePortRangeEnv)
+	if portRange == "" {
+		portRange = defaultNodePortRange
+	}
+	parts := strings.SplitN(portRange, "-", 2)
+	if len(parts) != 2 {
+		return 0, 0, errors.New("节点端口范围格式错误：" + portRange)
+	}
+	min, err := strconv.ParseInt(parts[0], 10, 32)
+	if err != nil {
+		return 0, 0, err
+	}
+	max, err := strconv.ParseInt(parts[1], 10, 32)
+	if err != nil {
+		return 0, 0, err
+	}
+	return int32(min), int32(max), nil
+}
+
+// checkService 按照服务类型检查配置，NodePort 和 LoadBalancer 类型检查节点端口是否可用
+func (s *SvcDataService) checkService(info *svc.SvcInfo) error {
+	svcType := getSvcType(info.SvcType)
+	switch svcType {
+	case v1.ServiceTypeClusterIP, v1.ServiceTypeNodePort, v1.ServiceTypeLoadBalancer:
+		if info.SvcPodName == "" {
+			return errors.New("服务需要绑定pod")
+		}
+		if len(info.SvcPort) == 0 && !info.SvcHeadless {
+			return errors.New("服务至少需要一个端口")
+		}
+	case v1.ServiceTypeExternalName:
+		if errs := validation.IsDNS1123Subdomain(info.SvcExternalName); len(errs) > 0 {
+			return errors.New("外部名称 " + info.SvcExternalName + " 不是合法的域名：" + strings.Join(errs, "; "))
+		}
+	default:
+		return errors.New("不支持的服务类型：" + info.SvcType)
+	}
+
+	if info.SvcHeadless && svcType != v1.ServiceTypeClusterIP {
+		return errors.New("只有 ClusterIP 类型可以设置为无头服务")
+	}
+	if info.SvcLoadBalancerSourceRanges != "" {
+		if svcType != v1.ServiceTypeLoadBalancer {
+			return errors.New("只有 LoadBalancer 类型可以限制访问网段")
+		}
+		for _, cidr := range splitRanges(info.SvcLoadBalancerSourceRanges) {
+			if _, _, err := net.ParseCIDR(cidr); err != nil {
+				return errors.New("网段 " + cidr + " 格式错误")
+			}
+		}
+	}
+	switch v1.ServiceExternalTrafficPolicy(info.SvcExternalTrafficPolicy) {
+	case "":
+	case v1.ServiceExternalTrafficPolicyCluster, v1.ServiceExternalTrafficPolicyLocal:
+		if !hasNodePort(svcType) {
+			return errors.New("只有 NodePort 和 LoadBalancer 类型可以设置外部流量策略")
+		}
+	default:
+		return errors.New("不支持的外部流量策略：" + info.SvcExternalTrafficPolicy)
+	}
+	switch v1.ServiceAffinity(info.SvcSessionAffinity) {
+	case "", v1.ServiceAffinityNone:
+	case v1.ServiceAffinityClientIP:
+		if svcType == v1.ServiceTypeExternalName {
+			return errors.New("ExternalName 类型不支持会话保持")
+		}
+		if info.SvcSessionAffinityTimeout < 0 || info.SvcSessionAffinityTimeout > maxSessionAffinityTimeout {
+			return errors.New("会话保持时间需要在 0-" + strconv.Itoa(maxSessionAffinityTimeout) + " 秒之间")
+		}
+	default:
+		return errors.New("不支持的会话保持方式：" + info.SvcSessionAffinity)
+	}
+
+	return s.checkPorts(info, svcType)
+}
+
+// checkPorts 检查端口和协议，节点端口需要在范围内并且没有被其他服务使用
+func (s *SvcDataService) checkPorts(info *svc.SvcInfo, svcType v1.ServiceType) error {
+	exists := map[string]bool{}
+	var nodePorts []*svc.SvcPort
+	for _, port := range info.SvcPort {
+		if port.SvcPort < 1 || port.SvcPort > 65535 {
+			return errors.New("端口 " + strconv.Itoa(int(port.SvcPort)) + " 超出范围")
+		}
+		switch v1.Protocol(port.SvcPortProtocol) {
+		case "", v1.ProtocolTCP, v1.ProtocolUDP, v1.ProtocolSCTP:
+		default:
+			return errors.New("不支持的端口协议：" + port.SvcPortProtocol)
+		}
+		key := strconv.Itoa(int(port.SvcPort)) + "/" + getProtocol(port.SvcPortProtocol)
+		if exists[key] {
+			return errors.New("端口 " + key + " 重复")
+		}
+		exists[key] = true
+
+		if port.SvcNodePort == 0 {
+			continue
+		}
+		if !hasNodePort(svcType) {
+			return errors.New("只有 NodePort 和 LoadBalancer 类型可以设置节点端口")
+		}
+		nodePorts = append(nodePorts, port)
+	}
+	if len(nodePorts) == 0 {
+		return nil
+	}
+
+	min, max, err := nodePortRange()
+	if err != nil {
+		return err
+	}
+	for _, port := range nodePorts {
+		if port.SvcNodePort < min || port.SvcNodePort > max {
+			return errors.New("节点端口 " + strconv.Itoa(int(port.SvcNodePort)) + " 不在范围 " + strconv.Itoa(int(min)) + "-" + strconv.Itoa(int(max)) + " 内")
+		}
+	}
+
+	// 节点端口在整个集群内唯一
+	services, err := s.K8sClientSet.CoreV1().Services("").List(context.TODO(), v12.ListOptions{})
+	if err != nil {
+		return err
+	}
+	used := map[int32]string{}
+	for _, service := range services.Items {
+		if service.Namespace == info.SvcNamespace && service.Name == info.SvcName {
+			continue
+		}
+		for _, port := range service.Spec.Ports {
+			if port.NodePort != 0 {
+				used[port.NodePort] = service.Namespace + "/" + service.Name
+			}
+		}
+	}
+	for _, port := range nodePorts {
+		if name, ok := used[port.SvcNodePort]; ok {
+			return errors.New("节点端口 " + strconv.Itoa(int(port.SvcNodePort)) + " 已被服务 " + name + " 使用")
+		}
+	}
+	return nil
+}
+
+// checkClusterIP 集群IP创建后不能修改，普通服务和无头服务之间不能直接切换
+func (s *SvcDataService) checkClusterIP(info *svc.SvcInfo) error {
+	existing, err := s.K8sClientSet.CoreV1().Services(info.SvcNamespace).Get(context.TODO(), info.SvcName, v12.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	headless := getSvcType(info.SvcType) == v1.ServiceTypeClusterIP && info.SvcHeadless
+	if existing.Spec.ClusterIP == v1.ClusterIPNone && !headless {
+		return errors.New("服务 " + info.SvcName + " 是无头服务，需要删除后重新创建才能分配集群IP")
+	}
+	if headless && existing.Spec.ClusterIP != "" && existing.Spec.ClusterIP != v1.ClusterIPNone {
+		return errors.New("服务 " + info.SvcName + " 已经分配了集群IP，需要删除后重新创建为无头服务")
+	}
+	return nil
+}
+
+// setNodePorts 回写k8s分配的节点端口
+func setNodePorts(info *svc.SvcInfo, service *v1.Service) {
+	for _, port := range info.SvcPort {
+		for _, applied := range service.Spec.Ports {
+			if applied.Port == port.SvcPort && string(applied.Protocol) == getProtocol(port.SvcPortProtocol) {
+				port.SvcNodePort = applied.NodePort
+			}
+		}
+	}
+}
+
+// getProtocol 端口协议，未设置时为TCP
+func getProtocol(protocol string) string {
+	if protocol == "" {
+		return string(v1.ProtocolTCP)
+	}
+	return protocol
+}
@@ -157,6 +157,23 @@ func (u *UserHandler) IsRight(ctx context.Context, userRight *user.UserRight, ri
 	return nil
 }
 
+// Login 登录，校验用户名和密码后签发登录令牌
+func (u *UserHandler) Login(ctx context.Context, login *user.UserLogin, token *user.UserToken) error {
+	userModel, err := u.UserDataService.Login(login.UserName, login.UserPwd)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+
+	token.Token, err = common.SignToken(userModel.ID)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	token.UserId = userModel.ID
+	return nil
+}
+
 // getUserRole 获取用户角色信息
 func (u *UserHandler) getUserRole(userRole *user.UserRole) (*model.User, []*model.Role, error) {
 	user := &model.User{}
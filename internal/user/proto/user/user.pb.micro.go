@@ -45,6 +45,7 @@ type UserService interface {
 	UpdateRole(ctx context.Context, in *UserRole, opts ...client.CallOption) (*Response, error)
 	DeleteRole(ctx context.Context, in *UserRole, opts ...client.CallOption) (*Response, error)
 	IsRight(ctx context.Context, in *UserRight, opts ...client.CallOption) (*Right, error)
+	Login(ctx context.Context, in *UserLogin, opts ...client.CallOption) (*UserToken, error)
 }
 
 type userService struct {
@@ -149,6 +150,16 @@ func (c *userService) IsRight(ctx context.Context, in *UserRight, opts ...client
 	return out, nil
 }
 
+func (c *userService) Login(ctx context.Context, in *UserLogin, opts ...client.CallOption) (*UserToken, error) {
+	req := c.c.NewRequest(c.name, "User.Login", in)
+	out := new(UserToken)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for User service
 
 type UserHandler interface {
@@ -161,6 +172,7 @@ type UserHandler interface {
 	UpdateRole(context.Context, *UserRole, *Response) error
 	DeleteRole(context.Context, *UserRole, *Response) error
 	IsRight(context.Context, *UserRight, *Right) error
+	Login(context.Context, *UserLogin, *UserToken) error
 }
 
 func RegisterUserHandler(s server.Server, hdlr UserHandler, opts ...server.HandlerOption) error {
@@ -174,6 +186,7 @@ func RegisterUserHandler(s server.Server, hdlr UserHandler, opts ...server.Handl
 		UpdateRole(ctx context.Context, in *UserRole, out *Response) error
 		DeleteRole(ctx context.Context, in *UserRole, out *Response) error
 		IsRight(ctx context.Context, in *UserRight, out *Right) error
+		Login(ctx context.Context, in *UserLogin, out *UserToken) error
 	}
 	type User struct {
 		user
@@ -221,3 +234,7 @@ func (h *userHandler) DeleteRole(ctx context.Context, in *UserRole, out *Respons
 func (h *userHandler) IsRight(ctx context.Context, in *UserRight, out *Right) error {
 	return h.UserHandler.IsRight(ctx, in, out)
 }
+
+func (h *userHandler) Login(ctx context.Context, in *UserLogin, out *UserToken) error {
+	return h.UserHandler.Login(ctx, in, out)
+}
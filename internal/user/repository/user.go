@@ -12,6 +12,7 @@ type UserRepository interface {
 	DeleteUser(id int64) error
 	UpdateUser(user *model.User) error
 	FindUserByID(id int64) (*model.User, error)
+	FindUserByName(name string) (*model.User, error)
 	FindAll() ([]model.User, error)
 
 	AddRole(user *model.User, role []*model.Role) error
@@ -58,6 +59,12 @@ func (u *User) FindUserByID(id int64) (*model.User, error) {
 	return user, u.db.First(user, id).Error
 }
 
+// FindUserByName 根据用户名查找用户
+func (u *User) FindUserByName(name string) (*model.User, error) {
+	user := &model.User{}
+	return user, u.db.Where("user_name = ?", name).First(user).Error
+}
+
 // FindAll 查找所有用户信息
 func (u *User) FindAll() ([]model.User, error) {
 	var users []model.User
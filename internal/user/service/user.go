@@ -1,6 +1,8 @@
 package service
 
 import (
+	"crypto/subtle"
+	"errors"
 	"k8s.io/client-go/kubernetes"
 	"tini-paas/internal/user/model"
 	"tini-paas/internal/user/repository"
@@ -18,6 +20,7 @@ type UserService interface {
 	DeleteRole(user *model.User, role []*model.Role) error
 	UpdateRole(user *model.User, role []*model.Role) error
 	IsRight(action string, id int64) bool
+	Login(name, pwd string) (*model.User, error)
 }
 
 // NewUserService 初始化用户服务
@@ -76,3 +79,12 @@ func (u *UserDataService) UpdateRole(user *model.User, role []*model.Role) error
 func (u *UserDataService) IsRight(action string, id int64) bool {
 	return u.UserRepository.IsRight(action, id)
 }
+
+// Login 校验用户名和密码，返回登录的用户
+func (u *UserDataService) Login(name, pwd string) (*model.User, error) {
+	user, err := u.UserRepository.FindUserByName(name)
+	if err != nil || pwd == "" || subtle.ConstantTimeCompare([]byte(user.UserPwd), []byte(pwd)) != 1 {
+		return nil, errors.New("用户名或者密码错误")
+	}
+	return user, nil
+}
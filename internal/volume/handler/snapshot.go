@@ -0,0 +1,113 @@
+package handler
+
+import (
+	"context"
+	"tini-paas/internal/volume/model"
+	"tini-paas/internal/volume/proto/volume"
+	"tini-paas/pkg/common"
+)
+
+// CreateSnapshot 为存储创建快照
+func (v *VolumeHandler) CreateSnapshot(ctx context.Context, req *volume.SnapshotRequest, response *volume.Response) error {
+	volumeModel, err := v.VolumeService.FindVolume(req.VolumeId)
+	if err != nil {
+		common.Error(err)
+		response.Msg = err.Error()
+		return err
+	}
+
+	snapshot, err := v.SnapshotService.CreateSnapshot(volumeModel, req.SnapshotName, req.SnapshotClassName)
+	if err != nil {
+		common.Error(err)
+		response.Msg = err.Error()
+		return err
+	}
+	response.Msg = "存储 " + volumeModel.VolumeName + " 的快照 " + snapshot.SnapshotName + " 创建成功"
+	return nil
+}
+
+// ListSnapshots 查找存储的快照
+func (v *VolumeHandler) ListSnapshots(ctx context.Context, req *volume.VolumeID, rsp *volume.AllSnapshot) error {
+	volumeModel, err := v.VolumeService.FindVolume(req.Id)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+
+	snapshots, err := v.SnapshotService.ListSnapshots(volumeModel)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	for _, snapshot := range snapshots {
+		snapshotInfo := &volume.SnapshotInfo{}
+		err = common.SwapTo(snapshot, snapshotInfo)
+		if err != nil {
+			common.Error(err)
+			return err
+		}
+		rsp.SnapshotInfo = append(rsp.SnapshotInfo, snapshotInfo)
+	}
+	return nil
+}
+
+// DeleteSnapshot 删除快照
+func (v *VolumeHandler) DeleteSnapshot(ctx context.Context, req *volume.SnapshotID, response *volume.Response) error {
+	snapshot, err := v.SnapshotService.FindSnapshotByID(req.Id)
+	if err != nil {
+		common.Error(err)
+		response.Msg = err.Error()
+		return err
+	}
+
+	err = v.SnapshotService.DeleteSnapshot(snapshot)
+	if err != nil {
+		common.Error(err)
+		response.Msg = err.Error()
+		return err
+	}
+	response.Msg = "快照 " + snapshot.SnapshotName + " 已删除"
+	return nil
+}
+
+// RestoreSnapshot 从快照恢复到新的存储，原存储不受影响
+func (v *VolumeHandler) RestoreSnapshot(ctx context.Context, req *volume.RestoreSnapshotRequest, response *volume.Response) error {
+	snapshot, err := v.SnapshotService.FindSnapshotByID(req.SnapshotId)
+	if err != nil {
+		common.Error(err)
+		response.Msg = err.Error()
+		return err
+	}
+
+	// 来源存储已经删除时仍然可以恢复，使用默认的访问模式
+	source, err := v.VolumeService.FindVolume(snapshot.VolumeID)
+	if err != nil {
+		source = &model.Volume{}
+	}
+
+	info, err := v.SnapshotService.BuildRestoreVolume(snapshot, source, req)
+	if err != nil {
+		common.Error(err)
+		response.Msg = err.Error()
+		return err
+	}
+	return v.AddVolume(ctx, info, response)
+}
+
+// CloneVolume 克隆存储到同一个命名空间的新存储
+func (v *VolumeHandler) CloneVolume(ctx context.Context, req *volume.CloneVolumeRequest, response *volume.Response) error {
+	source, err := v.VolumeService.FindVolume(req.VolumeId)
+	if err != nil {
+		common.Error(err)
+		response.Msg = err.Error()
+		return err
+	}
+
+	info, err := v.VolumeService.BuildCloneVolume(source, req)
+	if err != nil {
+		common.Error(err)
+		response.Msg = err.Error()
+		return err
+	}
+	return v.AddVolume(ctx, info, response)
+}
@@ -2,6 +2,9 @@ package handler
 
 import (
 	"context"
+	"errors"
+	microErrors "github.com/asim/go-micro/v3/errors"
+	"github.com/jinzhu/gorm"
 	"strconv"
 	"tini-paas/internal/volume/model"
 	"tini-paas/internal/volume/proto/volume"
@@ -12,18 +15,40 @@ import (
 // VolumeHandler 操作接口
 type VolumeHandler struct {
 	VolumeService service.VolumeService
+
+	// SnapshotService 快照操作接口
+	SnapshotService service.SnapshotService
 }
 
 func (v *VolumeHandler) AddVolume(ctx context.Context, info *volume.VolumeInfo, response *volume.Response) error {
 	volume := &model.Volume{}
 
+	// 检查存储类，未指定时使用默认存储类，保存实际使用的存储类
+	err := v.VolumeService.CheckStorageClass(info)
+	if err != nil {
+		common.Error(err)
+		response.Msg = err.Error()
+		return err
+	}
+
 	// 将info信息映射到volume
-	err := common.SwapTo(info, volume)
+	err = common.SwapTo(info, volume)
 	if err != nil {
 		common.Error(err)
 		return err
 	}
 
+	// 同一命名空间内名称唯一，避免覆盖k8s中已经存在的资源
+	// 查询出错时不能当作不存在，否则数据库异常时会绕过检查
+	_, err = v.VolumeService.FindVolumeByNamespaceAndName(info.VolumeNamespace, info.VolumeName)
+	if err == nil {
+		err = errors.New("存储 " + info.VolumeNamespace + "/" + info.VolumeName + " 已经存在")
+	}
+	if !gorm.IsRecordNotFoundError(err) {
+		common.Error(err)
+		return err
+	}
+
 	// 创建volume, 先在k8s中创建
 	err = v.VolumeService.CreateVolumeToK8s(info)
 	if err != nil {
@@ -52,12 +77,14 @@ func (v *VolumeHandler) DeleteVolume(ctx context.Context, id *volume.VolumeID, r
 		return err
 	}
 
-	// 在k8s中删除
-	err = v.VolumeService.DeleteVolumeFromK8s(volumeModel)
+	// 在k8s中删除，仍在使用时需要强制删除
+	err = v.VolumeService.DeleteVolumeFromK8s(volumeModel, id.Force)
 	if err != nil {
 		common.Error(err)
+		response.Msg = err.Error()
 		return err
 	}
+	response.Msg = "存储 " + volumeModel.VolumeName + " 已删除"
 	return nil
 }
 
@@ -79,6 +106,14 @@ func (v *VolumeHandler) FindVolumeByID(ctx context.Context, id *volume.VolumeID,
 		common.Error(err)
 		return err
 	}
+	info.Id = volumeModel.ID
+
+	// k8s中的实时状态
+	err = v.VolumeService.SetVolumeStatus(volumeModel, info)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
 	return nil
 }
 
@@ -105,3 +140,68 @@ func (v *VolumeHandler) FindAllVolume(ctx context.Context, all *volume.FindAll,
 	}
 	return nil
 }
+
+// FindVolumeByNamespaceAndName 根据命名空间和名称查找
+func (v *VolumeHandler) FindVolumeByNamespaceAndName(ctx context.Context, req *volume.VolumeNamespaceName, info *volume.VolumeInfo) error {
+	volumeModel, err := v.VolumeService.FindVolumeByNamespaceAndName(req.Namespace, req.Name)
+	if err != nil {
+		common.Error(err)
+		if gorm.IsRecordNotFoundError(err) {
+			return microErrors.NotFound("go.micro.service.volume", "volume %s/%s 不存在", req.Namespace, req.Name)
+		}
+		return err
+	}
+
+	// 数据转换
+	err = common.SwapTo(volumeModel, info)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	info.Id = volumeModel.ID
+	return nil
+}
+
+// ResizeVolume 在线扩容存储
+func (v *VolumeHandler) ResizeVolume(ctx context.Context, req *volume.VolumeResize, response *volume.Response) error {
+	volumeModel, err := v.VolumeService.FindVolume(req.Id)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+
+	err = v.VolumeService.ResizeVolume(volumeModel, req.VolumeRequest)
+	if err != nil {
+		common.Error(err)
+		response.Msg = err.Error()
+		return err
+	}
+	response.Msg = "存储 " + volumeModel.VolumeName + " 正在扩容"
+	return nil
+}
+
+// ListStorageClasses 查询集群中的存储类
+func (v *VolumeHandler) ListStorageClasses(ctx context.Context, req *volume.FindAll, rsp *volume.AllStorageClass) error {
+	storageClasses, err := v.VolumeService.ListStorageClasses()
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	rsp.StorageClass = storageClasses
+	return nil
+}
+
+// ExportVolume 导出存储应用到k8s的PVC
+func (v *VolumeHandler) ExportVolume(ctx context.Context, id *volume.VolumeID, rsp *volume.ExportResult) error {
+	info := &volume.VolumeInfo{}
+	err := v.FindVolumeByID(ctx, id, info)
+	if err != nil {
+		return err
+	}
+	rsp.Yaml, err = v.VolumeService.ExportYAML(info)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	return nil
+}
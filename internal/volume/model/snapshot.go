@@ -0,0 +1,30 @@
+package model
+
+// VolumeSnapshot 存储快照，对应k8s中的 VolumeSnapshot
+type VolumeSnapshot struct {
+	ID int64 `gorm:"primary_key;not_null;auto_increment" json:"id"`
+
+	// VolumeID 快照来源的存储
+	VolumeID int64 `gorm:"index;not_null" json:"volume_id"`
+
+	// SnapshotName 快照名称，与来源存储在同一个命名空间
+	SnapshotName string `gorm:"unique_index:idx_snapshot_namespace_name;not_null" json:"snapshot_name"`
+
+	// SnapshotNamespace 快照所属的命名空间
+	SnapshotNamespace string `gorm:"unique_index:idx_snapshot_namespace_name;not_null" json:"snapshot_namespace"`
+
+	// SnapshotClassName 快照类名称，为空时使用集群默认的快照类
+	SnapshotClassName string `json:"snapshot_class_name"`
+
+	// SnapshotReady 快照是否可以用于恢复
+	SnapshotReady bool `json:"snapshot_ready"`
+
+	// SnapshotRestoreSize 恢复需要的最小容量，如 10Gi
+	SnapshotRestoreSize string `json:"snapshot_restore_size"`
+
+	// SnapshotMsg 快照状态说明或错误信息
+	SnapshotMsg string `json:"snapshot_msg"`
+
+	// SnapshotCreateTime 创建时间
+	SnapshotCreateTime int64 `json:"snapshot_create_time"`
+}
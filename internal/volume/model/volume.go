@@ -4,11 +4,11 @@ package model
 type Volume struct {
 	ID int64 `gorm:"primary_key;not_null;auto_increment"`
 
-	// VolumeName 存储名称
-	VolumeName string `json:"volume_name"`
+	// VolumeName 存储名称，同一命名空间内唯一
+	VolumeName string `gorm:"unique_index:idx_volume_namespace_name;not_null" json:"volume_name"`
 
 	// VolumeNamespace 存储所属的命名空间
-	VolumeNamespace string `json:"volume_namespace"`
+	VolumeNamespace string `gorm:"unique_index:idx_volume_namespace_name;not_null" json:"volume_namespace"`
 
 	// VolumeAccessMode 存储的访问模式：RWO, ROX, RWX
 	VolumeAccessMode string `json:"volume_access_mode"`
@@ -21,4 +21,16 @@ type Volume struct {
 
 	// VolumePersistentVolumeMode 存储类型：Block，filesystem
 	VolumePersistentVolumeMode string `json:"volume_persistent_volume_mode"`
+
+	// VolumeResizeStatus 最近一次扩容的状态
+	VolumeResizeStatus string `json:"volume_resize_status"`
+
+	// VolumeResizeMsg 扩容状态说明
+	VolumeResizeMsg string `json:"volume_resize_msg"`
+
+	// VolumeDataSourceKind 创建时的数据来源：VolumeSnapshot 从快照恢复，PersistentVolumeClaim 克隆存储
+	VolumeDataSourceKind string `json:"volume_data_source_kind"`
+
+	// VolumeDataSourceName 数据来源的名称，与存储在同一个命名空间
+	VolumeDataSourceName string `json:"volume_data_source_name"`
 }
@@ -32,6 +32,23 @@ type VolumeInfo struct {
 	VolumeStorageClassName     string  `protobuf:"bytes,5,opt,name=volume_storage_class_name,json=volumeStorageClassName,proto3" json:"volume_storage_class_name,omitempty"`
 	VolumeRequest              float32 `protobuf:"fixed32,6,opt,name=volume_request,json=volumeRequest,proto3" json:"volume_request,omitempty"`
 	VolumePersistentVolumeMode string  `protobuf:"bytes,7,opt,name=volume_persistent_volume_mode,json=volumePersistentVolumeMode,proto3" json:"volume_persistent_volume_mode,omitempty"`
+	// 扩容状态：Resizing, FileSystemResizePending, Completed, Failed
+	VolumeResizeStatus string `protobuf:"bytes,8,opt,name=volume_resize_status,json=volumeResizeStatus,proto3" json:"volume_resize_status,omitempty"`
+	VolumeResizeMsg    string `protobuf:"bytes,9,opt,name=volume_resize_msg,json=volumeResizeMsg,proto3" json:"volume_resize_msg,omitempty"`
+	// 数据来源：VolumeSnapshot 从快照恢复，PersistentVolumeClaim 克隆存储
+	VolumeDataSourceKind string `protobuf:"bytes,10,opt,name=volume_data_source_kind,json=volumeDataSourceKind,proto3" json:"volume_data_source_kind,omitempty"`
+	VolumeDataSourceName string `protobuf:"bytes,11,opt,name=volume_data_source_name,json=volumeDataSourceName,proto3" json:"volume_data_source_name,omitempty"`
+	// 以下为k8s中的实时状态，只在 FindVolumeByID 时返回
+	// PVC状态：Pending, Bound, Lost
+	VolumePhase string `protobuf:"bytes,12,opt,name=volume_phase,json=volumePhase,proto3" json:"volume_phase,omitempty"`
+	// 绑定的PV名称
+	VolumeBoundPv string `protobuf:"bytes,13,opt,name=volume_bound_pv,json=volumeBoundPv,proto3" json:"volume_bound_pv,omitempty"`
+	// 实际容量，如 10Gi
+	VolumeCapacity string `protobuf:"bytes,14,opt,name=volume_capacity,json=volumeCapacity,proto3" json:"volume_capacity,omitempty"`
+	// PV实际的访问模式
+	VolumeActualAccessModes []string `protobuf:"bytes,15,rep,name=volume_actual_access_modes,json=volumeActualAccessModes,proto3" json:"volume_actual_access_modes,omitempty"`
+	// 正在使用存储的工作负载和pod
+	VolumeConsumers []*VolumeConsumer `protobuf:"bytes,16,rep,name=volume_consumers,json=volumeConsumers,proto3" json:"volume_consumers,omitempty"`
 }
 
 func (x *VolumeInfo) Reset() {
@@ -115,31 +132,813 @@ func (x *VolumeInfo) GetVolumePersistentVolumeMode() string {
 	return ""
 }
 
+func (x *VolumeInfo) GetVolumeResizeStatus() string {
+	if x != nil {
+		return x.VolumeResizeStatus
+	}
+	return ""
+}
+
+func (x *VolumeInfo) GetVolumeResizeMsg() string {
+	if x != nil {
+		return x.VolumeResizeMsg
+	}
+	return ""
+}
+
+func (x *VolumeInfo) GetVolumeDataSourceKind() string {
+	if x != nil {
+		return x.VolumeDataSourceKind
+	}
+	return ""
+}
+
+func (x *VolumeInfo) GetVolumeDataSourceName() string {
+	if x != nil {
+		return x.VolumeDataSourceName
+	}
+	return ""
+}
+
+func (x *VolumeInfo) GetVolumePhase() string {
+	if x != nil {
+		return x.VolumePhase
+	}
+	return ""
+}
+
+func (x *VolumeInfo) GetVolumeBoundPv() string {
+	if x != nil {
+		return x.VolumeBoundPv
+	}
+	return ""
+}
+
+func (x *VolumeInfo) GetVolumeCapacity() string {
+	if x != nil {
+		return x.VolumeCapacity
+	}
+	return ""
+}
+
+func (x *VolumeInfo) GetVolumeActualAccessModes() []string {
+	if x != nil {
+		return x.VolumeActualAccessModes
+	}
+	return nil
+}
+
+func (x *VolumeInfo) GetVolumeConsumers() []*VolumeConsumer {
+	if x != nil {
+		return x.VolumeConsumers
+	}
+	return nil
+}
+
+// VolumeConsumer 使用存储的资源
+type VolumeConsumer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Deployment, StatefulSet, Pod
+	Kind string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *VolumeConsumer) Reset() {
+	*x = VolumeConsumer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_volume_volume_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VolumeConsumer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VolumeConsumer) ProtoMessage() {}
+
+func (x *VolumeConsumer) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_volume_volume_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VolumeConsumer.ProtoReflect.Descriptor instead.
+func (*VolumeConsumer) Descriptor() ([]byte, []int) {
+	return file_proto_volume_volume_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *VolumeConsumer) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *VolumeConsumer) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
 type VolumeID struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// 删除时存储仍在使用也强制删除
+	Force bool `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
+}
+
+func (x *VolumeID) Reset() {
+	*x = VolumeID{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_volume_volume_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VolumeID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VolumeID) ProtoMessage() {}
+
+func (x *VolumeID) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_volume_volume_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VolumeID.ProtoReflect.Descriptor instead.
+func (*VolumeID) Descriptor() ([]byte, []int) {
+	return file_proto_volume_volume_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *VolumeID) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *VolumeID) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+// 根据命名空间和名称查找
+type VolumeNamespaceName struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name      string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *VolumeNamespaceName) Reset() {
+	*x = VolumeNamespaceName{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_volume_volume_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VolumeNamespaceName) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VolumeNamespaceName) ProtoMessage() {}
+
+func (x *VolumeNamespaceName) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_volume_volume_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VolumeNamespaceName.ProtoReflect.Descriptor instead.
+func (*VolumeNamespaceName) Descriptor() ([]byte, []int) {
+	return file_proto_volume_volume_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *VolumeNamespaceName) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *VolumeNamespaceName) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// VolumeResize 扩容到 volume_request(Gi)，只能扩大
+type VolumeResize struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            int64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	VolumeRequest float32 `protobuf:"fixed32,2,opt,name=volume_request,json=volumeRequest,proto3" json:"volume_request,omitempty"`
+}
+
+func (x *VolumeResize) Reset() {
+	*x = VolumeResize{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_volume_volume_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VolumeResize) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VolumeResize) ProtoMessage() {}
+
+func (x *VolumeResize) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_volume_volume_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VolumeResize.ProtoReflect.Descriptor instead.
+func (*VolumeResize) Descriptor() ([]byte, []int) {
+	return file_proto_volume_volume_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *VolumeResize) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *VolumeResize) GetVolumeRequest() float32 {
+	if x != nil {
+		return x.VolumeRequest
+	}
+	return 0
+}
+
+type FindAll struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *FindAll) Reset() {
+	*x = FindAll{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_volume_volume_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindAll) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindAll) ProtoMessage() {}
+
+func (x *FindAll) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_volume_volume_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindAll.ProtoReflect.Descriptor instead.
+func (*FindAll) Descriptor() ([]byte, []int) {
+	return file_proto_volume_volume_proto_rawDescGZIP(), []int{5}
+}
+
+type Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Msg string `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
+}
+
+func (x *Response) Reset() {
+	*x = Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_volume_volume_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Response) ProtoMessage() {}
+
+func (x *Response) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_volume_volume_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Response.ProtoReflect.Descriptor instead.
+func (*Response) Descriptor() ([]byte, []int) {
+	return file_proto_volume_volume_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Response) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+type AllVolume struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VolumeInfo []*VolumeInfo `protobuf:"bytes,1,rep,name=volume_info,json=volumeInfo,proto3" json:"volume_info,omitempty"`
+}
+
+func (x *AllVolume) Reset() {
+	*x = AllVolume{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_volume_volume_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AllVolume) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllVolume) ProtoMessage() {}
+
+func (x *AllVolume) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_volume_volume_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllVolume.ProtoReflect.Descriptor instead.
+func (*AllVolume) Descriptor() ([]byte, []int) {
+	return file_proto_volume_volume_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *AllVolume) GetVolumeInfo() []*VolumeInfo {
+	if x != nil {
+		return x.VolumeInfo
+	}
+	return nil
+}
+
+// StorageClassInfo 集群中的存储类
+type StorageClassInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Provisioner string `protobuf:"bytes,2,opt,name=provisioner,proto3" json:"provisioner,omitempty"`
+	// 回收策略：Delete, Retain
+	ReclaimPolicy string `protobuf:"bytes,3,opt,name=reclaim_policy,json=reclaimPolicy,proto3" json:"reclaim_policy,omitempty"`
+	// 绑定模式：Immediate, WaitForFirstConsumer
+	VolumeBindingMode string `protobuf:"bytes,4,opt,name=volume_binding_mode,json=volumeBindingMode,proto3" json:"volume_binding_mode,omitempty"`
+	// 是否支持在线扩容
+	AllowVolumeExpansion bool `protobuf:"varint,5,opt,name=allow_volume_expansion,json=allowVolumeExpansion,proto3" json:"allow_volume_expansion,omitempty"`
+	// 是否为集群的默认存储类，未指定存储类时使用
+	IsDefault bool `protobuf:"varint,6,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
+}
+
+func (x *StorageClassInfo) Reset() {
+	*x = StorageClassInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_volume_volume_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StorageClassInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StorageClassInfo) ProtoMessage() {}
+
+func (x *StorageClassInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_volume_volume_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StorageClassInfo.ProtoReflect.Descriptor instead.
+func (*StorageClassInfo) Descriptor() ([]byte, []int) {
+	return file_proto_volume_volume_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *StorageClassInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StorageClassInfo) GetProvisioner() string {
+	if x != nil {
+		return x.Provisioner
+	}
+	return ""
+}
+
+func (x *StorageClassInfo) GetReclaimPolicy() string {
+	if x != nil {
+		return x.ReclaimPolicy
+	}
+	return ""
+}
+
+func (x *StorageClassInfo) GetVolumeBindingMode() string {
+	if x != nil {
+		return x.VolumeBindingMode
+	}
+	return ""
+}
+
+func (x *StorageClassInfo) GetAllowVolumeExpansion() bool {
+	if x != nil {
+		return x.AllowVolumeExpansion
+	}
+	return false
+}
+
+func (x *StorageClassInfo) GetIsDefault() bool {
+	if x != nil {
+		return x.IsDefault
+	}
+	return false
+}
+
+type AllStorageClass struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StorageClass []*StorageClassInfo `protobuf:"bytes,1,rep,name=storage_class,json=storageClass,proto3" json:"storage_class,omitempty"`
+}
+
+func (x *AllStorageClass) Reset() {
+	*x = AllStorageClass{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_volume_volume_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AllStorageClass) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllStorageClass) ProtoMessage() {}
+
+func (x *AllStorageClass) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_volume_volume_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllStorageClass.ProtoReflect.Descriptor instead.
+func (*AllStorageClass) Descriptor() ([]byte, []int) {
+	return file_proto_volume_volume_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *AllStorageClass) GetStorageClass() []*StorageClassInfo {
+	if x != nil {
+		return x.StorageClass
+	}
+	return nil
+}
+
+// SnapshotInfo 存储快照
+type SnapshotInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                  int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	VolumeId            int64  `protobuf:"varint,2,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
+	SnapshotName        string `protobuf:"bytes,3,opt,name=snapshot_name,json=snapshotName,proto3" json:"snapshot_name,omitempty"`
+	SnapshotNamespace   string `protobuf:"bytes,4,opt,name=snapshot_namespace,json=snapshotNamespace,proto3" json:"snapshot_namespace,omitempty"`
+	SnapshotClassName   string `protobuf:"bytes,5,opt,name=snapshot_class_name,json=snapshotClassName,proto3" json:"snapshot_class_name,omitempty"`
+	SnapshotReady       bool   `protobuf:"varint,6,opt,name=snapshot_ready,json=snapshotReady,proto3" json:"snapshot_ready,omitempty"`
+	SnapshotRestoreSize string `protobuf:"bytes,7,opt,name=snapshot_restore_size,json=snapshotRestoreSize,proto3" json:"snapshot_restore_size,omitempty"`
+	SnapshotMsg         string `protobuf:"bytes,8,opt,name=snapshot_msg,json=snapshotMsg,proto3" json:"snapshot_msg,omitempty"`
+	SnapshotCreateTime  int64  `protobuf:"varint,9,opt,name=snapshot_create_time,json=snapshotCreateTime,proto3" json:"snapshot_create_time,omitempty"`
+}
+
+func (x *SnapshotInfo) Reset() {
+	*x = SnapshotInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_volume_volume_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SnapshotInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnapshotInfo) ProtoMessage() {}
+
+func (x *SnapshotInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_volume_volume_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnapshotInfo.ProtoReflect.Descriptor instead.
+func (*SnapshotInfo) Descriptor() ([]byte, []int) {
+	return file_proto_volume_volume_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SnapshotInfo) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SnapshotInfo) GetVolumeId() int64 {
+	if x != nil {
+		return x.VolumeId
+	}
+	return 0
+}
+
+func (x *SnapshotInfo) GetSnapshotName() string {
+	if x != nil {
+		return x.SnapshotName
+	}
+	return ""
+}
+
+func (x *SnapshotInfo) GetSnapshotNamespace() string {
+	if x != nil {
+		return x.SnapshotNamespace
+	}
+	return ""
+}
+
+func (x *SnapshotInfo) GetSnapshotClassName() string {
+	if x != nil {
+		return x.SnapshotClassName
+	}
+	return ""
+}
+
+func (x *SnapshotInfo) GetSnapshotReady() bool {
+	if x != nil {
+		return x.SnapshotReady
+	}
+	return false
+}
+
+func (x *SnapshotInfo) GetSnapshotRestoreSize() string {
+	if x != nil {
+		return x.SnapshotRestoreSize
+	}
+	return ""
+}
+
+func (x *SnapshotInfo) GetSnapshotMsg() string {
+	if x != nil {
+		return x.SnapshotMsg
+	}
+	return ""
+}
+
+func (x *SnapshotInfo) GetSnapshotCreateTime() int64 {
+	if x != nil {
+		return x.SnapshotCreateTime
+	}
+	return 0
+}
+
+type SnapshotID struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *SnapshotID) Reset() {
+	*x = SnapshotID{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_volume_volume_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SnapshotID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnapshotID) ProtoMessage() {}
+
+func (x *SnapshotID) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_volume_volume_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnapshotID.ProtoReflect.Descriptor instead.
+func (*SnapshotID) Descriptor() ([]byte, []int) {
+	return file_proto_volume_volume_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SnapshotID) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// SnapshotRequest 为存储创建快照，名称为空时自动生成，快照类为空时使用默认快照类
+type SnapshotRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VolumeId          int64  `protobuf:"varint,1,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
+	SnapshotName      string `protobuf:"bytes,2,opt,name=snapshot_name,json=snapshotName,proto3" json:"snapshot_name,omitempty"`
+	SnapshotClassName string `protobuf:"bytes,3,opt,name=snapshot_class_name,json=snapshotClassName,proto3" json:"snapshot_class_name,omitempty"`
+}
+
+func (x *SnapshotRequest) Reset() {
+	*x = SnapshotRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_volume_volume_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SnapshotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnapshotRequest) ProtoMessage() {}
+
+func (x *SnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_volume_volume_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnapshotRequest.ProtoReflect.Descriptor instead.
+func (*SnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_proto_volume_volume_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SnapshotRequest) GetVolumeId() int64 {
+	if x != nil {
+		return x.VolumeId
+	}
+	return 0
+}
+
+func (x *SnapshotRequest) GetSnapshotName() string {
+	if x != nil {
+		return x.SnapshotName
+	}
+	return ""
+}
+
+func (x *SnapshotRequest) GetSnapshotClassName() string {
+	if x != nil {
+		return x.SnapshotClassName
+	}
+	return ""
+}
+
+type AllSnapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SnapshotInfo []*SnapshotInfo `protobuf:"bytes,1,rep,name=snapshot_info,json=snapshotInfo,proto3" json:"snapshot_info,omitempty"`
 }
 
-func (x *VolumeID) Reset() {
-	*x = VolumeID{}
+func (x *AllSnapshot) Reset() {
+	*x = AllSnapshot{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_volume_volume_proto_msgTypes[1]
+		mi := &file_proto_volume_volume_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *VolumeID) String() string {
+func (x *AllSnapshot) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VolumeID) ProtoMessage() {}
+func (*AllSnapshot) ProtoMessage() {}
 
-func (x *VolumeID) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_volume_volume_proto_msgTypes[1]
+func (x *AllSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_volume_volume_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -150,41 +949,47 @@ func (x *VolumeID) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VolumeID.ProtoReflect.Descriptor instead.
-func (*VolumeID) Descriptor() ([]byte, []int) {
-	return file_proto_volume_volume_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use AllSnapshot.ProtoReflect.Descriptor instead.
+func (*AllSnapshot) Descriptor() ([]byte, []int) {
+	return file_proto_volume_volume_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *VolumeID) GetId() int64 {
+func (x *AllSnapshot) GetSnapshotInfo() []*SnapshotInfo {
 	if x != nil {
-		return x.Id
+		return x.SnapshotInfo
 	}
-	return 0
+	return nil
 }
 
-type FindAll struct {
+// RestoreSnapshotRequest 从快照恢复到新的存储，大小和存储类为空时与来源存储相同
+type RestoreSnapshotRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	SnapshotId             int64   `protobuf:"varint,1,opt,name=snapshot_id,json=snapshotId,proto3" json:"snapshot_id,omitempty"`
+	VolumeName             string  `protobuf:"bytes,2,opt,name=volume_name,json=volumeName,proto3" json:"volume_name,omitempty"`
+	VolumeRequest          float32 `protobuf:"fixed32,3,opt,name=volume_request,json=volumeRequest,proto3" json:"volume_request,omitempty"`
+	VolumeStorageClassName string  `protobuf:"bytes,4,opt,name=volume_storage_class_name,json=volumeStorageClassName,proto3" json:"volume_storage_class_name,omitempty"`
 }
 
-func (x *FindAll) Reset() {
-	*x = FindAll{}
+func (x *RestoreSnapshotRequest) Reset() {
+	*x = RestoreSnapshotRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_volume_volume_proto_msgTypes[2]
+		mi := &file_proto_volume_volume_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FindAll) String() string {
+func (x *RestoreSnapshotRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FindAll) ProtoMessage() {}
+func (*RestoreSnapshotRequest) ProtoMessage() {}
 
-func (x *FindAll) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_volume_volume_proto_msgTypes[2]
+func (x *RestoreSnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_volume_volume_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -195,36 +1000,67 @@ func (x *FindAll) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FindAll.ProtoReflect.Descriptor instead.
-func (*FindAll) Descriptor() ([]byte, []int) {
-	return file_proto_volume_volume_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use RestoreSnapshotRequest.ProtoReflect.Descriptor instead.
+func (*RestoreSnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_proto_volume_volume_proto_rawDescGZIP(), []int{14}
 }
 
-type Response struct {
+func (x *RestoreSnapshotRequest) GetSnapshotId() int64 {
+	if x != nil {
+		return x.SnapshotId
+	}
+	return 0
+}
+
+func (x *RestoreSnapshotRequest) GetVolumeName() string {
+	if x != nil {
+		return x.VolumeName
+	}
+	return ""
+}
+
+func (x *RestoreSnapshotRequest) GetVolumeRequest() float32 {
+	if x != nil {
+		return x.VolumeRequest
+	}
+	return 0
+}
+
+func (x *RestoreSnapshotRequest) GetVolumeStorageClassName() string {
+	if x != nil {
+		return x.VolumeStorageClassName
+	}
+	return ""
+}
+
+// CloneVolumeRequest 克隆存储到同一个命名空间的新存储，大小为空时与来源存储相同
+type CloneVolumeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Msg string `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
+	VolumeId      int64   `protobuf:"varint,1,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
+	VolumeName    string  `protobuf:"bytes,2,opt,name=volume_name,json=volumeName,proto3" json:"volume_name,omitempty"`
+	VolumeRequest float32 `protobuf:"fixed32,3,opt,name=volume_request,json=volumeRequest,proto3" json:"volume_request,omitempty"`
 }
 
-func (x *Response) Reset() {
-	*x = Response{}
+func (x *CloneVolumeRequest) Reset() {
+	*x = CloneVolumeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_volume_volume_proto_msgTypes[3]
+		mi := &file_proto_volume_volume_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Response) String() string {
+func (x *CloneVolumeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Response) ProtoMessage() {}
+func (*CloneVolumeRequest) ProtoMessage() {}
 
-func (x *Response) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_volume_volume_proto_msgTypes[3]
+func (x *CloneVolumeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_volume_volume_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -235,43 +1071,58 @@ func (x *Response) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Response.ProtoReflect.Descriptor instead.
-func (*Response) Descriptor() ([]byte, []int) {
-	return file_proto_volume_volume_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use CloneVolumeRequest.ProtoReflect.Descriptor instead.
+func (*CloneVolumeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_volume_volume_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *Response) GetMsg() string {
+func (x *CloneVolumeRequest) GetVolumeId() int64 {
 	if x != nil {
-		return x.Msg
+		return x.VolumeId
+	}
+	return 0
+}
+
+func (x *CloneVolumeRequest) GetVolumeName() string {
+	if x != nil {
+		return x.VolumeName
 	}
 	return ""
 }
 
-type AllVolume struct {
+func (x *CloneVolumeRequest) GetVolumeRequest() float32 {
+	if x != nil {
+		return x.VolumeRequest
+	}
+	return 0
+}
+
+// 导出的k8s对象
+type ExportResult struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	VolumeInfo []*VolumeInfo `protobuf:"bytes,1,rep,name=volume_info,json=volumeInfo,proto3" json:"volume_info,omitempty"`
+	Yaml string `protobuf:"bytes,1,opt,name=yaml,proto3" json:"yaml,omitempty"`
 }
 
-func (x *AllVolume) Reset() {
-	*x = AllVolume{}
+func (x *ExportResult) Reset() {
+	*x = ExportResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_volume_volume_proto_msgTypes[4]
+		mi := &file_proto_volume_volume_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AllVolume) String() string {
+func (x *ExportResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AllVolume) ProtoMessage() {}
+func (*ExportResult) ProtoMessage() {}
 
-func (x *AllVolume) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_volume_volume_proto_msgTypes[4]
+func (x *ExportResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_volume_volume_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -282,16 +1133,16 @@ func (x *AllVolume) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AllVolume.ProtoReflect.Descriptor instead.
-func (*AllVolume) Descriptor() ([]byte, []int) {
-	return file_proto_volume_volume_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use ExportResult.ProtoReflect.Descriptor instead.
+func (*ExportResult) Descriptor() ([]byte, []int) {
+	return file_proto_volume_volume_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *AllVolume) GetVolumeInfo() []*VolumeInfo {
+func (x *ExportResult) GetYaml() string {
 	if x != nil {
-		return x.VolumeInfo
+		return x.Yaml
 	}
-	return nil
+	return ""
 }
 
 var File_proto_volume_volume_proto protoreflect.FileDescriptor
@@ -299,7 +1150,7 @@ var File_proto_volume_volume_proto protoreflect.FileDescriptor
 var file_proto_volume_volume_proto_rawDesc = []byte{
 	0x0a, 0x19, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x2f, 0x76,
 	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06, 0x76, 0x6f, 0x6c,
-	0x75, 0x6d, 0x65, 0x22, 0xbb, 0x02, 0x0a, 0x0a, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49, 0x6e,
+	0x75, 0x6d, 0x65, 0x22, 0xfb, 0x05, 0x0a, 0x0a, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49, 0x6e,
 	0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02,
 	0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x6e, 0x61, 0x6d,
 	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x4e,
@@ -319,35 +1170,194 @@ var file_proto_volume_volume_proto_rawDesc = []byte{
 	0x65, 0x6e, 0x74, 0x5f, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18,
 	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1a, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x50, 0x65, 0x72,
 	0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x4d, 0x6f, 0x64,
-	0x65, 0x22, 0x1a, 0x0a, 0x08, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49, 0x44, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x09, 0x0a,
+	0x65, 0x12, 0x30, 0x0a, 0x14, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x69,
+	0x7a, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x12, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x69, 0x7a, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x72, 0x65,
+	0x73, 0x69, 0x7a, 0x65, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
+	0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x69, 0x7a, 0x65, 0x4d, 0x73, 0x67, 0x12,
+	0x35, 0x0a, 0x17, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x14, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x35, 0x0a, 0x17, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
+	0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x44,
+	0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a,
+	0x0c, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x70, 0x68, 0x61, 0x73, 0x65, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x50, 0x68, 0x61, 0x73, 0x65,
+	0x12, 0x26, 0x0a, 0x0f, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x62, 0x6f, 0x75, 0x6e, 0x64,
+	0x5f, 0x70, 0x76, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x76, 0x6f, 0x6c, 0x75, 0x6d,
+	0x65, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x50, 0x76, 0x12, 0x27, 0x0a, 0x0f, 0x76, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x5f, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x43, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74,
+	0x79, 0x12, 0x3b, 0x0a, 0x1a, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x61, 0x63, 0x74, 0x75,
+	0x61, 0x6c, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x73, 0x18,
+	0x0f, 0x20, 0x03, 0x28, 0x09, 0x52, 0x17, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x41, 0x63, 0x74,
+	0x75, 0x61, 0x6c, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4d, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x41,
+	0x0a, 0x10, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65,
+	0x72, 0x73, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x76, 0x6f, 0x6c, 0x75, 0x6d,
+	0x65, 0x2e, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72,
+	0x52, 0x0f, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72,
+	0x73, 0x22, 0x38, 0x0a, 0x0e, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x75,
+	0x6d, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x30, 0x0a, 0x08, 0x56,
+	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49, 0x44, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x22, 0x47, 0x0a,
+	0x13, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x45, 0x0a, 0x0c, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
+	0x52, 0x65, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
+	0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0d,
+	0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x09, 0x0a,
 	0x07, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x22, 0x1c, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70,
 	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x22, 0x40, 0x0a, 0x09, 0x41, 0x6c, 0x6c, 0x56, 0x6f, 0x6c,
 	0x75, 0x6d, 0x65, 0x12, 0x33, 0x0a, 0x0b, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x69, 0x6e,
 	0x66, 0x6f, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x76, 0x6f, 0x6c, 0x75, 0x6d,
 	0x65, 0x2e, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0a, 0x76, 0x6f,
-	0x6c, 0x75, 0x6d, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x32, 0x9c, 0x02, 0x0a, 0x06, 0x56, 0x6f, 0x6c,
-	0x75, 0x6d, 0x65, 0x12, 0x33, 0x0a, 0x09, 0x41, 0x64, 0x64, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
-	0x12, 0x12, 0x2e, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x2e, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
-	0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x10, 0x2e, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x2e, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x10, 0x2e, 0x76, 0x6f, 0x6c, 0x75, 0x6d,
-	0x65, 0x2e, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49, 0x44, 0x1a, 0x10, 0x2e, 0x76, 0x6f, 0x6c,
-	0x75, 0x6d, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x36,
-	0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x12,
-	0x2e, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x2e, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49, 0x6e,
-	0x66, 0x6f, 0x1a, 0x10, 0x2e, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x0e, 0x46, 0x69, 0x6e, 0x64, 0x56, 0x6f,
-	0x6c, 0x75, 0x6d, 0x65, 0x42, 0x79, 0x49, 0x44, 0x12, 0x10, 0x2e, 0x76, 0x6f, 0x6c, 0x75, 0x6d,
-	0x65, 0x2e, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49, 0x44, 0x1a, 0x12, 0x2e, 0x76, 0x6f, 0x6c,
-	0x75, 0x6d, 0x65, 0x2e, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00,
-	0x12, 0x35, 0x0a, 0x0d, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x56, 0x6f, 0x6c, 0x75, 0x6d,
-	0x65, 0x12, 0x0f, 0x2e, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41,
-	0x6c, 0x6c, 0x1a, 0x11, 0x2e, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x2e, 0x41, 0x6c, 0x6c, 0x56,
-	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x22, 0x00, 0x42, 0x17, 0x5a, 0x15, 0x2e, 0x2f, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2f, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x3b, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6c, 0x75, 0x6d, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0xf4, 0x01, 0x0a, 0x10, 0x53, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x65, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x5f, 0x70,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x63,
+	0x6c, 0x61, 0x69, 0x6d, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x2e, 0x0a, 0x13, 0x76, 0x6f,
+	0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x6f, 0x64,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x42,
+	0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x61, 0x6c,
+	0x6c, 0x6f, 0x77, 0x5f, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x65, 0x78, 0x70, 0x61, 0x6e,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x61, 0x6c, 0x6c, 0x6f,
+	0x77, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x45, 0x78, 0x70, 0x61, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x22,
+	0x50, 0x0a, 0x0f, 0x41, 0x6c, 0x6c, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x6c, 0x61,
+	0x73, 0x73, 0x12, 0x3d, 0x0a, 0x0d, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x63, 0x6c,
+	0x61, 0x73, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x76, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x6c, 0x61, 0x73,
+	0x73, 0x22, 0xef, 0x02, 0x0a, 0x0c, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49, 0x64, 0x12,
+	0x23, 0x0a, 0x0d, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x11, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f,
+	0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x11, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f,
+	0x72, 0x65, 0x61, 0x64, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x73, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x61, 0x64, 0x79, 0x12, 0x32, 0x0a, 0x15, 0x73, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x73,
+	0x69, 0x7a, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x73, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x21,
+	0x0a, 0x0c, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x4d, 0x73,
+	0x67, 0x12, 0x30, 0x0a, 0x14, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x12, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54,
+	0x69, 0x6d, 0x65, 0x22, 0x1c, 0x0a, 0x0a, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49,
+	0x44, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69,
+	0x64, 0x22, 0x83, 0x01, 0x0a, 0x0f, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
+	0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x73, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x43, 0x6c,
+	0x61, 0x73, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x48, 0x0a, 0x0b, 0x41, 0x6c, 0x6c, 0x53, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x39, 0x0a, 0x0d, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x6e, 0x66,
+	0x6f, 0x22, 0xbc, 0x01, 0x0a, 0x16, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
+	0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0a, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a,
+	0x0b, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x25,
+	0x0a, 0x0e, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0d, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x19, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f,
+	0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
+	0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x4e, 0x61, 0x6d, 0x65,
+	0x22, 0x79, 0x0a, 0x12, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x76, 0x6f, 0x6c, 0x75, 0x6d,
+	0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0d, 0x76, 0x6f,
+	0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x22, 0x0a, 0x0c, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x79,
+	0x61, 0x6d, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x79, 0x61, 0x6d, 0x6c, 0x32,
+	0xde, 0x06, 0x0a, 0x06, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x33, 0x0a, 0x09, 0x41, 0x64,
+	0x64, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x12, 0x2e, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
+	0x2e, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x10, 0x2e, 0x76, 0x6f,
+	0x6c, 0x75, 0x6d, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x34, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12,
+	0x10, 0x2e, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x2e, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49,
+	0x44, 0x1a, 0x10, 0x2e, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56,
+	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x12, 0x2e, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x2e, 0x56,
+	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x10, 0x2e, 0x76, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x38, 0x0a,
+	0x0e, 0x46, 0x69, 0x6e, 0x64, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x42, 0x79, 0x49, 0x44, 0x12,
+	0x10, 0x2e, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x2e, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49,
+	0x44, 0x1a, 0x12, 0x2e, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x2e, 0x56, 0x6f, 0x6c, 0x75, 0x6d,
+	0x65, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x1c, 0x46, 0x69, 0x6e, 0x64, 0x56,
+	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x41, 0x6e, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x2e, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
+	0x2e, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x1a, 0x12, 0x2e, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x2e, 0x56, 0x6f,
+	0x6c, 0x75, 0x6d, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x12, 0x35, 0x0a, 0x0d, 0x46, 0x69,
+	0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x0f, 0x2e, 0x76, 0x6f,
+	0x6c, 0x75, 0x6d, 0x65, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x1a, 0x11, 0x2e, 0x76,
+	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x2e, 0x41, 0x6c, 0x6c, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x22,
+	0x00, 0x12, 0x38, 0x0a, 0x0c, 0x52, 0x65, 0x73, 0x69, 0x7a, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d,
+	0x65, 0x12, 0x14, 0x2e, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x2e, 0x56, 0x6f, 0x6c, 0x75, 0x6d,
+	0x65, 0x52, 0x65, 0x73, 0x69, 0x7a, 0x65, 0x1a, 0x10, 0x2e, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
+	0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x12, 0x4c,
+	0x69, 0x73, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x65,
+	0x73, 0x12, 0x0f, 0x2e, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41,
+	0x6c, 0x6c, 0x1a, 0x17, 0x2e, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x2e, 0x41, 0x6c, 0x6c, 0x53,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x22, 0x00, 0x12, 0x3d, 0x0a,
+	0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12,
+	0x17, 0x2e, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x76, 0x6f, 0x6c, 0x75, 0x6d,
+	0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x0d,
+	0x4c, 0x69, 0x73, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x12, 0x10, 0x2e,
+	0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x2e, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49, 0x44, 0x1a,
+	0x13, 0x2e, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x2e, 0x41, 0x6c, 0x6c, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x12, 0x2e, 0x76, 0x6f, 0x6c, 0x75, 0x6d,
+	0x65, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x44, 0x1a, 0x10, 0x2e, 0x76,
+	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x45, 0x0a, 0x0f, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x12, 0x1e, 0x2e, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x2e, 0x52, 0x65, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x2e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0b, 0x43, 0x6c, 0x6f, 0x6e, 0x65,
+	0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x1a, 0x2e, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x2e,
+	0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x10, 0x2e, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x0c, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x10, 0x2e, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x2e,
+	0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49, 0x44, 0x1a, 0x14, 0x2e, 0x76, 0x6f, 0x6c, 0x75, 0x6d,
+	0x65, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x00,
+	0x42, 0x17, 0x5a, 0x15, 0x2e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x76, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x3b, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -362,31 +1372,64 @@ func file_proto_volume_volume_proto_rawDescGZIP() []byte {
 	return file_proto_volume_volume_proto_rawDescData
 }
 
-var file_proto_volume_volume_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_proto_volume_volume_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
 var file_proto_volume_volume_proto_goTypes = []interface{}{
-	(*VolumeInfo)(nil), // 0: volume.VolumeInfo
-	(*VolumeID)(nil),   // 1: volume.VolumeID
-	(*FindAll)(nil),    // 2: volume.FindAll
-	(*Response)(nil),   // 3: volume.Response
-	(*AllVolume)(nil),  // 4: volume.AllVolume
+	(*VolumeInfo)(nil),             // 0: volume.VolumeInfo
+	(*VolumeConsumer)(nil),         // 1: volume.VolumeConsumer
+	(*VolumeID)(nil),               // 2: volume.VolumeID
+	(*VolumeNamespaceName)(nil),    // 3: volume.VolumeNamespaceName
+	(*VolumeResize)(nil),           // 4: volume.VolumeResize
+	(*FindAll)(nil),                // 5: volume.FindAll
+	(*Response)(nil),               // 6: volume.Response
+	(*AllVolume)(nil),              // 7: volume.AllVolume
+	(*StorageClassInfo)(nil),       // 8: volume.StorageClassInfo
+	(*AllStorageClass)(nil),        // 9: volume.AllStorageClass
+	(*SnapshotInfo)(nil),           // 10: volume.SnapshotInfo
+	(*SnapshotID)(nil),             // 11: volume.SnapshotID
+	(*SnapshotRequest)(nil),        // 12: volume.SnapshotRequest
+	(*AllSnapshot)(nil),            // 13: volume.AllSnapshot
+	(*RestoreSnapshotRequest)(nil), // 14: volume.RestoreSnapshotRequest
+	(*CloneVolumeRequest)(nil),     // 15: volume.CloneVolumeRequest
+	(*ExportResult)(nil),           // 16: volume.ExportResult
 }
 var file_proto_volume_volume_proto_depIdxs = []int32{
-	0, // 0: volume.AllVolume.volume_info:type_name -> volume.VolumeInfo
-	0, // 1: volume.Volume.AddVolume:input_type -> volume.VolumeInfo
-	1, // 2: volume.Volume.DeleteVolume:input_type -> volume.VolumeID
-	0, // 3: volume.Volume.UpdateVolume:input_type -> volume.VolumeInfo
-	1, // 4: volume.Volume.FindVolumeByID:input_type -> volume.VolumeID
-	2, // 5: volume.Volume.FindAllVolume:input_type -> volume.FindAll
-	3, // 6: volume.Volume.AddVolume:output_type -> volume.Response
-	3, // 7: volume.Volume.DeleteVolume:output_type -> volume.Response
-	3, // 8: volume.Volume.UpdateVolume:output_type -> volume.Response
-	0, // 9: volume.Volume.FindVolumeByID:output_type -> volume.VolumeInfo
-	4, // 10: volume.Volume.FindAllVolume:output_type -> volume.AllVolume
-	6, // [6:11] is the sub-list for method output_type
-	1, // [1:6] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	1,  // 0: volume.VolumeInfo.volume_consumers:type_name -> volume.VolumeConsumer
+	0,  // 1: volume.AllVolume.volume_info:type_name -> volume.VolumeInfo
+	8,  // 2: volume.AllStorageClass.storage_class:type_name -> volume.StorageClassInfo
+	10, // 3: volume.AllSnapshot.snapshot_info:type_name -> volume.SnapshotInfo
+	0,  // 4: volume.Volume.AddVolume:input_type -> volume.VolumeInfo
+	2,  // 5: volume.Volume.DeleteVolume:input_type -> volume.VolumeID
+	0,  // 6: volume.Volume.UpdateVolume:input_type -> volume.VolumeInfo
+	2,  // 7: volume.Volume.FindVolumeByID:input_type -> volume.VolumeID
+	3,  // 8: volume.Volume.FindVolumeByNamespaceAndName:input_type -> volume.VolumeNamespaceName
+	5,  // 9: volume.Volume.FindAllVolume:input_type -> volume.FindAll
+	4,  // 10: volume.Volume.ResizeVolume:input_type -> volume.VolumeResize
+	5,  // 11: volume.Volume.ListStorageClasses:input_type -> volume.FindAll
+	12, // 12: volume.Volume.CreateSnapshot:input_type -> volume.SnapshotRequest
+	2,  // 13: volume.Volume.ListSnapshots:input_type -> volume.VolumeID
+	11, // 14: volume.Volume.DeleteSnapshot:input_type -> volume.SnapshotID
+	14, // 15: volume.Volume.RestoreSnapshot:input_type -> volume.RestoreSnapshotRequest
+	15, // 16: volume.Volume.CloneVolume:input_type -> volume.CloneVolumeRequest
+	2,  // 17: volume.Volume.ExportVolume:input_type -> volume.VolumeID
+	6,  // 18: volume.Volume.AddVolume:output_type -> volume.Response
+	6,  // 19: volume.Volume.DeleteVolume:output_type -> volume.Response
+	6,  // 20: volume.Volume.UpdateVolume:output_type -> volume.Response
+	0,  // 21: volume.Volume.FindVolumeByID:output_type -> volume.VolumeInfo
+	0,  // 22: volume.Volume.FindVolumeByNamespaceAndName:output_type -> volume.VolumeInfo
+	7,  // 23: volume.Volume.FindAllVolume:output_type -> volume.AllVolume
+	6,  // 24: volume.Volume.ResizeVolume:output_type -> volume.Response
+	9,  // 25: volume.Volume.ListStorageClasses:output_type -> volume.AllStorageClass
+	6,  // 26: volume.Volume.CreateSnapshot:output_type -> volume.Response
+	13, // 27: volume.Volume.ListSnapshots:output_type -> volume.AllSnapshot
+	6,  // 28: volume.Volume.DeleteSnapshot:output_type -> volume.Response
+	6,  // 29: volume.Volume.RestoreSnapshot:output_type -> volume.Response
+	6,  // 30: volume.Volume.CloneVolume:output_type -> volume.Response
+	16, // 31: volume.Volume.ExportVolume:output_type -> volume.ExportResult
+	18, // [18:32] is the sub-list for method output_type
+	4,  // [4:18] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_proto_volume_volume_proto_init() }
@@ -408,7 +1451,7 @@ func file_proto_volume_volume_proto_init() {
 			}
 		}
 		file_proto_volume_volume_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VolumeID); i {
+			switch v := v.(*VolumeConsumer); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -420,7 +1463,7 @@ func file_proto_volume_volume_proto_init() {
 			}
 		}
 		file_proto_volume_volume_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FindAll); i {
+			switch v := v.(*VolumeID); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -432,7 +1475,7 @@ func file_proto_volume_volume_proto_init() {
 			}
 		}
 		file_proto_volume_volume_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Response); i {
+			switch v := v.(*VolumeNamespaceName); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -444,6 +1487,42 @@ func file_proto_volume_volume_proto_init() {
 			}
 		}
 		file_proto_volume_volume_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VolumeResize); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_volume_volume_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindAll); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_volume_volume_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_volume_volume_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AllVolume); i {
 			case 0:
 				return &v.state
@@ -455,6 +1534,114 @@ func file_proto_volume_volume_proto_init() {
 				return nil
 			}
 		}
+		file_proto_volume_volume_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StorageClassInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_volume_volume_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AllStorageClass); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_volume_volume_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SnapshotInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_volume_volume_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SnapshotID); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_volume_volume_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SnapshotRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_volume_volume_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AllSnapshot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_volume_volume_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreSnapshotRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_volume_volume_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CloneVolumeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_volume_volume_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -462,7 +1649,7 @@ func file_proto_volume_volume_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_volume_volume_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   17,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -40,7 +40,20 @@ type VolumeService interface {
 	DeleteVolume(ctx context.Context, in *VolumeID, opts ...client.CallOption) (*Response, error)
 	UpdateVolume(ctx context.Context, in *VolumeInfo, opts ...client.CallOption) (*Response, error)
 	FindVolumeByID(ctx context.Context, in *VolumeID, opts ...client.CallOption) (*VolumeInfo, error)
+	FindVolumeByNamespaceAndName(ctx context.Context, in *VolumeNamespaceName, opts ...client.CallOption) (*VolumeInfo, error)
 	FindAllVolume(ctx context.Context, in *FindAll, opts ...client.CallOption) (*AllVolume, error)
+	// 在线扩容存储
+	ResizeVolume(ctx context.Context, in *VolumeResize, opts ...client.CallOption) (*Response, error)
+	// 查询集群中的存储类
+	ListStorageClasses(ctx context.Context, in *FindAll, opts ...client.CallOption) (*AllStorageClass, error)
+	// 快照、从快照恢复和克隆
+	CreateSnapshot(ctx context.Context, in *SnapshotRequest, opts ...client.CallOption) (*Response, error)
+	ListSnapshots(ctx context.Context, in *VolumeID, opts ...client.CallOption) (*AllSnapshot, error)
+	DeleteSnapshot(ctx context.Context, in *SnapshotID, opts ...client.CallOption) (*Response, error)
+	RestoreSnapshot(ctx context.Context, in *RestoreSnapshotRequest, opts ...client.CallOption) (*Response, error)
+	CloneVolume(ctx context.Context, in *CloneVolumeRequest, opts ...client.CallOption) (*Response, error)
+	// 导出应用到k8s的对象，多文档YAML
+	ExportVolume(ctx context.Context, in *VolumeID, opts ...client.CallOption) (*ExportResult, error)
 }
 
 type volumeService struct {
@@ -95,6 +108,16 @@ func (c *volumeService) FindVolumeByID(ctx context.Context, in *VolumeID, opts .
 	return out, nil
 }
 
+func (c *volumeService) FindVolumeByNamespaceAndName(ctx context.Context, in *VolumeNamespaceName, opts ...client.CallOption) (*VolumeInfo, error) {
+	req := c.c.NewRequest(c.name, "Volume.FindVolumeByNamespaceAndName", in)
+	out := new(VolumeInfo)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *volumeService) FindAllVolume(ctx context.Context, in *FindAll, opts ...client.CallOption) (*AllVolume, error) {
 	req := c.c.NewRequest(c.name, "Volume.FindAllVolume", in)
 	out := new(AllVolume)
@@ -105,6 +128,86 @@ func (c *volumeService) FindAllVolume(ctx context.Context, in *FindAll, opts ...
 	return out, nil
 }
 
+func (c *volumeService) ResizeVolume(ctx context.Context, in *VolumeResize, opts ...client.CallOption) (*Response, error) {
+	req := c.c.NewRequest(c.name, "Volume.ResizeVolume", in)
+	out := new(Response)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumeService) ListStorageClasses(ctx context.Context, in *FindAll, opts ...client.CallOption) (*AllStorageClass, error) {
+	req := c.c.NewRequest(c.name, "Volume.ListStorageClasses", in)
+	out := new(AllStorageClass)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumeService) CreateSnapshot(ctx context.Context, in *SnapshotRequest, opts ...client.CallOption) (*Response, error) {
+	req := c.c.NewRequest(c.name, "Volume.CreateSnapshot", in)
+	out := new(Response)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumeService) ListSnapshots(ctx context.Context, in *VolumeID, opts ...client.CallOption) (*AllSnapshot, error) {
+	req := c.c.NewRequest(c.name, "Volume.ListSnapshots", in)
+	out := new(AllSnapshot)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumeService) DeleteSnapshot(ctx context.Context, in *SnapshotID, opts ...client.CallOption) (*Response, error) {
+	req := c.c.NewRequest(c.name, "Volume.DeleteSnapshot", in)
+	out := new(Response)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumeService) RestoreSnapshot(ctx context.Context, in *RestoreSnapshotRequest, opts ...client.CallOption) (*Response, error) {
+	req := c.c.NewRequest(c.name, "Volume.RestoreSnapshot", in)
+	out := new(Response)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumeService) CloneVolume(ctx context.Context, in *CloneVolumeRequest, opts ...client.CallOption) (*Response, error) {
+	req := c.c.NewRequest(c.name, "Volume.CloneVolume", in)
+	out := new(Response)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumeService) ExportVolume(ctx context.Context, in *VolumeID, opts ...client.CallOption) (*ExportResult, error) {
+	req := c.c.NewRequest(c.name, "Volume.ExportVolume", in)
+	out := new(ExportResult)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for Volume service
 
 type VolumeHandler interface {
@@ -112,7 +215,20 @@ type VolumeHandler interface {
 	DeleteVolume(context.Context, *VolumeID, *Response) error
 	UpdateVolume(context.Context, *VolumeInfo, *Response) error
 	FindVolumeByID(context.Context, *VolumeID, *VolumeInfo) error
+	FindVolumeByNamespaceAndName(context.Context, *VolumeNamespaceName, *VolumeInfo) error
 	FindAllVolume(context.Context, *FindAll, *AllVolume) error
+	// 在线扩容存储
+	ResizeVolume(context.Context, *VolumeResize, *Response) error
+	// 查询集群中的存储类
+	ListStorageClasses(context.Context, *FindAll, *AllStorageClass) error
+	// 快照、从快照恢复和克隆
+	CreateSnapshot(context.Context, *SnapshotRequest, *Response) error
+	ListSnapshots(context.Context, *VolumeID, *AllSnapshot) error
+	DeleteSnapshot(context.Context, *SnapshotID, *Response) error
+	RestoreSnapshot(context.Context, *RestoreSnapshotRequest, *Response) error
+	CloneVolume(context.Context, *CloneVolumeRequest, *Response) error
+	// 导出应用到k8s的对象，多文档YAML
+	ExportVolume(context.Context, *VolumeID, *ExportResult) error
 }
 
 func RegisterVolumeHandler(s server.Server, hdlr VolumeHandler, opts ...server.HandlerOption) error {
@@ -121,7 +237,16 @@ func RegisterVolumeHandler(s server.Server, hdlr VolumeHandler, opts ...server.H
 		DeleteVolume(ctx context.Context, in *VolumeID, out *Response) error
 		UpdateVolume(ctx context.Context, in *VolumeInfo, out *Response) error
 		FindVolumeByID(ctx context.Context, in *VolumeID, out *VolumeInfo) error
+		FindVolumeByNamespaceAndName(ctx context.Context, in *VolumeNamespaceName, out *VolumeInfo) error
 		FindAllVolume(ctx context.Context, in *FindAll, out *AllVolume) error
+		ResizeVolume(ctx context.Context, in *VolumeResize, out *Response) error
+		ListStorageClasses(ctx context.Context, in *FindAll, out *AllStorageClass) error
+		CreateSnapshot(ctx context.Context, in *SnapshotRequest, out *Response) error
+		ListSnapshots(ctx context.Context, in *VolumeID, out *AllSnapshot) error
+		DeleteSnapshot(ctx context.Context, in *SnapshotID, out *Response) error
+		RestoreSnapshot(ctx context.Context, in *RestoreSnapshotRequest, out *Response) error
+		CloneVolume(ctx context.Context, in *CloneVolumeRequest, out *Response) error
+		ExportVolume(ctx context.Context, in *VolumeID, out *ExportResult) error
 	}
 	type Volume struct {
 		volume
@@ -150,6 +275,42 @@ func (h *volumeHandler) FindVolumeByID(ctx context.Context, in *VolumeID, out *V
 	return h.VolumeHandler.FindVolumeByID(ctx, in, out)
 }
 
+func (h *volumeHandler) FindVolumeByNamespaceAndName(ctx context.Context, in *VolumeNamespaceName, out *VolumeInfo) error {
+	return h.VolumeHandler.FindVolumeByNamespaceAndName(ctx, in, out)
+}
+
 func (h *volumeHandler) FindAllVolume(ctx context.Context, in *FindAll, out *AllVolume) error {
 	return h.VolumeHandler.FindAllVolume(ctx, in, out)
 }
+
+func (h *volumeHandler) ResizeVolume(ctx context.Context, in *VolumeResize, out *Response) error {
+	return h.VolumeHandler.ResizeVolume(ctx, in, out)
+}
+
+func (h *volumeHandler) ListStorageClasses(ctx context.Context, in *FindAll, out *AllStorageClass) error {
+	return h.VolumeHandler.ListStorageClasses(ctx, in, out)
+}
+
+func (h *volumeHandler) CreateSnapshot(ctx context.Context, in *SnapshotRequest, out *Response) error {
+	return h.VolumeHandler.CreateSnapshot(ctx, in, out)
+}
+
+func (h *volumeHandler) ListSnapshots(ctx context.Context, in *VolumeID, out *AllSnapshot) error {
+	return h.VolumeHandler.ListSnapshots(ctx, in, out)
+}
+
+func (h *volumeHandler) DeleteSnapshot(ctx context.Context, in *SnapshotID, out *Response) error {
+	return h.VolumeHandler.DeleteSnapshot(ctx, in, out)
+}
+
+func (h *volumeHandler) RestoreSnapshot(ctx context.Context, in *RestoreSnapshotRequest, out *Response) error {
+	return h.VolumeHandler.RestoreSnapshot(ctx, in, out)
+}
+
+func (h *volumeHandler) CloneVolume(ctx context.Context, in *CloneVolumeRequest, out *Response) error {
+	return h.VolumeHandler.CloneVolume(ctx, in, out)
+}
+
+func (h *volumeHandler) ExportVolume(ctx context.Context, in *VolumeID, out *ExportResult) error {
+	return h.VolumeHandler.ExportVolume(ctx, in, out)
+}
@@ -0,0 +1,69 @@
+package repository
+
+import (
+	"github.com/jinzhu/gorm"
+	"tini-paas/internal/volume/model"
+	"tini-paas/pkg/common"
+)
+
+// SnapshotRepository 存储快照数据库操作接口
+type SnapshotRepository interface {
+	// InitTable 初始化表
+	InitTable() error
+
+	// MigrateTable 迁移已有数据库，创建缺少的快照表
+	MigrateTable() error
+	CreateSnapshot(*model.VolumeSnapshot) (int64, error)
+	DeleteSnapshot(int64) error
+	UpdateSnapshot(*model.VolumeSnapshot) error
+	FindSnapshotByID(int64) (*model.VolumeSnapshot, error)
+
+	// FindAllSnapshotByVolumeID 查找存储的快照，新的在前
+	FindAllSnapshotByVolumeID(int64) ([]model.VolumeSnapshot, error)
+}
+
+// NewSnapshotRepository 初始化快照数据操作对象
+func NewSnapshotRepository(db *gorm.DB) SnapshotRepository {
+	return &Snapshot{
+		db: db,
+	}
+}
+
+// Snapshot 快照数据库对象
+type Snapshot struct {
+	db *gorm.DB
+}
+
+func (s *Snapshot) InitTable() error {
+	return s.db.CreateTable(&model.VolumeSnapshot{}).Error
+}
+
+// MigrateTable 迁移已有数据库，创建缺少的快照表
+func (s *Snapshot) MigrateTable() error {
+	return common.MigrateTables(s.db, &model.VolumeSnapshot{})
+}
+
+func (s *Snapshot) CreateSnapshot(snapshot *model.VolumeSnapshot) (int64, error) {
+	err := s.db.Create(snapshot).Error
+	return snapshot.ID, err
+}
+
+func (s *Snapshot) DeleteSnapshot(i int64) error {
+	return s.db.Where("id = ?", i).Delete(&model.VolumeSnapshot{}).Error
+}
+
+// UpdateSnapshot 快照状态会变回未就绪，使用Save更新零值字段
+func (s *Snapshot) UpdateSnapshot(snapshot *model.VolumeSnapshot) error {
+	return s.db.Save(snapshot).Error
+}
+
+func (s *Snapshot) FindSnapshotByID(i int64) (*model.VolumeSnapshot, error) {
+	snapshot := &model.VolumeSnapshot{}
+	return snapshot, s.db.First(snapshot, i).Error
+}
+
+// FindAllSnapshotByVolumeID 查找存储的快照，新的在前
+func (s *Snapshot) FindAllSnapshotByVolumeID(volumeID int64) ([]model.VolumeSnapshot, error) {
+	var snapshots []model.VolumeSnapshot
+	return snapshots, s.db.Where("volume_id = ?", volumeID).Order("id desc").Find(&snapshots).Error
+}
@@ -3,15 +3,20 @@ package repository
 import (
 	"github.com/jinzhu/gorm"
 	"tini-paas/internal/volume/model"
+	"tini-paas/pkg/common"
 )
 
 // VolumeRepository 存储卷数据库操作接口
 type VolumeRepository interface {
 	InitTable() error
+	MigrateTable() error
 	CreateVolume(*model.Volume) (int64, error)
 	DeleteVolume(int64) error
 	UpdateVolume(*model.Volume) error
 	FindVolumeByID(int64) (*model.Volume, error)
+
+	// FindVolumeByNamespaceAndName 根据命名空间和名称查找存储
+	FindVolumeByNamespaceAndName(string, string) (*model.Volume, error)
 	FindAll() ([]model.Volume, error)
 }
 
@@ -31,6 +36,15 @@ func (v *Volume) InitTable() error {
 	return v.db.CreateTable(&model.Volume{}).Error
 }
 
+// MigrateTable 迁移已有数据表，名称唯一改为同一命名空间内唯一，并补充数据来源和扩容状态等新增的字段
+func (v *Volume) MigrateTable() error {
+	err := common.MigrateUniqueIndex(v.db, &model.Volume{}, "", "idx_volume_namespace_name", "volume_namespace", "volume_name")
+	if err != nil {
+		return err
+	}
+	return common.MigrateTables(v.db, &model.Volume{})
+}
+
 func (v *Volume) CreateVolume(volume *model.Volume) (int64, error) {
 	return volume.ID, v.db.Create(volume).Error
 }
@@ -48,6 +62,12 @@ func (v *Volume) FindVolumeByID(i int64) (*model.Volume, error) {
 	return volume, v.db.First(volume, i).Error
 }
 
+// FindVolumeByNamespaceAndName 根据命名空间和名称查找存储
+func (v *Volume) FindVolumeByNamespaceAndName(namespace, name string) (*model.Volume, error) {
+	volume := &model.Volume{}
+	return volume, v.db.Where("volume_namespace = ? AND volume_name = ?", namespace, name).First(volume).Error
+}
+
 func (v *Volume) FindAll() ([]model.Volume, error) {
 	var volumeAll []model.Volume
 	return volumeAll, v.db.Find(&volumeAll).Error
@@ -0,0 +1,238 @@
+package service
+
+import (
+	"context"
+	"errors"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	v13 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"math"
+	"strconv"
+	"time"
+	"tini-paas/internal/volume/model"
+	"tini-paas/internal/volume/proto/volume"
+	"tini-paas/internal/volume/repository"
+	"tini-paas/pkg/common"
+)
+
+// 存储的数据来源
+const (
+	DataSourceSnapshot = "VolumeSnapshot"
+	DataSourcePVC      = "PersistentVolumeClaim"
+)
+
+// snapshotGroup 快照的API组，需要集群安装 external-snapshotter 的CRD和控制器
+const snapshotGroup = "snapshot.storage.k8s.io"
+
+// snapshotResource VolumeSnapshot 不在 client-go 中，通过动态客户端操作
+var snapshotResource = schema.GroupVersionResource{Group: snapshotGroup, Version: "v1", Resource: "volumesnapshots"}
+
+// SnapshotService 存储快照接口
+type SnapshotService interface {
+	// CreateSnapshot 为存储创建快照
+	CreateSnapshot(*model.Volume, string, string) (*model.VolumeSnapshot, error)
+
+	// ListSnapshots 同步k8s中的快照状态并返回存储的快照
+	ListSnapshots(*model.Volume) ([]model.VolumeSnapshot, error)
+
+	// DeleteSnapshot 删除快照，k8s中的快照内容按照快照类的删除策略处理
+	DeleteSnapshot(*model.VolumeSnapshot) error
+	FindSnapshotByID(int64) (*model.VolumeSnapshot, error)
+
+	// BuildRestoreVolume 生成从快照恢复的新存储，快照需要已经就绪
+	BuildRestoreVolume(*model.VolumeSnapshot, *model.Volume, *volume.RestoreSnapshotRequest) (*volume.VolumeInfo, error)
+}
+
+// NewSnapshotService 初始化快照服务
+func NewSnapshotService(snapshotRepository repository.SnapshotRepository, dynamicClient dynamic.Interface) SnapshotService {
+	return &SnapshotDataService{
+		SnapshotRepository: snapshotRepository,
+		DynamicClient:      dynamicClient,
+	}
+}
+
+// SnapshotDataService 快照服务对象
+type SnapshotDataService struct {
+	SnapshotRepository repository.SnapshotRepository
+
+	// DynamicClient 操作 VolumeSnapshot
+	DynamicClient dynamic.Interface
+}
+
+// CreateSnapshot 为存储创建快照，name为空时使用 <存储名称>-<时间戳>
+func (s *SnapshotDataService) CreateSnapshot(volumeModel *model.Volume, name, className string) (*model.VolumeSnapshot, error) {
+	if name == "" {
+		name = volumeModel.VolumeName + "-" + strconv.FormatInt(time.Now().Unix(), 10)
+	}
+
+	spec := map[string]interface{}{
+		"source": map[string]interface{}{
+			"persistentVolumeClaimName": volumeModel.VolumeName,
+		},
+	}
+	if className != "" {
+		spec["volumeSnapshotClassName"] = className
+	}
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": snapshotGroup + "/v1",
+		"kind":       "VolumeSnapshot",
+		"metadata": map[string]interface{}{
+			"name":      name,
+			"namespace": volumeModel.VolumeNamespace,
+			"labels": map[string]interface{}{
+				"volume-name": volumeModel.VolumeName,
+				"author":      "Paas",
+			},
+		},
+		"spec": spec,
+	}}
+
+	created, err := s.DynamicClient.Resource(snapshotResource).Namespace(volumeModel.VolumeNamespace).Create(context.TODO(), obj, v13.CreateOptions{})
+	if err != nil {
+		common.Error(err)
+		return nil, err
+	}
+
+	snapshot := &model.VolumeSnapshot{
+		VolumeID:           volumeModel.ID,
+		SnapshotName:       name,
+		SnapshotNamespace:  volumeModel.VolumeNamespace,
+		SnapshotClassName:  className,
+		SnapshotMsg:        "创建中",
+		SnapshotCreateTime: created.GetCreationTimestamp().Unix(),
+	}
+	_, err = s.SnapshotRepository.CreateSnapshot(snapshot)
+	if err != nil {
+		common.Error(err)
+		return nil, err
+	}
+	common.Info("存储 " + volumeModel.VolumeName + " 的快照 " + name + " 创建成功")
+	return snapshot, nil
+}
+
+// ListSnapshots 同步k8s中的快照状态并返回存储的快照
+func (s *SnapshotDataService) ListSnapshots(volumeModel *model.Volume) ([]model.VolumeSnapshot, error) {
+	snapshots, err := s.SnapshotRepository.FindAllSnapshotByVolumeID(volumeModel.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range snapshots {
+		changed, err := s.syncSnapshot(&snapshots[i])
+		if err != nil {
+			common.Error(err)
+			return nil, err
+		}
+		if !changed {
+			continue
+		}
+		err = s.SnapshotRepository.UpdateSnapshot(&snapshots[i])
+		if err != nil {
+			common.Error(err)
+			return nil, err
+		}
+	}
+	return snapshots, nil
+}
+
+// syncSnapshot 读取快照的状态，返回状态是否变化
+func (s *SnapshotDataService) syncSnapshot(snapshot *model.VolumeSnapshot) (bool, error) {
+	ready, restoreSize, msg := false, "", "创建中"
+	obj, err := s.DynamicClient.Resource(snapshotResource).Namespace(snapshot.SnapshotNamespace).Get(context.TODO(), snapshot.SnapshotName, v13.GetOptions{})
+	switch {
+	case k8serrors.IsNotFound(err):
+		msg = "快照在k8s中已经不存在"
+	case err != nil:
+		return false, err
+	default:
+		ready, _, _ = unstructured.NestedBool(obj.Object, "status", "readyToUse")
+		restoreSize, _, _ = unstructured.NestedString(obj.Object, "status", "restoreSize")
+		if ready {
+			msg = "可用"
+		}
+		if errMsg, ok, _ := unstructured.NestedString(obj.Object, "status", "error", "message"); ok {
+			msg = "快照失败：" + errMsg
+		}
+		if snapshot.SnapshotClassName == "" {
+			snapshot.SnapshotClassName, _, _ = unstructured.NestedString(obj.Object, "spec", "volumeSnapshotClassName")
+		}
+	}
+
+	changed := snapshot.SnapshotReady != ready || snapshot.SnapshotRestoreSize != restoreSize || snapshot.SnapshotMsg != msg
+	snapshot.SnapshotReady = ready
+	snapshot.SnapshotRestoreSize = restoreSize
+	snapshot.SnapshotMsg = msg
+	return changed, nil
+}
+
+// DeleteSnapshot 删除快照，k8s中已经不存在时只删除记录
+func (s *SnapshotDataService) DeleteSnapshot(snapshot *model.VolumeSnapshot) error {
+	err := s.DynamicClient.Resource(snapshotResource).Namespace(snapshot.SnapshotNamespace).Delete(context.TODO(), snapshot.SnapshotName, v13.DeleteOptions{})
+	if err != nil && !k8serrors.IsNotFound(err) {
+		common.Error(err)
+		return err
+	}
+
+	err = s.SnapshotRepository.DeleteSnapshot(snapshot.ID)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	common.Info("删除快照 " + snapshot.SnapshotName + " 成功")
+	return nil
+}
+
+func (s *SnapshotDataService) FindSnapshotByID(i int64) (*model.VolumeSnapshot, error) {
+	return s.SnapshotRepository.FindSnapshotByID(i)
+}
+
+// BuildRestoreVolume 生成从快照恢复的新存储，访问模式和存储类型与来源存储相同，来源存储已经删除时source为空
+// 新存储与快照在同一个命名空间，大小不能小于快照的恢复大小
+func (s *SnapshotDataService) BuildRestoreVolume(snapshot *model.VolumeSnapshot, source *model.Volume, req *volume.RestoreSnapshotRequest) (*volume.VolumeInfo, error) {
+	_, err := s.syncSnapshot(snapshot)
+	if err != nil {
+		return nil, err
+	}
+	if !snapshot.SnapshotReady {
+		return nil, errors.New("快照 " + snapshot.SnapshotName + " 还不能用于恢复：" + snapshot.SnapshotMsg)
+	}
+	if req.VolumeName == "" {
+		return nil, errors.New("需要指定新存储的名称")
+	}
+
+	info := &volume.VolumeInfo{
+		VolumeName:                 req.VolumeName,
+		VolumeNamespace:            snapshot.SnapshotNamespace,
+		VolumeAccessMode:           source.VolumeAccessMode,
+		VolumeStorageClassName:     req.VolumeStorageClassName,
+		VolumeRequest:              req.VolumeRequest,
+		VolumePersistentVolumeMode: source.VolumePersistentVolumeMode,
+		VolumeDataSourceKind:       DataSourceSnapshot,
+		VolumeDataSourceName:       snapshot.SnapshotName,
+	}
+	if info.VolumeStorageClassName == "" {
+		info.VolumeStorageClassName = source.VolumeStorageClassName
+	}
+	if info.VolumeRequest == 0 {
+		info.VolumeRequest = source.VolumeRequest
+	}
+
+	if snapshot.SnapshotRestoreSize != "" {
+		restoreSize, err := resource.ParseQuantity(snapshot.SnapshotRestoreSize)
+		if err != nil {
+			return nil, err
+		}
+		// 来源存储已经删除时按照恢复大小创建
+		if info.VolumeRequest == 0 {
+			info.VolumeRequest = float32(math.Ceil(float64(restoreSize.Value()) / (1 << 30)))
+		}
+		request := getStorageQuantity(info.VolumeRequest)
+		if request.Cmp(restoreSize) < 0 {
+			return nil, errors.New("新存储的大小 " + request.String() + " 小于快照的恢复大小 " + restoreSize.String())
+		}
+	}
+	return info, nil
+}
@@ -0,0 +1,136 @@
+package service
+
+import (
+	"context"
+	v12 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	v13 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"strconv"
+	"strings"
+	"tini-paas/internal/volume/model"
+	"tini-paas/internal/volume/proto/volume"
+	"tini-paas/pkg/common"
+)
+
+// SetVolumeStatus 读取PVC的实时状态和正在使用存储的资源
+func (v *VolumeDataService) SetVolumeStatus(volumeModel *model.Volume, info *volume.VolumeInfo) error {
+	pvc, err := v.K8sClientSet.CoreV1().PersistentVolumeClaims(volumeModel.VolumeNamespace).Get(context.TODO(), volumeModel.VolumeName, v13.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		info.VolumePhase = "NotFound"
+		return nil
+	}
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+
+	info.VolumePhase = string(pvc.Status.Phase)
+	info.VolumeBoundPv = pvc.Spec.VolumeName
+	if capacity, ok := pvc.Status.Capacity[v12.ResourceStorage]; ok {
+		info.VolumeCapacity = capacity.String()
+	}
+	for _, accessMode := range pvc.Status.AccessModes {
+		info.VolumeActualAccessModes = append(info.VolumeActualAccessModes, string(accessMode))
+	}
+
+	err = v.syncResizeStatus(volumeModel, pvc)
+	if err != nil {
+		return err
+	}
+	info.VolumeResizeStatus = volumeModel.VolumeResizeStatus
+	info.VolumeResizeMsg = volumeModel.VolumeResizeMsg
+
+	info.VolumeConsumers, err = v.FindConsumers(volumeModel.VolumeNamespace, volumeModel.VolumeName)
+	return err
+}
+
+// syncResizeStatus 扩容进度由后台跟踪，服务重启后跟踪会中断，读取时根据PVC的状态重新判断并写回数据库
+func (v *VolumeDataService) syncResizeStatus(volumeModel *model.Volume, pvc *v12.PersistentVolumeClaim) error {
+	if volumeModel.VolumeResizeStatus != common.ResizeResizing && volumeModel.VolumeResizeStatus != common.ResizeFileSystemResizePending {
+		return nil
+	}
+	status, msg := common.PVCResizeStatus(pvc)
+	if status == volumeModel.VolumeResizeStatus && msg == volumeModel.VolumeResizeMsg {
+		return nil
+	}
+	volumeModel.VolumeResizeStatus = status
+	volumeModel.VolumeResizeMsg = msg
+	err := v.VolumeRepository.UpdateVolume(volumeModel)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	common.Info("存储 " + volumeModel.VolumeName + " 扩容状态：" + status + " " + msg)
+	return nil
+}
+
+// FindConsumers 查找挂载了PVC的 Deployment、StatefulSet 和 pod
+// StatefulSet 通过 VolumeClaimTemplates 为每个实例创建的PVC也算作使用
+func (v *VolumeDataService) FindConsumers(namespace, claimName string) ([]*volume.VolumeConsumer, error) {
+	var consumers []*volume.VolumeConsumer
+
+	deployments, err := v.K8sClientSet.AppsV1().Deployments(namespace).List(context.TODO(), v13.ListOptions{})
+	if err != nil {
+		common.Error(err)
+		return nil, err
+	}
+	for _, deployment := range deployments.Items {
+		if mountsClaim(deployment.Spec.Template.Spec.Volumes, claimName) {
+			consumers = append(consumers, &volume.VolumeConsumer{Kind: "Deployment", Name: deployment.Name})
+		}
+	}
+
+	statefulSets, err := v.K8sClientSet.AppsV1().StatefulSets(namespace).List(context.TODO(), v13.ListOptions{})
+	if err != nil {
+		common.Error(err)
+		return nil, err
+	}
+	for _, statefulSet := range statefulSets.Items {
+		used := mountsClaim(statefulSet.Spec.Template.Spec.Volumes, claimName)
+		for _, template := range statefulSet.Spec.VolumeClaimTemplates {
+			if isStatefulSetClaim(claimName, template.Name, statefulSet.Name) {
+				used = true
+			}
+		}
+		if used {
+			consumers = append(consumers, &volume.VolumeConsumer{Kind: "StatefulSet", Name: statefulSet.Name})
+		}
+	}
+
+	pods, err := v.K8sClientSet.CoreV1().Pods(namespace).List(context.TODO(), v13.ListOptions{})
+	if err != nil {
+		common.Error(err)
+		return nil, err
+	}
+	for _, pod := range pods.Items {
+		// 已经结束的pod不再使用存储
+		if pod.Status.Phase == v12.PodSucceeded || pod.Status.Phase == v12.PodFailed {
+			continue
+		}
+		if mountsClaim(pod.Spec.Volumes, claimName) {
+			consumers = append(consumers, &volume.VolumeConsumer{Kind: "Pod", Name: pod.Name})
+		}
+	}
+	return consumers, nil
+}
+
+// mountsClaim 卷中是否引用了PVC
+func mountsClaim(volumes []v12.Volume, claimName string) bool {
+	for _, vol := range volumes {
+		if vol.PersistentVolumeClaim != nil && vol.PersistentVolumeClaim.ClaimName == claimName {
+			return true
+		}
+	}
+	return false
+}
+
+// isStatefulSetClaim PVC是否由StatefulSet的模板创建，名称为 <模板名称>-<StatefulSet名称>-<序号>
+// 序号必须是数字，否则StatefulSet web 会被当作 data-web-api-0 的使用者
+func isStatefulSetClaim(claimName, templateName, statefulSetName string) bool {
+	ordinal := strings.TrimPrefix(claimName, templateName+"-"+statefulSetName+"-")
+	if ordinal == claimName || ordinal == "" {
+		return false
+	}
+	_, err := strconv.ParseUint(ordinal, 10, 32)
+	return err == nil
+}
@@ -5,10 +5,14 @@ import (
 	"errors"
 	v1 "k8s.io/api/apps/v1"
 	v12 "k8s.io/api/core/v1"
+	v14 "k8s.io/api/storage/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	v13 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes"
 	"strconv"
+	"strings"
 	"tini-paas/internal/volume/model"
 	"tini-paas/internal/volume/proto/volume"
 	"tini-paas/internal/volume/repository"
@@ -21,10 +25,34 @@ type VolumeService interface {
 	DeleteVolume(int64) error
 	UpdateVolume(*model.Volume) error
 	FindVolume(int64) (*model.Volume, error)
+	FindVolumeByNamespaceAndName(string, string) (*model.Volume, error)
 	FindAllVolume() ([]model.Volume, error)
 
 	CreateVolumeToK8s(*volume.VolumeInfo) error
-	DeleteVolumeFromK8s(*model.Volume) error
+
+	// DeleteVolumeFromK8s 删除存储，仍在使用时需要force才能删除
+	DeleteVolumeFromK8s(*model.Volume, bool) error
+
+	// SetVolumeStatus 读取PVC的实时状态和正在使用存储的资源
+	SetVolumeStatus(*model.Volume, *volume.VolumeInfo) error
+
+	// FindConsumers 查找挂载了PVC的 Deployment、StatefulSet 和 pod
+	FindConsumers(string, string) ([]*volume.VolumeConsumer, error)
+
+	// ResizeVolume 在线扩容存储，大小单位为Gi
+	ResizeVolume(*model.Volume, float32) error
+
+	// ListStorageClasses 查询集群中的存储类
+	ListStorageClasses() ([]*volume.StorageClassInfo, error)
+
+	// CheckStorageClass 检查存储类是否存在，未指定时使用集群的默认存储类
+	CheckStorageClass(*volume.VolumeInfo) error
+
+	// BuildCloneVolume 生成克隆source的新存储
+	BuildCloneVolume(*model.Volume, *volume.CloneVolumeRequest) (*volume.VolumeInfo, error)
+
+	// ExportYAML 导出应用到k8s的PVC
+	ExportYAML(*volume.VolumeInfo) (string, error)
 }
 
 // NewVolumeService 初始化存储卷服务
@@ -59,36 +87,202 @@ func (v *VolumeDataService) FindVolume(i int64) (*model.Volume, error) {
 	return v.VolumeRepository.FindVolumeByID(i)
 }
 
+func (v *VolumeDataService) FindVolumeByNamespaceAndName(namespace, name string) (*model.Volume, error) {
+	return v.VolumeRepository.FindVolumeByNamespaceAndName(namespace, name)
+}
+
 func (v *VolumeDataService) FindAllVolume() ([]model.Volume, error) {
 	return v.VolumeRepository.FindAll()
 }
 
+// CreateVolumeToK8s 创建存储到k8s
+// 采用服务端应用(server-side apply)，平台创建过的同名对象按照重试处理，不是平台创建的同名对象返回错误
 func (v *VolumeDataService) CreateVolumeToK8s(info *volume.VolumeInfo) error {
-	volume := v.setVolume(info)
+	err := common.CheckCreate("存储空间", info.VolumeName, func() (v13.Object, error) {
+		return v.K8sClientSet.CoreV1().PersistentVolumeClaims(info.VolumeNamespace).Get(context.TODO(), info.VolumeName, v13.GetOptions{})
+	})
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	err = v.applyVolume(info)
+	if err != nil {
+		return err
+	}
+	common.Info("存储创建成功")
+	return nil
+}
+
+// ResizeVolume 在线扩容存储，大小单位为Gi
+// 存储类需要开启 allowVolumeExpansion，扩容进度在后台跟踪并写回数据库
+func (v *VolumeDataService) ResizeVolume(volumeModel *model.Volume, size float32) error {
+	info := &volume.VolumeInfo{}
+	err := common.SwapTo(volumeModel, info)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	info.VolumeRequest = size
+
+	pvc, err := v.K8sClientSet.CoreV1().PersistentVolumeClaims(volumeModel.VolumeNamespace).Get(context.TODO(), volumeModel.VolumeName, v13.GetOptions{})
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	err = common.CheckPVCResize(v.K8sClientSet, pvc, v.getResource(info).Requests[v12.ResourceStorage])
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+
+	// 与创建时一样以平台的身份应用，保证之后的更新不会产生字段冲突
+	err = v.applyVolume(info)
+	if err != nil {
+		return err
+	}
 
-	// 先查询之前是否存在
-	_, err := v.K8sClientSet.CoreV1().PersistentVolumeClaims(info.VolumeNamespace).Get(context.TODO(), info.VolumeName, v13.GetOptions{})
+	volumeModel.VolumeRequest = size
+	volumeModel.VolumeResizeStatus = common.ResizeResizing
+	volumeModel.VolumeResizeMsg = "等待扩容"
+	err = v.VolumeRepository.UpdateVolume(volumeModel)
 	if err != nil {
-		// 之前不存在 -> 可以创建
-		_, err = v.K8sClientSet.CoreV1().PersistentVolumeClaims(info.VolumeNamespace).Create(context.TODO(), volume, v13.CreateOptions{})
+		common.Error(err)
+		return err
+	}
+
+	go common.WatchPVCResize(v.K8sClientSet, volumeModel.VolumeNamespace, volumeModel.VolumeName, func(status, msg string) {
+		volumeModel.VolumeResizeStatus = status
+		volumeModel.VolumeResizeMsg = msg
+		err := v.VolumeRepository.UpdateVolume(volumeModel)
 		if err != nil {
-			// 创建失败
 			common.Error(err)
-			return err
 		}
-		common.Info("存储创建成功")
-		return nil
+		common.Info("存储 " + volumeModel.VolumeName + " 扩容状态：" + status + " " + msg)
+	})
+	return nil
+}
+
+// applyVolume 以平台字段管理者的身份将PVC应用到k8s
+func (v *VolumeDataService) applyVolume(info *volume.VolumeInfo) error {
+	data, err := common.ApplyData(v.setVolume(info))
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+
+	opts, err := common.ApplyOptions(func() (v13.Object, error) {
+		return v.K8sClientSet.CoreV1().PersistentVolumeClaims(info.VolumeNamespace).Get(context.TODO(), info.VolumeName, v13.GetOptions{})
+	})
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	// 字段冲突时不强制覆盖，返回冲突信息
+	_, err = v.K8sClientSet.CoreV1().PersistentVolumeClaims(info.VolumeNamespace).Patch(context.TODO(), info.VolumeName, types.ApplyPatchType, data, opts)
+	if err != nil {
+		err = common.ApplyError("存储空间", info.VolumeName, err)
+		common.Error(err)
+		return err
+	}
+	return nil
+}
+
+// ListStorageClasses 查询集群中的存储类
+func (v *VolumeDataService) ListStorageClasses() ([]*volume.StorageClassInfo, error) {
+	list, err := v.K8sClientSet.StorageV1().StorageClasses().List(context.TODO(), v13.ListOptions{})
+	if err != nil {
+		common.Error(err)
+		return nil, err
+	}
+
+	var storageClasses []*volume.StorageClassInfo
+	for i := range list.Items {
+		storageClass := &list.Items[i]
+		info := &volume.StorageClassInfo{
+			Name:        storageClass.Name,
+			Provisioner: storageClass.Provisioner,
+			// 未设置时为k8s的默认值
+			ReclaimPolicy:     string(v12.PersistentVolumeReclaimDelete),
+			VolumeBindingMode: string(v14.VolumeBindingImmediate),
+			IsDefault:         common.IsDefaultStorageClass(storageClass),
+		}
+		if storageClass.ReclaimPolicy != nil {
+			info.ReclaimPolicy = string(*storageClass.ReclaimPolicy)
+		}
+		if storageClass.VolumeBindingMode != nil {
+			info.VolumeBindingMode = string(*storageClass.VolumeBindingMode)
+		}
+		if storageClass.AllowVolumeExpansion != nil {
+			info.AllowVolumeExpansion = *storageClass.AllowVolumeExpansion
+		}
+		storageClasses = append(storageClasses, info)
+	}
+	return storageClasses, nil
+}
+
+// CheckStorageClass 检查存储类是否存在，未指定时使用集群的默认存储类
+func (v *VolumeDataService) CheckStorageClass(info *volume.VolumeInfo) error {
+	name, err := common.ResolveStorageClass(v.K8sClientSet, info.VolumeStorageClassName)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	info.VolumeStorageClassName = name
+	return nil
+}
+
+// BuildCloneVolume 生成克隆source的新存储
+// CSI克隆要求新存储与来源在同一个命名空间、使用相同的存储类，并且不小于来源的大小
+func (v *VolumeDataService) BuildCloneVolume(source *model.Volume, req *volume.CloneVolumeRequest) (*volume.VolumeInfo, error) {
+	if req.VolumeName == "" {
+		return nil, errors.New("需要指定新存储的名称")
+	}
+	pvc, err := v.K8sClientSet.CoreV1().PersistentVolumeClaims(source.VolumeNamespace).Get(context.TODO(), source.VolumeName, v13.GetOptions{})
+	if err != nil {
+		common.Error(err)
+		return nil, err
 	}
 
-	// 之前存在
-	common.Error("存储空间" + info.VolumeName + "已经存在")
-	return errors.New("存储空间" + info.VolumeName + "已经存在")
+	info := &volume.VolumeInfo{
+		VolumeName:                 req.VolumeName,
+		VolumeNamespace:            source.VolumeNamespace,
+		VolumeAccessMode:           source.VolumeAccessMode,
+		VolumeStorageClassName:     source.VolumeStorageClassName,
+		VolumeRequest:              req.VolumeRequest,
+		VolumePersistentVolumeMode: source.VolumePersistentVolumeMode,
+		VolumeDataSourceKind:       DataSourcePVC,
+		VolumeDataSourceName:       source.VolumeName,
+	}
+	if info.VolumeRequest == 0 {
+		info.VolumeRequest = source.VolumeRequest
+	}
+
+	current := pvc.Spec.Resources.Requests[v12.ResourceStorage]
+	if request := getStorageQuantity(info.VolumeRequest); request.Cmp(current) < 0 {
+		return nil, errors.New("新存储的大小 " + request.String() + " 小于来源存储 " + current.String())
+	}
+	return info, nil
 }
 
-func (v *VolumeDataService) DeleteVolumeFromK8s(volume *model.Volume) error {
-	// 先从k8s中删除
+// DeleteVolumeFromK8s 删除存储，仍有工作负载或pod使用时拒绝删除，force为true时强制删除
+func (v *VolumeDataService) DeleteVolumeFromK8s(volume *model.Volume, force bool) error {
+	if !force {
+		consumers, err := v.FindConsumers(volume.VolumeNamespace, volume.VolumeName)
+		if err != nil {
+			return err
+		}
+		if len(consumers) > 0 {
+			var names []string
+			for _, consumer := range consumers {
+				names = append(names, consumer.Kind+"/"+consumer.Name)
+			}
+			return errors.New("存储 " + volume.VolumeName + " 正在被使用：" + strings.Join(names, ", ") + "，需要强制删除")
+		}
+	}
+
+	// 先从k8s中删除，已经不存在时只删除记录
 	err := v.K8sClientSet.CoreV1().PersistentVolumeClaims(volume.VolumeNamespace).Delete(context.TODO(), volume.VolumeName, v13.DeleteOptions{})
-	if err != nil {
+	if err != nil && !k8serrors.IsNotFound(err) {
 		// 删除失败
 		common.Error(err)
 		return err
@@ -104,6 +298,11 @@ func (v *VolumeDataService) DeleteVolumeFromK8s(volume *model.Volume) error {
 	return nil
 }
 
+// ExportYAML 导出应用到k8s的PVC，与 CreateVolumeToK8s 发送的内容一致
+func (v *VolumeDataService) ExportYAML(info *volume.VolumeInfo) (string, error) {
+	return common.ExportYAML(v.setVolume(info))
+}
+
 // setVolume 设置pvc详情信息
 func (v *VolumeDataService) setVolume(info *volume.VolumeInfo) *v12.PersistentVolumeClaim {
 	pvc := &v12.PersistentVolumeClaim{}
@@ -119,17 +318,27 @@ func (v *VolumeDataService) setVolume(info *volume.VolumeInfo) *v12.PersistentVo
 		Name:      info.VolumeName,
 		Namespace: info.VolumeNamespace,
 		Annotations: map[string]string{
-			"pv.kubernetes.io/bound-by-controller":          "yes", // 绑定控制器自动绑定
-			"volume.beta.kubernetes.io/storage-provisioner": "rbd.csi.ceph.com",
+			"pv.kubernetes.io/bound-by-controller": "yes", // 绑定控制器自动绑定
 		},
 	}
 
-	// 设置存储动态信息
+	// 设置存储动态信息，供应者由存储类决定
 	pvc.Spec = v12.PersistentVolumeClaimSpec{
-		AccessModes:      v.getAccessMode(info),
-		Resources:        v.getResource(info),
-		StorageClassName: &info.VolumeStorageClassName,
-		VolumeMode:       v.getVolumeMode(info),
+		AccessModes: v.getAccessMode(info),
+		Resources:   v.getResource(info),
+		VolumeMode:  v.getVolumeMode(info),
+	}
+	if info.VolumeStorageClassName != "" {
+		pvc.Spec.StorageClassName = &info.VolumeStorageClassName
+	}
+
+	// 从快照恢复或者克隆已有的存储
+	switch info.VolumeDataSourceKind {
+	case DataSourceSnapshot:
+		group := snapshotGroup
+		pvc.Spec.DataSource = &v12.TypedLocalObjectReference{APIGroup: &group, Kind: DataSourceSnapshot, Name: info.VolumeDataSourceName}
+	case DataSourcePVC:
+		pvc.Spec.DataSource = &v12.TypedLocalObjectReference{Kind: DataSourcePVC, Name: info.VolumeDataSourceName}
 	}
 	return pvc
 }
@@ -159,11 +368,16 @@ func (v *VolumeDataService) getAccessMode(info *volume.VolumeInfo) []v12.Persist
 func (v *VolumeDataService) getResource(info *volume.VolumeInfo) v12.ResourceRequirements {
 	source := v12.ResourceRequirements{}
 	source.Requests = v12.ResourceList{
-		"storage": resource.MustParse(strconv.FormatFloat(float64(info.VolumeRequest), 'f', 6, 64) + "Gi"),
+		"storage": getStorageQuantity(info.VolumeRequest),
 	}
 	return source
 }
 
+// getStorageQuantity 存储大小(Gi)对应的容量
+func getStorageQuantity(size float32) resource.Quantity {
+	return resource.MustParse(strconv.FormatFloat(float64(size), 'f', 6, 64) + "Gi")
+}
+
 // getVolumeMode 获取存储类型
 func (v *VolumeDataService) getVolumeMode(info *volume.VolumeInfo) *v12.PersistentVolumeMode {
 	var pvm v12.PersistentVolumeMode
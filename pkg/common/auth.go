@@ -0,0 +1,89 @@
+package common
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"github.com/asim/go-micro/v3/metadata"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// AuthSecretEnv 签发和校验登录令牌的密钥，全部服务需要配置相同的值
+const AuthSecretEnv = "PAAS_AUTH_SECRET"
+
+// AuthHeader 携带登录令牌的请求头，API网关会将请求头放入RPC的metadata中
+const AuthHeader = "Authorization"
+
+// TokenTTL 登录令牌的有效期
+const TokenTTL = 24 * time.Hour
+
+// ErrUnauthenticated 没有登录或者令牌无效
+var ErrUnauthenticated = errors.New("没有登录或者登录令牌无效")
+
+// SignToken 为用户签发登录令牌，格式为 用户ID.过期时间.签名
+func SignToken(userID int64) (string, error) {
+	secret := os.Getenv(AuthSecretEnv)
+	if secret == "" {
+		return "", errors.New("没有配置 " + AuthSecretEnv + "，无法签发登录令牌")
+	}
+	payload := strconv.FormatInt(userID, 10) + "." + strconv.FormatInt(time.Now().Add(TokenTTL).Unix(), 10)
+	return payload + "." + tokenSignature(secret, payload), nil
+}
+
+// VerifyToken 校验登录令牌，返回令牌中的用户ID
+func VerifyToken(token string) (int64, error) {
+	secret := os.Getenv(AuthSecretEnv)
+	if secret == "" {
+		return 0, errors.New("没有配置 " + AuthSecretEnv + "，无法校验登录令牌")
+	}
+
+	token = strings.TrimSpace(strings.TrimPrefix(token, "Bearer "))
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return 0, ErrUnauthenticated
+	}
+	payload := parts[0] + "." + parts[1]
+	if !hmac.Equal([]byte(parts[2]), []byte(tokenSignature(secret, payload))) {
+		return 0, ErrUnauthenticated
+	}
+
+	userID, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return 0, ErrUnauthenticated
+	}
+	expire, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil || time.Now().Unix() > expire {
+		return 0, ErrUnauthenticated
+	}
+	return userID, nil
+}
+
+// WithToken 将登录令牌放入调用后端服务的metadata中
+func WithToken(ctx context.Context, token string) context.Context {
+	if token == "" {
+		return ctx
+	}
+	return metadata.Set(ctx, AuthHeader, token)
+}
+
+// UserIDFromContext 从RPC的metadata中读取登录令牌并校验，返回登录的用户ID
+// 需要鉴权的接口只能使用这里返回的用户ID，不能信任请求参数中的用户ID
+func UserIDFromContext(ctx context.Context) (int64, error) {
+	token, ok := metadata.Get(ctx, AuthHeader)
+	if !ok || token == "" {
+		return 0, ErrUnauthenticated
+	}
+	return VerifyToken(token)
+}
+
+// tokenSignature 计算令牌签名
+func tokenSignature(secret, payload string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(payload))
+	return hex.EncodeToString(mac.Sum(nil))
+}
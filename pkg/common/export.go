@@ -0,0 +1,74 @@
+package common
+
+import (
+	"encoding/json"
+	"sigs.k8s.io/yaml"
+	"strings"
+)
+
+// ExportYAML 将服务端应用时发送的k8s对象转换为多文档YAML
+// 去掉序列化产生的空值(creationTimestamp: null、status: {} 等)，便于审阅和在其他集群中导入
+func ExportYAML(objs ...interface{}) (string, error) {
+	var docs []string
+	for _, obj := range objs {
+		doc, err := exportDocument(obj)
+		if err != nil {
+			return "", err
+		}
+		docs = append(docs, doc)
+	}
+	return JoinYAML(docs...), nil
+}
+
+// JoinYAML 合并多个YAML文档
+func JoinYAML(docs ...string) string {
+	var result []string
+	for _, doc := range docs {
+		doc = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(doc), "---"))
+		if doc != "" {
+			result = append(result, "---\n"+doc+"\n")
+		}
+	}
+	return strings.Join(result, "")
+}
+
+// exportDocument 将一个对象转换为YAML文档
+func exportDocument(obj interface{}) (string, error) {
+	data, err := ApplyData(obj)
+	if err != nil {
+		return "", err
+	}
+	object := map[string]interface{}{}
+	err = json.Unmarshal(data, &object)
+	if err != nil {
+		return "", err
+	}
+
+	if status, ok := object["status"].(map[string]interface{}); ok && len(status) == 0 {
+		delete(object, "status")
+	}
+	doc, err := yaml.Marshal(dropNull(object))
+	if err != nil {
+		return "", err
+	}
+	return string(doc), nil
+}
+
+// dropNull 递归去掉值为null的字段，空对象(如 emptyDir: {})有含义，保留
+func dropNull(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, item := range v {
+			if item == nil {
+				delete(v, key)
+				continue
+			}
+			v[key] = dropNull(item)
+		}
+	case []interface{}:
+		for i := range v {
+			v[i] = dropNull(v[i])
+		}
+	}
+	return value
+}
@@ -0,0 +1,97 @@
+package common
+
+import (
+	"encoding/json"
+	"errors"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"strings"
+)
+
+// FieldManager 服务端应用(server-side apply)时平台使用的字段管理者名称
+const FieldManager = "tini-paas"
+
+// ApplyPatchOptions 服务端应用参数
+// force 为 false 时，与其它字段管理者(HPA、sidecar注入等)冲突会返回错误而不是强行覆盖
+func ApplyPatchOptions(force bool) v1.PatchOptions {
+	return v1.PatchOptions{
+		FieldManager: FieldManager,
+		Force:        &force,
+	}
+}
+
+// ObjectGetter 查询集群中已有的同名对象，不存在时返回 NotFound 错误
+type ObjectGetter func() (v1.Object, error)
+
+// ManagedByPlatform 对象是否已经由平台通过服务端应用管理
+func ManagedByPlatform(obj v1.Object) bool {
+	for _, field := range obj.GetManagedFields() {
+		if field.Manager == FieldManager && field.Operation == v1.ManagedFieldsOperationApply {
+			return true
+		}
+	}
+	return false
+}
+
+// ApplyOptions 根据集群中已有的对象选择服务端应用参数
+// 改为服务端应用之前通过 Create/Update 写入的对象，字段属于旧的字段管理者，不强制应用时第一次修改就会冲突
+// 平台还没有应用过的对象强制应用一次接管字段，之后与其它管理者冲突时返回错误
+func ApplyOptions(get ObjectGetter) (v1.PatchOptions, error) {
+	existing, err := get()
+	if k8serrors.IsNotFound(err) {
+		return ApplyPatchOptions(false), nil
+	}
+	if err != nil {
+		return v1.PatchOptions{}, err
+	}
+	return ApplyOptionsFor(existing), nil
+}
+
+// ApplyOptionsFor 根据已经查询到的对象选择服务端应用参数，existing 为 nil 表示对象不存在
+func ApplyOptionsFor(existing v1.Object) v1.PatchOptions {
+	return ApplyPatchOptions(existing != nil && !ManagedByPlatform(existing))
+}
+
+// CheckCreate 创建前检查同名对象，不接管不是平台创建的对象
+// 平台应用过的同名对象按照重试处理，不返回错误
+func CheckCreate(kind, name string, get ObjectGetter) error {
+	existing, err := get()
+	if k8serrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if !ManagedByPlatform(existing) {
+		return errors.New(kind + " " + name + " 已经存在并且不是平台创建的")
+	}
+	return nil
+}
+
+// ApplyData 将k8s对象序列化为服务端应用需要的数据
+// 对象必须设置正确的 Kind 和 APIVersion
+func ApplyData(obj interface{}) ([]byte, error) {
+	return json.Marshal(obj)
+}
+
+// ApplyError 将服务端应用返回的错误转换为可读信息，冲突时列出冲突的字段和管理者
+func ApplyError(kind, name string, err error) error {
+	if err == nil {
+		return nil
+	}
+	if !k8serrors.IsConflict(err) {
+		return err
+	}
+
+	var conflicts []string
+	var statusErr k8serrors.APIStatus
+	if errors.As(err, &statusErr) && statusErr.Status().Details != nil {
+		for _, cause := range statusErr.Status().Details.Causes {
+			conflicts = append(conflicts, cause.Field+"("+cause.Message+")")
+		}
+	}
+	if len(conflicts) == 0 {
+		return errors.New(kind + " " + name + " 字段冲突：" + err.Error())
+	}
+	return errors.New(kind + " " + name + " 字段冲突：" + strings.Join(conflicts, "; "))
+}
@@ -0,0 +1,41 @@
+package common
+
+import (
+	"errors"
+	"github.com/jinzhu/gorm"
+)
+
+// MigrateUniqueIndex 将名称的全局唯一索引迁移为命名空间内的联合唯一索引
+// 旧索引存在时删除，新索引不存在时创建，可以在每次启动时重复执行
+// 旧版本没有唯一索引的表 oldIndex 传空字符串
+func MigrateUniqueIndex(db *gorm.DB, model interface{}, oldIndex, newIndex string, columns ...string) error {
+	table := db.NewScope(model).TableName()
+	if !db.HasTable(table) {
+		// 表还没有创建，建表时会按照模型创建新索引
+		return nil
+	}
+
+	dialect := db.Dialect()
+	if oldIndex != "" && dialect.HasIndex(table, oldIndex) {
+		err := db.Model(model).RemoveIndex(oldIndex).Error
+		if err != nil {
+			return err
+		}
+		Info("数据表 " + table + " 删除索引 " + oldIndex)
+	}
+
+	if !dialect.HasIndex(table, newIndex) {
+		err := db.Model(model).AddUniqueIndex(newIndex, columns...).Error
+		if err != nil {
+			return errors.New("数据表 " + table + " 创建唯一索引 " + newIndex + " 失败，请先处理同一命名空间内重名的数据：" + err.Error())
+		}
+		Info("数据表 " + table + " 创建唯一索引 " + newIndex)
+	}
+	return nil
+}
+
+// MigrateTables 按照模型创建缺少的数据表、字段和索引，已有的字段和数据不会修改，可以在每次启动时重复执行
+// 需要在 MigrateUniqueIndex 之后执行，避免旧的唯一索引与新索引同时存在
+func MigrateTables(db *gorm.DB, models ...interface{}) error {
+	return db.AutoMigrate(models...).Error
+}
@@ -0,0 +1,110 @@
+package common
+
+import (
+	"context"
+	"errors"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	v12 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"time"
+)
+
+// PVC扩容状态
+const (
+	ResizeResizing                = "Resizing"
+	ResizeFileSystemResizePending = "FileSystemResizePending"
+	ResizeCompleted               = "Completed"
+	ResizeFailed                  = "Failed"
+)
+
+const (
+	// resizePollInterval 检查扩容状态的间隔
+	resizePollInterval = 5 * time.Second
+
+	// resizeTimeout 文件系统扩容需要等pod重新挂载，等待时间较长
+	resizeTimeout = 2 * time.Hour
+)
+
+// CheckPVCResize 检查PVC是否可以扩容到size：只能扩大，并且存储类开启了 allowVolumeExpansion
+func CheckPVCResize(clientSet kubernetes.Interface, pvc *v1.PersistentVolumeClaim, size resource.Quantity) error {
+	current := pvc.Spec.Resources.Requests[v1.ResourceStorage]
+	if size.Cmp(current) < 0 {
+		return errors.New("存储 " + pvc.Name + " 当前大小为 " + current.String() + "，不支持缩容")
+	}
+
+	if pvc.Spec.StorageClassName == nil || *pvc.Spec.StorageClassName == "" {
+		return errors.New("存储 " + pvc.Name + " 没有使用存储类，不能在线扩容")
+	}
+	storageClass, err := clientSet.StorageV1().StorageClasses().Get(context.TODO(), *pvc.Spec.StorageClassName, v12.GetOptions{})
+	if err != nil {
+		return err
+	}
+	if storageClass.AllowVolumeExpansion == nil || !*storageClass.AllowVolumeExpansion {
+		return errors.New("存储类 " + storageClass.Name + " 没有开启 allowVolumeExpansion，不能扩容")
+	}
+	return nil
+}
+
+// PVCResizeStatus 根据PVC的状态判断扩容进度
+func PVCResizeStatus(pvc *v1.PersistentVolumeClaim) (string, string) {
+	if pvc.Status.ResizeStatus != nil {
+		switch *pvc.Status.ResizeStatus {
+		case v1.PersistentVolumeClaimControllerExpansionFailed, v1.PersistentVolumeClaimNodeExpansionFailed:
+			return ResizeFailed, "扩容失败：" + string(*pvc.Status.ResizeStatus)
+		}
+	}
+
+	for _, condition := range pvc.Status.Conditions {
+		if condition.Status != v1.ConditionTrue {
+			continue
+		}
+		switch condition.Type {
+		case v1.PersistentVolumeClaimFileSystemResizePending:
+			// 存储已经扩容，等待pod挂载时扩容文件系统
+			return ResizeFileSystemResizePending, condition.Message
+		case v1.PersistentVolumeClaimResizing:
+			return ResizeResizing, condition.Message
+		}
+	}
+
+	request := pvc.Spec.Resources.Requests[v1.ResourceStorage]
+	capacity := pvc.Status.Capacity[v1.ResourceStorage]
+	if capacity.Cmp(request) >= 0 {
+		return ResizeCompleted, "当前容量 " + capacity.String()
+	}
+	return ResizeResizing, "当前容量 " + capacity.String() + "，目标容量 " + request.String()
+}
+
+// WatchPVCResize 定时检查PVC的扩容状态，状态变化时调用onChange，完成、失败或超时后返回
+func WatchPVCResize(clientSet kubernetes.Interface, namespace, name string, onChange func(status, msg string)) {
+	ticker := time.NewTicker(resizePollInterval)
+	defer ticker.Stop()
+	timeout := time.After(resizeTimeout)
+
+	var last string
+	for {
+		select {
+		case <-ticker.C:
+		case <-timeout:
+			onChange(ResizeFailed, "等待扩容超时")
+			return
+		}
+
+		pvc, err := clientSet.CoreV1().PersistentVolumeClaims(namespace).Get(context.TODO(), name, v12.GetOptions{})
+		if err != nil {
+			Error(err)
+			onChange(ResizeFailed, err.Error())
+			return
+		}
+
+		status, msg := PVCResizeStatus(pvc)
+		if status != last {
+			last = status
+			onChange(status, msg)
+		}
+		if status == ResizeCompleted || status == ResizeFailed {
+			return
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package common
+
+import (
+	"context"
+	"errors"
+	v1 "k8s.io/api/storage/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	v12 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"strings"
+)
+
+// 标记默认存储类的注解，旧版本集群使用beta注解
+const (
+	defaultStorageClassAnnotation     = "storageclass.kubernetes.io/is-default-class"
+	betaDefaultStorageClassAnnotation = "storageclass.beta.kubernetes.io/is-default-class"
+)
+
+// IsDefaultStorageClass 是否为集群的默认存储类
+func IsDefaultStorageClass(storageClass *v1.StorageClass) bool {
+	return storageClass.Annotations[defaultStorageClassAnnotation] == "true" ||
+		storageClass.Annotations[betaDefaultStorageClassAnnotation] == "true"
+}
+
+// ResolveStorageClass 检查存储类是否存在，name为空时返回集群的默认存储类
+func ResolveStorageClass(clientSet kubernetes.Interface, name string) (string, error) {
+	if name != "" {
+		_, err := clientSet.StorageV1().StorageClasses().Get(context.TODO(), name, v12.GetOptions{})
+		if k8serrors.IsNotFound(err) {
+			names, listErr := storageClassNames(clientSet)
+			if listErr != nil {
+				return "", listErr
+			}
+			return "", errors.New("存储类 " + name + " 不存在，可用的存储类：" + names)
+		}
+		return name, err
+	}
+
+	list, err := clientSet.StorageV1().StorageClasses().List(context.TODO(), v12.ListOptions{})
+	if err != nil {
+		return "", err
+	}
+	for i := range list.Items {
+		if IsDefaultStorageClass(&list.Items[i]) {
+			return list.Items[i].Name, nil
+		}
+	}
+	return "", errors.New("集群没有默认存储类，需要指定存储类")
+}
+
+// storageClassNames 集群中所有存储类的名称
+func storageClassNames(clientSet kubernetes.Interface) (string, error) {
+	list, err := clientSet.StorageV1().StorageClasses().List(context.TODO(), v12.ListOptions{})
+	if err != nil {
+		return "", err
+	}
+	var names []string
+	for _, storageClass := range list.Items {
+		names = append(names, storageClass.Name)
+	}
+	if len(names) == 0 {
+		return "无", nil
+	}
+	return strings.Join(names, ", "), nil
+}
@@ -8,20 +8,29 @@ import (
 
 var (
 	logger *zap.SugaredLogger
+	// logWriter 当前写入的日志文件，修改日志文件时关闭
+	logWriter *lumberjack.Logger
 )
 
 func init() {
 	//日志文件名称
-	fileName := "micro.log"
-	syncWriter := zapcore.AddSync(
-		&lumberjack.Logger{
-			Filename: fileName, //文件名称
-			MaxSize:  512,      //MB
-			//MaxAge:     0,
-			MaxBackups: 0, //最大备份
-			LocalTime:  true,
-			Compress:   true, //是否启用压缩
-		})
+	SetLogFile("micro.log")
+}
+
+// SetLogFile 修改日志文件，测试时写到临时目录，不在包目录下生成日志
+func SetLogFile(fileName string) {
+	if logWriter != nil {
+		_ = logWriter.Close()
+	}
+	logWriter = &lumberjack.Logger{
+		Filename: fileName, //文件名称
+		MaxSize:  512,      //MB
+		//MaxAge:     0,
+		MaxBackups: 0, //最大备份
+		LocalTime:  true,
+		Compress:   true, //是否启用压缩
+	}
+	syncWriter := zapcore.AddSync(logWriter)
 	//编码
 	encoder := zap.NewProductionEncoderConfig()
 	//时间格式
@@ -0,0 +1,41 @@
+package form
+
+import (
+	"reflect"
+	"strings"
+	"tini-paas/api/buildapi/proto/buildApi"
+	"tini-paas/pkg/common"
+)
+
+func FormToBuildStruct(data map[string]*buildApi.Pair, obj interface{}) {
+	objValue := reflect.ValueOf(obj).Elem()
+	for i := 0; i < objValue.NumField(); i++ {
+		//获取sql对应的值
+		dataTag := strings.Replace(objValue.Type().Field(i).Tag.Get("json"), ",omitempty", "", -1)
+		dataSlice, ok := data[dataTag]
+		if !ok {
+			continue
+		}
+		valueSlice := dataSlice.Values
+		if len(valueSlice) <= 0 {
+			continue
+		}
+		value := valueSlice[0]
+		//获取对应字段的名称
+		name := objValue.Type().Field(i).Name
+		//获取对应字段类型
+		structFieldType := objValue.Field(i).Type()
+		//获取变量类型，也可以直接写"string类型"
+		val := reflect.ValueOf(value)
+		var err error
+		if structFieldType != val.Type() {
+			//类型转换
+			val, err = TypeConversion(value, structFieldType.Name()) //类型转换
+			if err != nil {
+				common.Error(err)
+			}
+		}
+		//设置类型值
+		objValue.FieldByName(name).Set(val)
+	}
+}
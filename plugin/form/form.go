@@ -35,6 +35,9 @@ func TypeConversion(value string, ntype string) (reflect.Value, error) {
 	} else if ntype == "float64" {
 		i, err := strconv.ParseFloat(value, 64)
 		return reflect.ValueOf(i), err
+	} else if ntype == "bool" {
+		b, err := strconv.ParseBool(value)
+		return reflect.ValueOf(b), err
 	}
 
 	//else if .......增加其他一些类型的转换
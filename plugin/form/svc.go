@@ -21,7 +21,7 @@ func FormToSvcStruct(data map[string]*svcApi.Pair, obj interface{}) {
 			continue
 		}
 		//排除port和env
-		if dataTag == "svc_port" || dataTag == "svc_target_port" {
+		if dataTag == "svc_port" || dataTag == "svc_target_port" || dataTag == "svc_node_port" {
 			continue
 		}
 		value := valueSlice[0]